@@ -0,0 +1,27 @@
+package ogg
+
+// crcTable is the table of the CRC-32 used by Ogg, with the polynomial
+// 0x04c11db7, no reflection, no initial value and no final XOR.
+var crcTable = func() [256]uint32 {
+	var table [256]uint32
+	for i := range table {
+		r := uint32(i) << 24
+		for j := 0; j < 8; j++ {
+			if r&0x80000000 != 0 {
+				r = r<<1 ^ 0x04c11db7
+			} else {
+				r <<= 1
+			}
+		}
+		table[i] = r
+	}
+	return table
+}()
+
+func crc(p []byte) uint32 {
+	var c uint32
+	for _, b := range p {
+		c = c<<8 ^ crcTable[byte(c>>24)^b]
+	}
+	return c
+}
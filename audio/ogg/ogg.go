@@ -0,0 +1,135 @@
+// Package ogg writes Ogg bitstreams as defined in RFC 3533, and Ogg Opus
+// streams as defined in RFC 7845.
+package ogg
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	headerContinued = 0x01
+	headerBOS       = 0x02
+	headerEOS       = 0x04
+
+	pageHeaderSize = 27
+	maxSegments    = 255
+	maxSegmentSize = 255
+)
+
+// NoGranule is the granule position of a page on which no packet ends.
+const NoGranule int64 = -1
+
+// Writer writes packets of a logical bitstream into Ogg pages. Packets are
+// gathered into a page until it is full or Flush is called.
+type Writer struct {
+	w      io.Writer
+	serial uint32
+	seq    uint32
+
+	// the next page is the first page of the stream
+	bos bool
+	// the first packet of the pending page continues from the previous page
+	continued bool
+	// granule position of the last packet ended on the pending page
+	granule int64
+
+	segments []byte
+	data     []byte
+}
+
+func NewWriter(w io.Writer, serial uint32) *Writer {
+	return &Writer{
+		w:       w,
+		serial:  serial,
+		bos:     true,
+		granule: NoGranule,
+	}
+}
+
+// WritePacket adds a packet ending at the granule position to the pending
+// page. Full pages are written out; a packet too large for a page is
+// continued on the next page.
+func (w *Writer) WritePacket(p []byte, granule int64) error {
+	for started := false; ; started = true {
+		if len(w.segments) == maxSegments {
+			if err := w.writePage(0); err != nil {
+				return fmt.Errorf("ogg.Writer.WritePacket: %w", err)
+			}
+			// the rest of the packet continues on the next page
+			w.continued = started
+		}
+
+		n := min(len(p), maxSegmentSize)
+		w.segments = append(w.segments, byte(n))
+		w.data = append(w.data, p[:n]...)
+		p = p[n:]
+
+		// a segment shorter than the maximum ends the packet
+		if n < maxSegmentSize {
+			break
+		}
+	}
+
+	w.granule = granule
+
+	return nil
+}
+
+// Flush writes the pending page, if any.
+func (w *Writer) Flush() error {
+	if len(w.segments) == 0 {
+		return nil
+	}
+
+	if err := w.writePage(0); err != nil {
+		return fmt.Errorf("ogg.Writer.Flush: %w", err)
+	}
+
+	return nil
+}
+
+// Close writes the pending page as the last page of the stream. It does not
+// close the underlying writer.
+func (w *Writer) Close() error {
+	if err := w.writePage(headerEOS); err != nil {
+		return fmt.Errorf("ogg.Writer.Close: %w", err)
+	}
+
+	return nil
+}
+
+func (w *Writer) writePage(flags byte) error {
+	if w.bos {
+		flags |= headerBOS
+	}
+	if w.continued {
+		flags |= headerContinued
+	}
+
+	page := make([]byte, pageHeaderSize, pageHeaderSize+len(w.segments)+len(w.data))
+	copy(page, "OggS")
+	page[4] = 0 // version
+	page[5] = flags
+	binary.LittleEndian.PutUint64(page[6:], uint64(w.granule))
+	binary.LittleEndian.PutUint32(page[14:], w.serial)
+	binary.LittleEndian.PutUint32(page[18:], w.seq)
+	page[26] = byte(len(w.segments))
+	page = append(page, w.segments...)
+	page = append(page, w.data...)
+	binary.LittleEndian.PutUint32(page[22:], crc(page))
+
+	if _, err := w.w.Write(page); err != nil {
+		return err
+	}
+
+	w.seq++
+	w.bos = false
+	w.continued = false
+	w.granule = NoGranule
+	w.segments = w.segments[:0]
+	w.data = w.data[:0]
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package ogg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestCRC(t *testing.T) {
+	// CRC-32/CKSUM without the final XOR
+	if got, want := crc([]byte("123456789")), uint32(0x765e7680^0xffffffff); got != want {
+		t.Errorf("crc(): got %#08x, want %#08x", got, want)
+	}
+}
+
+type page struct {
+	flags    byte
+	granule  int64
+	serial   uint32
+	seq      uint32
+	segments []byte
+	data     []byte
+}
+
+func readPages(p []byte) ([]*page, error) {
+	var pages []*page
+	for len(p) > 0 {
+		if len(p) < pageHeaderSize || string(p[:4]) != "OggS" {
+			return nil, errors.New("invalid page header")
+		}
+		nsegs := int(p[26])
+		if len(p) < pageHeaderSize+nsegs {
+			return nil, errors.New("short segment table")
+		}
+		segments := p[pageHeaderSize : pageHeaderSize+nsegs]
+		size := pageHeaderSize + nsegs
+		for _, s := range segments {
+			size += int(s)
+		}
+		if len(p) < size {
+			return nil, errors.New("short page")
+		}
+
+		raw := bytes.Clone(p[:size])
+		want := binary.LittleEndian.Uint32(raw[22:])
+		binary.LittleEndian.PutUint32(raw[22:], 0)
+		if got := crc(raw); got != want {
+			return nil, fmt.Errorf("page %d: crc mismatch: got %#08x, want %#08x", len(pages), got, want)
+		}
+
+		pages = append(pages, &page{
+			flags:    p[5],
+			granule:  int64(binary.LittleEndian.Uint64(p[6:])),
+			serial:   binary.LittleEndian.Uint32(p[14:]),
+			seq:      binary.LittleEndian.Uint32(p[18:]),
+			segments: segments,
+			data:     p[pageHeaderSize+nsegs : size],
+		})
+		p = p[size:]
+	}
+	return pages, nil
+}
+
+// packets reassembles the packets from the pages.
+func packets(pages []*page) [][]byte {
+	var packets [][]byte
+	var packet []byte
+	for _, pg := range pages {
+		data := pg.data
+		for _, s := range pg.segments {
+			packet = append(packet, data[:s]...)
+			data = data[s:]
+			if s < maxSegmentSize {
+				packets = append(packets, packet)
+				packet = nil
+			}
+		}
+	}
+	return packets
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf, 1234)
+
+	in := [][]byte{
+		bytes.Repeat([]byte{1}, 10),
+		bytes.Repeat([]byte{2}, 255),                  // needs a terminating empty segment
+		bytes.Repeat([]byte{3}, maxSegmentSize*600+7), // continued over pages
+		{},
+		bytes.Repeat([]byte{4}, 100),
+	}
+	for i, p := range in {
+		if err := w.WritePacket(p, int64(i+1)*100); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	pages, err := readPages(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(in, packets(pages), cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("packets mismatch (-want +got):\n%s", diff)
+	}
+
+	type pageInfo struct {
+		Flags   byte
+		Granule int64
+		Seq     uint32
+	}
+	var got []pageInfo
+	for _, pg := range pages {
+		if pg.serial != 1234 {
+			t.Errorf("page %d: serial: got %d, want 1234", pg.seq, pg.serial)
+		}
+		got = append(got, pageInfo{pg.flags, pg.granule, pg.seq})
+	}
+	want := []pageInfo{
+		// the first packets and the start of the large one
+		{headerBOS, 200, 0},
+		// the large packet does not end on the page
+		{headerContinued, NoGranule, 1},
+		{headerContinued | headerEOS, 500, 2},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("pages mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestOpusWriter(t *testing.T) {
+	var buf bytes.Buffer
+
+	head := &OpusHead{
+		Channels:        1,
+		PreSkip:         DefaultOpusPreSkip,
+		InputSampleRate: 48000,
+	}
+	tags := &OpusTags{
+		Vendor:   "yomiko",
+		Comments: []string{"TITLE=test"},
+	}
+	w, err := NewOpusWriter(&buf, head, tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// CELT 20 ms, one frame
+	packet := []byte{31<<3 | 0, 0xaa, 0xbb}
+	for i := 0; i < 75; i++ {
+		if err := w.WritePacket(packet); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := w.Granule(), int64(75*960); got != want {
+		t.Errorf("OpusWriter.Granule(): got %d, want %d", got, want)
+	}
+
+	pages, err := readPages(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pages) != 4 {
+		t.Fatalf("pages: got %d, want 4", len(pages))
+	}
+
+	wantHead := []byte{'O', 'p', 'u', 's', 'H', 'e', 'a', 'd', 1, 1, 0x38, 0x01, 0x80, 0xbb, 0, 0, 0, 0, 0}
+	if diff := cmp.Diff(wantHead, pages[0].data); diff != "" {
+		t.Errorf("OpusHead mismatch (-want +got):\n%s", diff)
+	}
+	if pages[0].flags != headerBOS || pages[0].granule != 0 {
+		t.Errorf("OpusHead page: got flags %#x granule %d, want %#x 0", pages[0].flags, pages[0].granule, headerBOS)
+	}
+
+	wantTags := []byte("OpusTags\x06\x00\x00\x00yomiko\x01\x00\x00\x00\x0a\x00\x00\x00TITLE=test")
+	if diff := cmp.Diff(wantTags, pages[1].data); diff != "" {
+		t.Errorf("OpusTags mismatch (-want +got):\n%s", diff)
+	}
+
+	// a page is flushed after a second of audio
+	if got, want := pages[2].granule, int64(50*960); got != want {
+		t.Errorf("page 2: granule: got %d, want %d", got, want)
+	}
+	if got, want := pages[3].granule, int64(75*960); got != want {
+		t.Errorf("page 3: granule: got %d, want %d", got, want)
+	}
+	if pages[3].flags&headerEOS == 0 {
+		t.Errorf("page 3: got flags %#x, want EOS", pages[3].flags)
+	}
+}
+
+func TestOpusPacketSamples(t *testing.T) {
+	tests := []struct {
+		packet []byte
+		want   int
+		err    error
+	}{
+		{[]byte{0<<3 | 0}, 480, nil},         // SILK 10 ms
+		{[]byte{3<<3 | 1}, 2880 * 2, nil},    // SILK 60 ms x 2
+		{[]byte{13<<3 | 2}, 960 * 2, nil},    // Hybrid 20 ms x 2
+		{[]byte{16<<3 | 0}, 120, nil},        // CELT 2.5 ms
+		{[]byte{31<<3 | 3, 6}, 960 * 6, nil}, // CELT 20 ms x 6
+		{[]byte{31<<3 | 3, 7}, 0, ErrInvalidOpusPacket},
+		{[]byte{31<<3 | 3}, 0, ErrInvalidOpusPacket},
+		{nil, 0, ErrInvalidOpusPacket},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i+1), func(t *testing.T) {
+			got, err := OpusPacketSamples(tt.packet)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("OpusPacketSamples(): got error %v, want %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("OpusPacketSamples(): got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package ogg
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+)
+
+// OpusSampleRate is the rate of the granule positions of Ogg Opus streams.
+const OpusSampleRate = 48000
+
+// DefaultOpusPreSkip is the look ahead of libopus at 48 kHz.
+const DefaultOpusPreSkip = 312
+
+var ErrInvalidOpusPacket = errors.New("ogg: invalid opus packet")
+
+// OpusHead is the identification header of an Ogg Opus stream. Only the
+// channel mapping family 0, mono or stereo, is supported.
+type OpusHead struct {
+	Channels int
+	// PreSkip is the number of samples at 48 kHz to be discarded from the
+	// start of the decoded output.
+	PreSkip int
+	// InputSampleRate is the sample rate of the original input, for
+	// information only.
+	InputSampleRate int
+	// OutputGain is the gain in dB to be applied to the decoded output.
+	OutputGain float64
+}
+
+func (h *OpusHead) marshal() []byte {
+	p := []byte("OpusHead")
+	p = append(p, 1) // version
+	p = append(p, byte(h.Channels))
+	p = binary.LittleEndian.AppendUint16(p, uint16(h.PreSkip))
+	p = binary.LittleEndian.AppendUint32(p, uint32(h.InputSampleRate))
+	// Q7.8 in dB
+	p = binary.LittleEndian.AppendUint16(p, uint16(int16(h.OutputGain*256)))
+	p = append(p, 0) // channel mapping family
+	return p
+}
+
+// OpusTags is the comment header of an Ogg Opus stream.
+type OpusTags struct {
+	Vendor string
+	// Comments are "NAME=value" pairs.
+	Comments []string
+}
+
+func (t *OpusTags) marshal() []byte {
+	p := []byte("OpusTags")
+	p = binary.LittleEndian.AppendUint32(p, uint32(len(t.Vendor)))
+	p = append(p, t.Vendor...)
+	p = binary.LittleEndian.AppendUint32(p, uint32(len(t.Comments)))
+	for _, c := range t.Comments {
+		p = binary.LittleEndian.AppendUint32(p, uint32(len(c)))
+		p = append(p, c...)
+	}
+	return p
+}
+
+// opusPageSamples is the duration of audio in a page, so that a page is
+// written about every second.
+const opusPageSamples = OpusSampleRate
+
+// OpusWriter writes Opus packets into an Ogg Opus stream.
+type OpusWriter struct {
+	ogg *Writer
+
+	// granule position of the end of the last packet
+	granule int64
+	// granule position of the start of the pending page
+	pageStart int64
+}
+
+// NewOpusWriter writes the headers of a new Ogg Opus stream to w, and
+// returns a writer of the audio packets.
+func NewOpusWriter(w io.Writer, head *OpusHead, tags *OpusTags) (*OpusWriter, error) {
+	ow := &OpusWriter{
+		ogg: NewWriter(w, rand.Uint32()),
+	}
+
+	// each header is on its own page
+	for _, header := range [][]byte{head.marshal(), tags.marshal()} {
+		if err := ow.ogg.WritePacket(header, 0); err != nil {
+			return nil, fmt.Errorf("ogg.NewOpusWriter: %w", err)
+		}
+		if err := ow.ogg.Flush(); err != nil {
+			return nil, fmt.Errorf("ogg.NewOpusWriter: %w", err)
+		}
+	}
+
+	return ow, nil
+}
+
+// WritePacket writes an Opus packet. The duration of the packet is read from
+// its TOC byte.
+func (w *OpusWriter) WritePacket(p []byte) error {
+	samples, err := OpusPacketSamples(p)
+	if err != nil {
+		return fmt.Errorf("ogg.OpusWriter.WritePacket: %w", err)
+	}
+
+	w.granule += int64(samples)
+	if err := w.ogg.WritePacket(p, w.granule); err != nil {
+		return fmt.Errorf("ogg.OpusWriter.WritePacket: %w", err)
+	}
+
+	if w.granule-w.pageStart >= opusPageSamples {
+		if err := w.Flush(); err != nil {
+			return fmt.Errorf("ogg.OpusWriter.WritePacket: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Flush writes the pending page.
+func (w *OpusWriter) Flush() error {
+	if err := w.ogg.Flush(); err != nil {
+		return fmt.Errorf("ogg.OpusWriter.Flush: %w", err)
+	}
+	w.pageStart = w.granule
+
+	return nil
+}
+
+// Granule returns the granule position of the end of the last packet, that
+// is the number of samples at 48 kHz written so far.
+func (w *OpusWriter) Granule() int64 {
+	return w.granule
+}
+
+// Close writes the last page of the stream. It does not close the
+// underlying writer.
+func (w *OpusWriter) Close() error {
+	if err := w.ogg.Close(); err != nil {
+		return fmt.Errorf("ogg.OpusWriter.Close: %w", err)
+	}
+
+	return nil
+}
+
+// OpusPacketSamples returns the number of samples at 48 kHz in an Opus
+// packet as defined in RFC 6716.
+func OpusPacketSamples(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, fmt.Errorf("%w: empty packet", ErrInvalidOpusPacket)
+	}
+
+	toc := p[0]
+	config := int(toc >> 3)
+
+	var frameSamples int
+	switch {
+	case config < 12:
+		// SILK: 10, 20, 40, 60 ms
+		frameSamples = []int{480, 960, 1920, 2880}[config%4]
+	case config < 16:
+		// Hybrid: 10, 20 ms
+		frameSamples = []int{480, 960}[config%2]
+	default:
+		// CELT: 2.5, 5, 10, 20 ms
+		frameSamples = []int{120, 240, 480, 960}[config%4]
+	}
+
+	var frames int
+	switch toc & 0x03 {
+	case 0:
+		frames = 1
+	case 1, 2:
+		frames = 2
+	case 3:
+		if len(p) < 2 {
+			return 0, fmt.Errorf("%w: no frame count", ErrInvalidOpusPacket)
+		}
+		frames = int(p[1] & 0x3f)
+	}
+
+	samples := frames * frameSamples
+	// a packet is at most 120 ms
+	if frames == 0 || samples > OpusSampleRate*120/1000 {
+		return 0, fmt.Errorf("%w: %d frames of %d samples", ErrInvalidOpusPacket, frames, frameSamples)
+	}
+
+	return samples, nil
+}
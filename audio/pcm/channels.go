@@ -0,0 +1,112 @@
+package pcm
+
+// Buffer holds interleaved samples of multiple channels.
+type Buffer[T Type] struct {
+	Channels int
+	Data     []T
+}
+
+func (b *Buffer[T]) Frames() int {
+	return len(b.Data) / b.Channels
+}
+
+// Frame returns the samples of the i-th frame.
+func (b *Buffer[T]) Frame(i int) []T {
+	return b.Data[i*b.Channels : (i+1)*b.Channels]
+}
+
+// Channel appends the samples of the channel ch to dst.
+func (b *Buffer[T]) Channel(dst []T, ch int) []T {
+	for i := ch; i < len(b.Data); i += b.Channels {
+		dst = append(dst, b.Data[i])
+	}
+	return dst
+}
+
+// Interleave appends the frames made from the given channels to dst.
+// The number of frames is the length of the shortest channel.
+func Interleave[T Type](dst []T, channels ...[]T) []T {
+	if len(channels) == 0 {
+		return dst
+	}
+
+	frames := len(channels[0])
+	for _, ch := range channels[1:] {
+		frames = min(frames, len(ch))
+	}
+
+	for i := 0; i < frames; i++ {
+		for _, ch := range channels {
+			dst = append(dst, ch[i])
+		}
+	}
+
+	return dst
+}
+
+// Downmix appends the mono samples mixed from the interleaved src to dst.
+// The channels are averaged so that the mix does not clip.
+func Downmix[T Type](dst, src []T, channels int) []T {
+	if channels == 1 {
+		return append(dst, src...)
+	}
+
+	scale := 1 / float32(channels)
+	for i := 0; i+channels <= len(src); i += channels {
+		var v float32
+		for _, s := range src[i : i+channels] {
+			v += toFloat32(s)
+		}
+		dst = append(dst, fromFloat32[T](v*scale))
+	}
+
+	return dst
+}
+
+// Upmix appends the mono src copied to each of the channels to dst.
+func Upmix[T Type](dst, src []T, channels int) []T {
+	if channels == 1 {
+		return append(dst, src...)
+	}
+
+	for _, v := range src {
+		for ch := 0; ch < channels; ch++ {
+			dst = append(dst, v)
+		}
+	}
+
+	return dst
+}
+
+// ConvertChannels appends src converted from the interleaved from channels
+// to the interleaved to channels to dst.
+func ConvertChannels[T Type](dst, src []T, from, to int) []T {
+	switch {
+	case from == to:
+		return append(dst, src...)
+	case to == 1:
+		return Downmix(dst, src, from)
+	case from == 1:
+		return Upmix(dst, src, to)
+	}
+
+	// keep the common channels and fill the rest with the mix
+	for i := 0; i+from <= len(src); i += from {
+		frame := src[i : i+from]
+		var mix float32
+		for _, v := range frame {
+			mix += toFloat32(v)
+		}
+		mixed := fromFloat32[T](mix / float32(from))
+
+		for ch := 0; ch < to; ch++ {
+			if ch < from {
+				dst = append(dst, frame[ch])
+			} else {
+				dst = append(dst, mixed)
+			}
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,92 @@
+package pcm
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInterleave(t *testing.T) {
+	got := Interleave(nil, []int16{1, 2, 3}, []int16{-1, -2, -3, -4})
+	want := []int16{1, -1, 2, -2, 3, -3}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Interleave() mismatch (-want +got):\n%s", diff)
+	}
+
+	buf := &Buffer[int16]{Channels: 2, Data: got}
+	if buf.Frames() != 3 {
+		t.Errorf("Buffer.Frames(): got %d, want 3", buf.Frames())
+	}
+	if diff := cmp.Diff([]int16{2, -2}, buf.Frame(1)); diff != "" {
+		t.Errorf("Buffer.Frame(1) mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]int16{-1, -2, -3}, buf.Channel(nil, 1)); diff != "" {
+		t.Errorf("Buffer.Channel(1) mismatch (-want +got):\n%s", diff)
+	}
+}
+
+var convertChannelsTests = []struct {
+	name string
+	from int
+	to   int
+	in   []float32
+	want []float32
+}{
+	{"mono to mono", 1, 1, []float32{0.5, -0.5}, []float32{0.5, -0.5}},
+	{"stereo to mono", 2, 1, []float32{0.5, 0.25, -1, 1}, []float32{0.375, 0}},
+	{"mono to stereo", 1, 2, []float32{0.5, -0.5}, []float32{0.5, 0.5, -0.5, -0.5}},
+	{"stereo to 3ch", 2, 3, []float32{0.5, 0.25}, []float32{0.5, 0.25, 0.375}},
+	{"3ch to stereo", 3, 2, []float32{0.5, 0.25, 0}, []float32{0.5, 0.25}},
+}
+
+func TestConvertChannels(t *testing.T) {
+	for _, tt := range convertChannelsTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ConvertChannels(nil, tt.in, tt.from, tt.to)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ConvertChannels() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDownmixInt16(t *testing.T) {
+	// averaging must not overflow
+	got := Downmix(nil, []int16{32767, 32767, -32768, -32768}, 2)
+	want := []int16{32767, -32768}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Downmix() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestConverter(t *testing.T) {
+	const amp = 0.5
+
+	left := makeSine(24000, 1000, 12000, amp)
+	right := makeSine(24000, 1000, 12000, amp)
+
+	from := Format{SampleRate: 24000, Channels: 2, SampleType: SampleTypeFloat32}
+	to := Format{SampleRate: 48000, Channels: 1, SampleType: SampleTypeFloat32}
+
+	c, err := NewConverter[float32](from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in := Interleave(nil, left, right)
+	var out []float32
+	for i := 0; i < len(in); i += 1000 {
+		out, err = c.Convert(out, in[i:min(len(in), i+1000)])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	out = c.Flush(out)
+
+	if len(out) != 24000 {
+		t.Fatalf("output frames: got %d, want 24000", len(out))
+	}
+	if snr := sineSNR(out, 48000, 1000, amp, 1000); snr < 75 {
+		t.Errorf("SNR: got %.1f dB, want >= 75 dB", snr)
+	}
+}
@@ -0,0 +1,90 @@
+package pcm
+
+import "fmt"
+
+// Converter converts the sample rate and the channels of a stream of
+// interleaved samples. The sample type of the formats is ignored; the
+// samples are always T.
+type Converter[T Type] struct {
+	from Format
+	to   Format
+
+	resampler *Resampler[T]
+	buf       []T
+}
+
+func NewConverter[T Type](from, to Format) (*Converter[T], error) {
+	if from.SampleRate <= 0 || to.SampleRate <= 0 {
+		return nil, fmt.Errorf("pcm.NewConverter: %w: %d -> %d", ErrInvalidSampleRate, from.SampleRate, to.SampleRate)
+	}
+	if from.Channels <= 0 || to.Channels <= 0 {
+		return nil, fmt.Errorf("pcm.NewConverter: %w: %d -> %d", ErrInvalidChannels, from.Channels, to.Channels)
+	}
+
+	c := &Converter[T]{
+		from: from,
+		to:   to,
+	}
+
+	if from.SampleRate != to.SampleRate {
+		// resample the smaller number of channels
+		r, err := NewResampler[T](from.SampleRate, to.SampleRate, min(from.Channels, to.Channels))
+		if err != nil {
+			return nil, fmt.Errorf("pcm.NewConverter: %w", err)
+		}
+		c.resampler = r
+	}
+
+	return c, nil
+}
+
+func (c *Converter[T]) From() Format {
+	return c.from
+}
+
+func (c *Converter[T]) To() Format {
+	return c.to
+}
+
+// Convert appends the frames converted from src to dst. src must contain
+// whole frames. Like Resampler, some frames may be held back until Flush is
+// called.
+func (c *Converter[T]) Convert(dst, src []T) ([]T, error) {
+	if len(src)%c.from.Channels != 0 {
+		return dst, fmt.Errorf("pcm.Converter.Convert: %w: %d samples of %d channels", ErrPartialFrame, len(src), c.from.Channels)
+	}
+
+	if c.resampler == nil {
+		return ConvertChannels(dst, src, c.from.Channels, c.to.Channels), nil
+	}
+
+	var err error
+	if c.to.Channels < c.from.Channels {
+		c.buf = ConvertChannels(c.buf[:0], src, c.from.Channels, c.to.Channels)
+		dst, err = c.resampler.Resample(dst, c.buf)
+		if err != nil {
+			return dst, fmt.Errorf("pcm.Converter.Convert: %w", err)
+		}
+		return dst, nil
+	}
+
+	c.buf, err = c.resampler.Resample(c.buf[:0], src)
+	if err != nil {
+		return dst, fmt.Errorf("pcm.Converter.Convert: %w", err)
+	}
+	return ConvertChannels(dst, c.buf, c.from.Channels, c.to.Channels), nil
+}
+
+// Flush appends the remaining frames to dst and resets the converter.
+func (c *Converter[T]) Flush(dst []T) []T {
+	if c.resampler == nil {
+		return dst
+	}
+
+	if c.to.Channels < c.from.Channels {
+		return c.resampler.Flush(dst)
+	}
+
+	c.buf = c.resampler.Flush(c.buf[:0])
+	return ConvertChannels(dst, c.buf, c.from.Channels, c.to.Channels)
+}
@@ -0,0 +1,168 @@
+package pcm
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	ErrInvalidChannels = errors.New("pcm: invalid number of channels")
+	ErrPartialFrame    = errors.New("pcm: partial frame")
+)
+
+type SampleType int
+
+const (
+	SampleTypeInt16 SampleType = iota + 1
+	SampleTypeFloat32
+)
+
+func SampleTypeOf[T Type]() SampleType {
+	var v T
+	switch any(v).(type) {
+	case int16:
+		return SampleTypeInt16
+	case float32:
+		return SampleTypeFloat32
+	}
+
+	// unreachable
+	return 0
+}
+
+// Size returns the size of a sample in bytes.
+func (t SampleType) Size() int {
+	switch t {
+	case SampleTypeInt16:
+		return 2
+	case SampleTypeFloat32:
+		return 4
+	}
+	return 0
+}
+
+func (t SampleType) String() string {
+	switch t {
+	case SampleTypeInt16:
+		return "int16"
+	case SampleTypeFloat32:
+		return "float32"
+	}
+	return fmt.Sprintf("SampleType(%d)", int(t))
+}
+
+// Format describes a stream of interleaved samples.
+type Format struct {
+	SampleRate int
+	Channels   int
+	SampleType SampleType
+}
+
+func (f Format) String() string {
+	return fmt.Sprintf("%d Hz, %d ch, %s", f.SampleRate, f.Channels, f.SampleType)
+}
+
+func (f Format) Validate() error {
+	if f.SampleRate <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidSampleRate, f.SampleRate)
+	}
+	if f.Channels <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidChannels, f.Channels)
+	}
+	if f.SampleType.Size() == 0 {
+		return fmt.Errorf("%w: %s", ErrUnsupportedFormat, f.SampleType)
+	}
+	return nil
+}
+
+// FrameSize returns the size of a frame, which has a sample for each
+// channel, in bytes.
+func (f Format) FrameSize() int {
+	return f.Channels * f.SampleType.Size()
+}
+
+// BytesToFrames returns the number of frames in the given bytes.
+func (f Format) BytesToFrames(bytes int) int {
+	return bytes / f.FrameSize()
+}
+
+// FramesToBytes returns the size of the given number of frames in bytes.
+func (f Format) FramesToBytes(frames int) int {
+	return frames * f.FrameSize()
+}
+
+// WAVInfo returns the fmt chunk describing the format.
+func (f Format) WAVInfo() WAVInfo {
+	info := WAVInfo{
+		Channels:      f.Channels,
+		SampleRate:    f.SampleRate,
+		BitsPerSample: f.SampleType.Size() * 8,
+	}
+	switch f.SampleType {
+	case SampleTypeInt16:
+		info.AudioFormat = WAVFormatPCM
+	case SampleTypeFloat32:
+		info.AudioFormat = WAVFormatIEEEFloat
+	}
+	return info
+}
+
+// Format returns the format of the samples in the data chunk.
+func (info WAVInfo) Format() (Format, error) {
+	f := Format{
+		SampleRate: info.SampleRate,
+		Channels:   info.Channels,
+	}
+
+	switch {
+	case info.AudioFormat == WAVFormatPCM && info.BitsPerSample == 16:
+		f.SampleType = SampleTypeInt16
+	case info.AudioFormat == WAVFormatIEEEFloat && info.BitsPerSample == 32:
+		f.SampleType = SampleTypeFloat32
+	default:
+		return Format{}, fmt.Errorf("%w: format 0x%04x, %d bits", ErrUnsupportedFormat, info.AudioFormat, info.BitsPerSample)
+	}
+
+	return f, nil
+}
+
+// DecodeAs decodes samples of the sample type t in p and appends them to dst
+// converting to T.
+func DecodeAs[T Type](dst []T, p []byte, t SampleType, order ByteOrder) []T {
+	switch t {
+	case SampleTypeInt16:
+		for i := 0; i+2 <= len(p); i += 2 {
+			dst = append(dst, convertSample[int16, T](int16(order.Uint16(p[i:]))))
+		}
+	case SampleTypeFloat32:
+		for i := 0; i+4 <= len(p); i += 4 {
+			dst = append(dst, convertSample[float32, T](math.Float32frombits(order.Uint32(p[i:]))))
+		}
+	}
+	return dst
+}
+
+// ConvertSamples appends the samples in src to dst converting to U.
+func ConvertSamples[T, U Type](dst []U, src []T) []U {
+	for _, v := range src {
+		dst = append(dst, convertSample[T, U](v))
+	}
+	return dst
+}
+
+func convertSample[T, U Type](v T) U {
+	var u U
+	switch p := any(&u).(type) {
+	case *int16:
+		switch v := any(v).(type) {
+		case int16:
+			*p = v
+		case float32:
+			*p = float32ToInt16(v)
+		}
+	case *float32:
+		*p = toFloat32(v)
+	}
+	return u
+}
@@ -0,0 +1,229 @@
+package pcm
+
+import (
+	"fmt"
+	"math"
+)
+
+// Limiter reduces the gain of a stream of interleaved samples around peaks
+// exceeding the true peak ceiling, with a short look ahead and a smooth
+// release. The output is delayed by the look ahead and the true peak
+// estimation; the delayed frames are returned by Flush.
+type Limiter[T Type] struct {
+	channels int
+	ceiling  float32
+	release  float32
+
+	oversampler *Resampler[float32]
+	// frames not yet returned, and the number of them whose peaks are
+	// measured
+	frames []float32
+	peaked int
+	// oversampled samples of the frames not yet measured
+	oversampled []float32
+
+	// Take the minimum of the gains needed over the window around each frame,
+	// then smooth it by the moving average of half the window. Every frame in
+	// the average window covers the center frame, so the result never
+	// exceeds the gain needed for the center frame.
+	minimum slidingWindow
+	average slidingWindow
+
+	// gains of the frames ready to be returned
+	gains []float32
+	gain  float32
+
+	// buffers of the gains through the stages
+	needed, mins, smoothed []float32
+}
+
+func NewLimiter[T Type](format Format, ceilingDB float64) (*Limiter[T], error) {
+	r, err := NewResampler[float32](format.SampleRate, format.SampleRate*truePeakOversample, format.Channels)
+	if err != nil {
+		return nil, fmt.Errorf("pcm.NewLimiter: %w", err)
+	}
+
+	lookahead := max(1, format.SampleRate*limiterLookaheadMs/1000)
+
+	return &Limiter[T]{
+		channels:    format.Channels,
+		ceiling:     float32(math.Pow(10, ceilingDB/20)),
+		release:     float32(1 - math.Exp(-1/(float64(format.SampleRate)*limiterReleaseMs/1000))),
+		oversampler: r,
+		minimum:     slidingWindow{n: lookahead, f: minimum},
+		average:     slidingWindow{n: lookahead / 2, f: average},
+		gain:        1,
+	}, nil
+}
+
+// Process appends the limited frames of src to dst. src must contain whole
+// frames. Some frames are held back until the frames after them arrive.
+func (l *Limiter[T]) Process(dst, src []T) []T {
+	start := len(l.frames)
+	l.frames = ConvertSamples(l.frames, src)
+	oversampled, err := l.oversampler.Resample(l.oversampled, l.frames[start:])
+	if err != nil {
+		panic("bug: " + err.Error())
+	}
+	l.oversampled = oversampled
+
+	l.measure(false)
+	l.mins = l.minimum.push(l.mins[:0], l.needed...)
+	l.smoothed = l.average.push(l.smoothed[:0], l.mins...)
+	l.applyRelease()
+
+	return l.output(dst)
+}
+
+// Flush appends the remaining frames to dst and resets the limiter.
+func (l *Limiter[T]) Flush(dst []T) []T {
+	l.oversampled = l.oversampler.Flush(l.oversampled)
+
+	l.measure(true)
+	l.mins = l.minimum.flush(l.minimum.push(l.mins[:0], l.needed...))
+	l.smoothed = l.average.flush(l.average.push(l.smoothed[:0], l.mins...))
+	l.applyRelease()
+
+	dst = l.output(dst)
+
+	l.frames = l.frames[:0]
+	l.peaked = 0
+	l.oversampled = l.oversampled[:0]
+	l.gain = 1
+
+	return dst
+}
+
+// measure sets the gains needed for the frames whose oversampled samples are
+// ready, or for all the frames if final is true.
+func (l *Limiter[T]) measure(final bool) {
+	channels := l.channels
+	oversampled := truePeakOversample * channels
+	frames := len(l.frames) / channels
+
+	l.needed = l.needed[:0]
+	var j int
+	for ; l.peaked < frames; l.peaked++ {
+		if !final && len(l.oversampled)-j < oversampled {
+			break
+		}
+
+		var peak float32
+		for _, v := range l.frames[l.peaked*channels : (l.peaked+1)*channels] {
+			peak = max(peak, abs32(v))
+		}
+		end := min(len(l.oversampled), j+oversampled)
+		for _, v := range l.oversampled[j:end] {
+			peak = max(peak, abs32(v))
+		}
+		j = end
+
+		gain := float32(1)
+		if peak > l.ceiling {
+			gain = l.ceiling / peak
+		}
+		l.needed = append(l.needed, gain)
+	}
+
+	if final {
+		j = len(l.oversampled)
+	}
+	l.oversampled = l.oversampled[:copy(l.oversampled, l.oversampled[j:])]
+}
+
+func (l *Limiter[T]) applyRelease() {
+	for _, target := range l.smoothed {
+		if target < l.gain {
+			l.gain = target
+		} else {
+			l.gain += (target - l.gain) * l.release
+		}
+		l.gains = append(l.gains, l.gain)
+	}
+}
+
+func (l *Limiter[T]) output(dst []T) []T {
+	channels := l.channels
+	n := len(l.gains)
+
+	for i, g := range l.gains {
+		for _, v := range l.frames[i*channels : (i+1)*channels] {
+			dst = append(dst, fromFloat32[T](v*g))
+		}
+	}
+
+	l.frames = l.frames[:copy(l.frames, l.frames[n*channels:])]
+	l.peaked -= n
+	l.gains = l.gains[:0]
+
+	return dst
+}
+
+// Limit reduces the gain of the interleaved samples in place around peaks
+// exceeding the true peak ceiling in dBTP. See Limiter for the details.
+func Limit[T Type](data []T, format Format, ceilingDB float64) {
+	l, err := NewLimiter[T](format, ceilingDB)
+	if err != nil {
+		panic("bug: " + err.Error())
+	}
+
+	limited := l.Process(make([]T, 0, len(data)), data)
+	copy(data, l.Flush(limited))
+}
+
+// slidingWindow applies f to values[i-n:i+n+1] of a stream of values for
+// each i. The stream is extended by its first and last values at the edges,
+// so the output is delayed by n values until flush.
+type slidingWindow struct {
+	n      int
+	f      func(values []float32) float32
+	values []float32
+}
+
+func (w *slidingWindow) push(dst []float32, values ...float32) []float32 {
+	for _, v := range values {
+		if len(w.values) == 0 {
+			for i := 0; i < w.n; i++ {
+				w.values = append(w.values, v)
+			}
+		}
+
+		w.values = append(w.values, v)
+		if len(w.values) > 2*w.n {
+			dst = append(dst, w.f(w.values))
+			w.values = w.values[:copy(w.values, w.values[1:])]
+		}
+	}
+
+	return dst
+}
+
+func (w *slidingWindow) flush(dst []float32) []float32 {
+	if len(w.values) == 0 {
+		return dst
+	}
+
+	last := w.values[len(w.values)-1]
+	for i := 0; i < w.n; i++ {
+		dst = w.push(dst, last)
+	}
+	w.values = w.values[:0]
+
+	return dst
+}
+
+func minimum(values []float32) float32 {
+	m := values[0]
+	for _, v := range values[1:] {
+		m = min(m, v)
+	}
+	return m
+}
+
+func average(values []float32) float32 {
+	var s float64
+	for _, v := range values {
+		s += float64(v)
+	}
+	return float32(s / float64(len(values)))
+}
@@ -0,0 +1,94 @@
+package pcm
+
+import (
+	"math"
+	"testing"
+)
+
+func makeLoudPeaks(rate, frames int) []float32 {
+	sine := makeSine(rate, 440, frames, 0.5)
+	for i := frames / 4; i < frames/4+rate/100; i++ {
+		sine[i] *= 3
+	}
+	for i := frames / 2; i < frames/2+rate/50; i++ {
+		sine[i] *= 2
+	}
+	return sine
+}
+
+func TestLimiter(t *testing.T) {
+	format := Format{SampleRate: 48000, Channels: 2, SampleType: SampleTypeFloat32}
+	sine := makeLoudPeaks(48000, 48000)
+	data := Interleave(nil, sine, sine)
+
+	const ceiling = -1.0
+	Limit(data, format, ceiling)
+
+	if got := TruePeak(data, format); got > ceiling+0.1 {
+		t.Errorf("TruePeak(): got %.2f dBTP, want <= %.2f dBTP", got, ceiling)
+	}
+
+	// the quiet part before the peaks is untouched
+	for i := 0; i < 48000/8; i++ {
+		if data[i*2] != sine[i] {
+			t.Fatalf("sample %d: got %v, want %v", i, data[i*2], sine[i])
+		}
+	}
+}
+
+func TestLimiterStreaming(t *testing.T) {
+	format := Format{SampleRate: 48000, Channels: 1, SampleType: SampleTypeFloat32}
+	in := makeLoudPeaks(48000, 24000)
+
+	want := make([]float32, len(in))
+	copy(want, in)
+	Limit(want, format, -1)
+
+	l, err := NewLimiter[float32](format, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []float32
+	for i, size := 0, 1; i < len(in); i, size = i+size, size*2%997+1 {
+		got = l.Process(got, in[i:min(len(in), i+size)])
+	}
+	got = l.Flush(got)
+
+	if len(got) != len(want) {
+		t.Fatalf("output length: got %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if math.Abs(float64(got[i]-want[i])) > 1e-6 {
+			t.Fatalf("sample %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	tests := []struct {
+		n      int
+		values []float32
+		want   []float32
+	}{
+		{1, []float32{3, 1, 4, 1, 5, 9, 2}, []float32{1, 1, 1, 1, 1, 2, 2}},
+		{2, []float32{3, 1, 4, 1, 5, 9, 2}, []float32{1, 1, 1, 1, 1, 1, 2}},
+		{0, []float32{3, 1, 4}, []float32{3, 1, 4}},
+		{3, []float32{5}, []float32{5}},
+		{2, nil, nil},
+	}
+
+	for _, tt := range tests {
+		w := slidingWindow{n: tt.n, f: minimum}
+		got := w.flush(w.push(nil, tt.values...))
+
+		if len(got) != len(tt.want) {
+			t.Fatalf("slidingWindow(n=%d, %v): got %v, want %v", tt.n, tt.values, got, tt.want)
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("slidingWindow(n=%d, %v): got %v, want %v", tt.n, tt.values, got, tt.want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,395 @@
+package pcm
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	DefaultLoudnessTarget = -18.0 // LUFS
+	DefaultTruePeak       = -1.0  // dBTP
+	DefaultMaxGain        = 20.0  // dB
+)
+
+const (
+	// gating block of ITU-R BS.1770
+	loudnessBlockMs    = 400
+	loudnessStepMs     = 100
+	absoluteGateLUFS   = -70.0
+	relativeGateLU     = -10.0
+	truePeakOversample = 4
+
+	limiterLookaheadMs = 2
+	limiterReleaseMs   = 50
+
+	// time constant of the gain following the loudness of a stream
+	loudnessSmoothingMs = 500
+)
+
+// biquad is a second order IIR filter in direct form I.
+type biquad struct {
+	b0, b1, b2 float64
+	a1, a2     float64
+
+	x1, x2 float64
+	y1, y2 float64
+}
+
+func (f *biquad) process(x float64) float64 {
+	y := f.b0*x + f.b1*f.x1 + f.b2*f.x2 - f.a1*f.y1 - f.a2*f.y2
+	f.x2, f.x1 = f.x1, x
+	f.y2, f.y1 = f.y1, y
+	return y
+}
+
+// newKWeightingFilters returns the pre-filter and the RLB weighting filter
+// of ITU-R BS.1770 designed for the sample rate.
+func newKWeightingFilters(sampleRate int) (*biquad, *biquad) {
+	fs := float64(sampleRate)
+
+	// high shelf
+	f0 := 1681.974450955533
+	gain := 3.999843853973347
+	q := 0.7071752369554196
+
+	k := math.Tan(math.Pi * f0 / fs)
+	vh := math.Pow(10, gain/20)
+	vb := math.Pow(vh, 0.4996667741545416)
+	a0 := 1 + k/q + k*k
+	shelf := &biquad{
+		b0: (vh + vb*k/q + k*k) / a0,
+		b1: 2 * (k*k - vh) / a0,
+		b2: (vh - vb*k/q + k*k) / a0,
+		a1: 2 * (k*k - 1) / a0,
+		a2: (1 - k/q + k*k) / a0,
+	}
+
+	// high pass
+	f0 = 38.13547087602444
+	q = 0.5003270373238773
+
+	k = math.Tan(math.Pi * f0 / fs)
+	a0 = 1 + k/q + k*k
+	highPass := &biquad{
+		b0: 1,
+		b1: -2,
+		b2: 1,
+		a1: 2 * (k*k - 1) / a0,
+		a2: (1 - k/q + k*k) / a0,
+	}
+
+	return shelf, highPass
+}
+
+// LoudnessMeter measures the integrated loudness of a stream of interleaved
+// samples. See Loudness for the details.
+type LoudnessMeter[T Type] struct {
+	sampleRate int
+	channels   int
+	stepFrames int
+
+	// K-weighting filters of each channel
+	filters [][2]*biquad
+
+	// mean square of the K-weighted signal summed over channels, per step
+	steps []float64
+	// power and frames of the current step
+	power  float64
+	frames int
+}
+
+func NewLoudnessMeter[T Type](format Format) *LoudnessMeter[T] {
+	m := &LoudnessMeter[T]{
+		sampleRate: format.SampleRate,
+		channels:   format.Channels,
+		stepFrames: format.SampleRate * loudnessStepMs / 1000,
+	}
+	m.Reset()
+	return m
+}
+
+// Reset clears the measurement to start a new stream.
+func (m *LoudnessMeter[T]) Reset() {
+	m.filters = make([][2]*biquad, m.channels)
+	for ch := range m.filters {
+		shelf, highPass := newKWeightingFilters(m.sampleRate)
+		m.filters[ch] = [2]*biquad{shelf, highPass}
+	}
+	m.steps = m.steps[:0]
+	m.power = 0
+	m.frames = 0
+}
+
+// Write measures the frames of data.
+func (m *LoudnessMeter[T]) Write(data []T) {
+	channels := m.channels
+
+	for i := 0; i+channels <= len(data); i += channels {
+		for ch, f := range m.filters {
+			y := f[1].process(f[0].process(float64(toFloat32(data[i+ch]))))
+			m.power += y * y
+		}
+
+		m.frames++
+		if m.frames == m.stepFrames {
+			m.steps = append(m.steps, m.power)
+			m.power = 0
+			m.frames = 0
+		}
+	}
+}
+
+// Integrated returns the integrated loudness of the frames written so far
+// in LUFS. It returns -Inf for silence.
+func (m *LoudnessMeter[T]) Integrated() float64 {
+	steps := m.steps
+	frames := len(steps)*m.stepFrames + m.frames
+	if frames == 0 {
+		return math.Inf(-1)
+	}
+	if m.frames > 0 {
+		steps = append(steps[:len(steps):len(steps)], m.power)
+	}
+
+	// overlapping gating blocks made of consecutive steps
+	blockSteps := loudnessBlockMs / loudnessStepMs
+	var blocks []float64
+	if len(steps) < blockSteps {
+		// shorter than a block, measure as a whole
+		blocks = append(blocks, sum(steps)/float64(frames))
+	} else {
+		for i := 0; i+blockSteps <= len(steps); i++ {
+			blockFrames := min(frames, (i+blockSteps)*m.stepFrames) - i*m.stepFrames
+			blocks = append(blocks, sum(steps[i:i+blockSteps])/float64(blockFrames))
+		}
+	}
+
+	gated := gateBlocks(blocks, absoluteGateLUFS)
+	if len(gated) == 0 {
+		return math.Inf(-1)
+	}
+	gated = gateBlocks(gated, powerToLUFS(mean(gated))+relativeGateLU)
+	if len(gated) == 0 {
+		return math.Inf(-1)
+	}
+
+	return powerToLUFS(mean(gated))
+}
+
+// Loudness returns the integrated loudness of the interleaved samples in
+// LUFS as defined in ITU-R BS.1770 / EBU R128. All channels are weighted
+// equally. It returns -Inf for silence.
+func Loudness[T Type](data []T, format Format) float64 {
+	m := NewLoudnessMeter[T](format)
+	m.Write(data)
+	return m.Integrated()
+}
+
+func gateBlocks(blocks []float64, threshold float64) []float64 {
+	var gated []float64
+	for _, p := range blocks {
+		if powerToLUFS(p) > threshold {
+			gated = append(gated, p)
+		}
+	}
+	return gated
+}
+
+func powerToLUFS(p float64) float64 {
+	return -0.691 + 10*math.Log10(p)
+}
+
+func sum(values []float64) float64 {
+	var s float64
+	for _, v := range values {
+		s += v
+	}
+	return s
+}
+
+func mean(values []float64) float64 {
+	return sum(values) / float64(len(values))
+}
+
+// TruePeak returns the true peak of the interleaved samples in dBTP,
+// estimated by 4x oversampling.
+func TruePeak[T Type](data []T, format Format) float64 {
+	peaks := framePeaks(data, format)
+	var peak float32
+	for _, p := range peaks {
+		peak = max(peak, p)
+	}
+	return 20 * math.Log10(float64(peak))
+}
+
+// framePeaks returns the oversampled peak amplitude of each frame.
+func framePeaks[T Type](data []T, format Format) []float32 {
+	channels := format.Channels
+	frames := len(data) / channels
+
+	r, err := NewResampler[float32](format.SampleRate, format.SampleRate*truePeakOversample, channels)
+	if err != nil {
+		panic("bug: " + err.Error())
+	}
+	src := ConvertSamples[T, float32](make([]float32, 0, len(data)), data)
+	oversampled, err := r.Resample(make([]float32, 0, len(src)*truePeakOversample), src)
+	if err != nil {
+		panic("bug: " + err.Error())
+	}
+	oversampled = r.Flush(oversampled)
+
+	peaks := make([]float32, frames)
+	for i := range peaks {
+		for _, v := range src[i*channels : (i+1)*channels] {
+			peaks[i] = max(peaks[i], abs32(v))
+		}
+		for j := i * truePeakOversample * channels; j < min(len(oversampled), (i+1)*truePeakOversample*channels); j++ {
+			peaks[i] = max(peaks[i], abs32(oversampled[j]))
+		}
+	}
+
+	return peaks
+}
+
+func abs32(v float32) float32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+type LoudnessOptions struct {
+	// Target is the integrated loudness to normalize to in LUFS.
+	Target float64
+	// TruePeak is the ceiling of the true peak in dBTP.
+	TruePeak float64
+	// MaxGain limits the gain in dB so that quiet noise is not boosted.
+	MaxGain float64
+}
+
+func DefaultLoudnessOptions() LoudnessOptions {
+	return LoudnessOptions{
+		Target:   DefaultLoudnessTarget,
+		TruePeak: DefaultTruePeak,
+		MaxGain:  DefaultMaxGain,
+	}
+}
+
+// LoudnessNormalizer applies a gain to a stream of interleaved samples so
+// that the loudness reaches the target, and then limits peaks to the true
+// peak ceiling. The gain is decided by the loudness of the frames measured
+// so far: the output is held back until the window is measured, and the gain
+// then follows the integrated loudness smoothly as the stream goes on.
+type LoudnessNormalizer[T Type] struct {
+	opts     LoudnessOptions
+	channels int
+	// frames measured before the first output
+	window    int
+	smoothing float32
+
+	meter   *LoudnessMeter[T]
+	limiter *Limiter[float32]
+
+	started bool
+	pending []T
+	gainDB  float64
+	gain    float32
+
+	buf, limited []float32
+}
+
+func NewLoudnessNormalizer[T Type](format Format, opts LoudnessOptions, window time.Duration) (*LoudnessNormalizer[T], error) {
+	limiter, err := NewLimiter[float32](format, opts.TruePeak)
+	if err != nil {
+		return nil, fmt.Errorf("pcm.NewLoudnessNormalizer: %w", err)
+	}
+
+	return &LoudnessNormalizer[T]{
+		opts:      opts,
+		channels:  format.Channels,
+		window:    int(int64(format.SampleRate) * int64(window) / int64(time.Second)),
+		smoothing: float32(1 - math.Exp(-1/(float64(format.SampleRate)*loudnessSmoothingMs/1000))),
+		meter:     NewLoudnessMeter[T](format),
+		limiter:   limiter,
+	}, nil
+}
+
+// Gain returns the last gain in dB, not including the gain reduction by the
+// limiter.
+func (n *LoudnessNormalizer[T]) Gain() float64 {
+	return n.gainDB
+}
+
+// Normalize appends the normalized frames of src to dst. Frames are held
+// back until the window is measured, and by the limiter.
+func (n *LoudnessNormalizer[T]) Normalize(dst, src []T) []T {
+	n.meter.Write(src)
+	n.pending = append(n.pending, src...)
+
+	if !n.started && len(n.pending) < n.window*n.channels {
+		return dst
+	}
+
+	return n.process(dst)
+}
+
+// Flush appends the remaining frames to dst and resets the normalizer.
+func (n *LoudnessNormalizer[T]) Flush(dst []T) []T {
+	if len(n.pending) > 0 {
+		dst = n.process(dst)
+	}
+
+	n.limited = n.limiter.Flush(n.limited[:0])
+	dst = ConvertSamples(dst, n.limited)
+
+	n.meter.Reset()
+	n.started = false
+
+	return dst
+}
+
+func (n *LoudnessNormalizer[T]) process(dst []T) []T {
+	channels := n.channels
+
+	n.gainDB = 0
+	if loudness := n.meter.Integrated(); !math.IsInf(loudness, -1) {
+		n.gainDB = min(n.opts.Target-loudness, n.opts.MaxGain)
+	}
+	target := float32(math.Pow(10, n.gainDB/20))
+	if !n.started {
+		n.gain = target
+		n.started = true
+	}
+
+	// process in float32, so that int16 samples are not clipped before
+	// limiting
+	n.buf = n.buf[:0]
+	for i := 0; i+channels <= len(n.pending); i += channels {
+		n.gain += (target - n.gain) * n.smoothing
+		for _, v := range n.pending[i : i+channels] {
+			n.buf = append(n.buf, toFloat32(v)*n.gain)
+		}
+	}
+	n.pending = n.pending[:0]
+
+	n.limited = n.limiter.Process(n.limited[:0], n.buf)
+	return ConvertSamples(dst, n.limited)
+}
+
+// NormalizeLoudness applies a gain to the interleaved samples in place so
+// that the integrated loudness reaches the target, and then limits peaks to
+// the true peak ceiling so that the samples will not clip. It returns the
+// applied gain in dB, not including the gain reduction by the limiter.
+func NormalizeLoudness[T Type](data []T, format Format, opts LoudnessOptions) float64 {
+	// the whole data is measured before the output
+	n, err := NewLoudnessNormalizer[T](format, opts, 0)
+	if err != nil {
+		panic("bug: " + err.Error())
+	}
+
+	normalized := n.Normalize(make([]T, 0, len(data)), data)
+	copy(data, n.Flush(normalized))
+
+	return n.Gain()
+}
@@ -0,0 +1,133 @@
+package pcm
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLoudnessSine(t *testing.T) {
+	// A 0 dBFS sine wave of 1 kHz in a channel reads -3.01 LUFS.
+	tests := []struct {
+		amp  float64
+		want float64
+	}{
+		{1.0, -3.01},
+		{0.1, -23.01},
+	}
+
+	for _, tt := range tests {
+		data := makeSine(48000, 1000, 48000*3, tt.amp)
+		format := Format{SampleRate: 48000, Channels: 1, SampleType: SampleTypeFloat32}
+
+		got := Loudness(data, format)
+		if math.Abs(got-tt.want) > 0.05 {
+			t.Errorf("Loudness(amp=%.1f): got %.2f LUFS, want %.2f LUFS", tt.amp, got, tt.want)
+		}
+	}
+}
+
+func TestLoudnessGating(t *testing.T) {
+	format := Format{SampleRate: 48000, Channels: 1, SampleType: SampleTypeFloat32}
+
+	// silence between speech must not lower the loudness, except for the
+	// blocks at the edges of the silence
+	data := makeSine(48000, 1000, 48000*2, 0.1)
+	data = append(data, make([]float32, 48000*4)...)
+	data = append(data, makeSine(48000, 1000, 48000*2, 0.1)...)
+
+	got := Loudness(data, format)
+	if math.Abs(got-(-23.01)) > 0.5 {
+		t.Errorf("Loudness(): got %.2f LUFS, want -23.01 LUFS", got)
+	}
+
+	if got := Loudness(make([]float32, 48000), format); !math.IsInf(got, -1) {
+		t.Errorf("Loudness(silence): got %.2f LUFS, want -Inf", got)
+	}
+}
+
+func TestNormalizeLoudness(t *testing.T) {
+	format := Format{SampleRate: 48000, Channels: 1, SampleType: SampleTypeInt16}
+
+	sine := makeSine(48000, 440, 48000*2, 0.05)
+	data := ConvertSamples[float32, int16](nil, sine)
+
+	opts := DefaultLoudnessOptions()
+	NormalizeLoudness(data, format, opts)
+
+	if got := Loudness(data, format); math.Abs(got-opts.Target) > 0.1 {
+		t.Errorf("Loudness(): got %.2f LUFS, want %.2f LUFS", got, opts.Target)
+	}
+}
+
+func TestNormalizeLoudnessLimit(t *testing.T) {
+	format := Format{SampleRate: 48000, Channels: 2, SampleType: SampleTypeInt16}
+
+	// a quiet signal with loud peaks needs a gain which would clip the peaks
+	sine := makeSine(48000, 440, 48000, 0.02)
+	for i := 10000; i < 10480; i++ {
+		sine[i] *= 40
+	}
+	data := ConvertSamples[float32, int16](nil, Interleave(nil, sine, sine))
+
+	opts := DefaultLoudnessOptions()
+	opts.Target = -6
+	gain := NormalizeLoudness(data, format, opts)
+	if gain < 6 {
+		t.Fatalf("NormalizeLoudness(): got gain %.1f dB, want >= 6 dB", gain)
+	}
+
+	if got := TruePeak(data, format); got > opts.TruePeak+0.1 {
+		t.Errorf("TruePeak(): got %.2f dBTP, want <= %.2f dBTP", got, opts.TruePeak)
+	}
+}
+
+func TestLoudnessMeterStreaming(t *testing.T) {
+	format := Format{SampleRate: 48000, Channels: 1, SampleType: SampleTypeFloat32}
+	data := makeSine(48000, 1000, 48000*2+1234, 0.1)
+
+	want := Loudness(data, format)
+
+	m := NewLoudnessMeter[float32](format)
+	for i, size := 0, 1; i < len(data); i, size = i+size, size*2%4999+1 {
+		m.Write(data[i:min(len(data), i+size)])
+	}
+	if got := m.Integrated(); math.Abs(got-want) > 1e-9 {
+		t.Errorf("LoudnessMeter.Integrated(): got %v LUFS, want %v LUFS", got, want)
+	}
+}
+
+func TestLoudnessNormalizerStreaming(t *testing.T) {
+	format := Format{SampleRate: 48000, Channels: 1, SampleType: SampleTypeInt16}
+
+	sine := makeSine(48000, 440, 48000*3, 0.05)
+	data := ConvertSamples[float32, int16](nil, sine)
+
+	opts := DefaultLoudnessOptions()
+	n, err := NewLoudnessNormalizer[int16](format, opts, 400*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// nothing comes out until the window is measured
+	chunk := 48000 / 50
+	if got := n.Normalize(nil, data[:chunk]); len(got) != 0 {
+		t.Errorf("Normalize(): got %d samples before the window, want 0", len(got))
+	}
+
+	got := n.Normalize(nil, data[chunk:48000])
+	if len(got) == 0 {
+		t.Fatal("Normalize(): got no samples after the window")
+	}
+	for i := 48000; i < len(data); i += chunk {
+		got = n.Normalize(got, data[i:min(len(data), i+chunk)])
+	}
+	got = n.Flush(got)
+
+	if len(got) != len(data) {
+		t.Fatalf("output length: got %d, want %d", len(got), len(data))
+	}
+	if got := Loudness(got, format); math.Abs(got-opts.Target) > 0.1 {
+		t.Errorf("Loudness(): got %.2f LUFS, want %.2f LUFS", got, opts.Target)
+	}
+}
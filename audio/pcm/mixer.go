@@ -0,0 +1,158 @@
+package pcm
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	DefaultDucking        = -12.0 // dB
+	DefaultDuckingAttack  = 50 * time.Millisecond
+	DefaultDuckingRelease = 500 * time.Millisecond
+
+	// fade at the seam of a loop
+	loopFadeMs = 10
+)
+
+// Source is an endless stream of interleaved samples.
+type Source[T Type] interface {
+	// Read fills dst with the next samples.
+	Read(dst []T)
+}
+
+// Loop is a Source repeating the samples endlessly.
+type Loop[T Type] struct {
+	data []T
+	pos  int
+}
+
+// NewLoop returns a Loop of a copy of the interleaved samples. The edges are
+// faded so that the seam does not click.
+func NewLoop[T Type](data []T, format Format) *Loop[T] {
+	channels := format.Channels
+	frames := len(data) / channels
+	data = append([]T(nil), data[:frames*channels]...)
+
+	fade := min(frames/2, format.SampleRate*loopFadeMs/1000)
+	for i := 0; i < fade; i++ {
+		g := float32(i) / float32(fade)
+		for ch := 0; ch < channels; ch++ {
+			head := i*channels + ch
+			tail := (frames-1-i)*channels + ch
+			data[head] = fromFloat32[T](toFloat32(data[head]) * g)
+			data[tail] = fromFloat32[T](toFloat32(data[tail]) * g)
+		}
+	}
+
+	return &Loop[T]{data: data}
+}
+
+func (l *Loop[T]) Read(dst []T) {
+	if len(l.data) == 0 {
+		clear(dst)
+		return
+	}
+
+	for len(dst) > 0 {
+		n := copy(dst, l.data[l.pos:])
+		dst = dst[n:]
+		l.pos = (l.pos + n) % len(l.data)
+	}
+}
+
+type MixerOptions struct {
+	// Volume is the linear gain of the background.
+	Volume float64
+	// Ducking is the gain in dB applied to the background while the
+	// foreground is active.
+	Ducking float64
+	// Attack and Release are the time constants of the ducking.
+	Attack  time.Duration
+	Release time.Duration
+}
+
+func DefaultMixerOptions() MixerOptions {
+	return MixerOptions{
+		Volume:  1,
+		Ducking: DefaultDucking,
+		Attack:  DefaultDuckingAttack,
+		Release: DefaultDuckingRelease,
+	}
+}
+
+// Mixer mixes a background source under foreground audio such as speech.
+// The background is ducked while the foreground is active, and the gain
+// changes smoothly, including the changes of the volume.
+type Mixer[T Type] struct {
+	channels   int
+	background Source[T]
+
+	volume  float32
+	ducking float32
+	attack  float32
+	release float32
+
+	gain float32
+	buf  []T
+}
+
+func NewMixer[T Type](format Format, background Source[T], opts MixerOptions) *Mixer[T] {
+	return &Mixer[T]{
+		channels:   format.Channels,
+		background: background,
+		volume:     float32(opts.Volume),
+		ducking:    float32(math.Pow(10, opts.Ducking/20)),
+		attack:     smoothingCoefficient(format.SampleRate, opts.Attack),
+		release:    smoothingCoefficient(format.SampleRate, opts.Release),
+		gain:       float32(opts.Volume),
+	}
+}
+
+func smoothingCoefficient(sampleRate int, d time.Duration) float32 {
+	if d <= 0 {
+		return 1
+	}
+	return float32(1 - math.Exp(-1/(float64(sampleRate)*d.Seconds())))
+}
+
+// SetVolume changes the linear gain of the background.
+func (m *Mixer[T]) SetVolume(v float64) {
+	m.volume = float32(v)
+}
+
+// Mix appends the frames of the foreground mixed with the background to
+// dst. A nil foreground means it is inactive, and the given number of frames
+// of the background alone are appended.
+func (m *Mixer[T]) Mix(dst, foreground []T, frames int) []T {
+	target := m.volume
+	if foreground != nil {
+		frames = len(foreground) / m.channels
+		target *= m.ducking
+	}
+
+	if n := frames * m.channels; cap(m.buf) < n {
+		m.buf = make([]T, n)
+	} else {
+		m.buf = m.buf[:n]
+	}
+	m.background.Read(m.buf)
+
+	for i := 0; i < frames; i++ {
+		if target < m.gain {
+			m.gain += (target - m.gain) * m.attack
+		} else {
+			m.gain += (target - m.gain) * m.release
+		}
+
+		for ch := 0; ch < m.channels; ch++ {
+			j := i*m.channels + ch
+			v := toFloat32(m.buf[j]) * m.gain
+			if foreground != nil {
+				v += toFloat32(foreground[j])
+			}
+			dst = append(dst, fromFloat32[T](v))
+		}
+	}
+
+	return dst
+}
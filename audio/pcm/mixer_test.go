@@ -0,0 +1,91 @@
+package pcm
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoop(t *testing.T) {
+	format := Format{SampleRate: 1000, Channels: 2, SampleType: SampleTypeFloat32}
+	data := []float32{1, -1, 1, -1, 1, -1, 1, -1}
+
+	// too short for the fade to reach inside
+	l := NewLoop(data, format)
+	want := []float32{0, 0, 0.5, -0.5, 0.5, -0.5, 0, 0}
+
+	got := make([]float32, 20)
+	l.Read(got[:3*2])
+	l.Read(got[3*2:])
+
+	for i := range got {
+		if w := want[i%len(want)]; got[i] != w {
+			t.Fatalf("Loop.Read(): got %v, want repeated %v", got, want)
+		}
+	}
+
+	// the source data is not modified
+	if diff := cmp.Diff([]float32{1, -1, 1, -1, 1, -1, 1, -1}, data); diff != "" {
+		t.Errorf("data mismatch (-want +got):\n%s", diff)
+	}
+}
+
+type constSource float32
+
+func (s constSource) Read(dst []float32) {
+	for i := range dst {
+		dst[i] = float32(s)
+	}
+}
+
+func TestMixerDucking(t *testing.T) {
+	format := Format{SampleRate: 48000, Channels: 1, SampleType: SampleTypeFloat32}
+	opts := MixerOptions{
+		Volume:  0.5,
+		Ducking: -20,
+		Attack:  10 * time.Millisecond,
+		Release: 100 * time.Millisecond,
+	}
+	m := NewMixer[float32](format, constSource(1), opts)
+
+	near := func(got, want float64) bool {
+		return math.Abs(got-want) < 1e-3
+	}
+
+	// background alone
+	out := m.Mix(nil, nil, 480)
+	if got := float64(out[len(out)-1]); !near(got, 0.5) {
+		t.Errorf("background: got %v, want 0.5", got)
+	}
+
+	// ducked after the attack
+	fg := make([]float32, 4800)
+	for i := range fg {
+		fg[i] = 0.25
+	}
+	out = m.Mix(nil, fg, 0)
+	if len(out) != len(fg) {
+		t.Fatalf("Mix(): got %d samples, want %d", len(out), len(fg))
+	}
+	if got := float64(out[len(out)-1]); !near(got, 0.25+0.05) {
+		t.Errorf("ducked: got %v, want 0.3", got)
+	}
+
+	// released slowly
+	out = m.Mix(nil, nil, 480)
+	if got := float64(out[len(out)-1]); got >= 0.5 || got <= 0.05 {
+		t.Errorf("releasing: got %v, want between 0.05 and 0.5", got)
+	}
+	out = m.Mix(nil, nil, 48000)
+	if got := float64(out[len(out)-1]); !near(got, 0.5) {
+		t.Errorf("released: got %v, want 0.5", got)
+	}
+
+	m.SetVolume(0.1)
+	out = m.Mix(nil, nil, 48000)
+	if got := float64(out[len(out)-1]); !near(got, 0.1) {
+		t.Errorf("volume: got %v, want 0.1", got)
+	}
+}
@@ -0,0 +1,259 @@
+package pcm
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	// number of zero crossings on each side of the sinc kernel
+	resampleZeroCrossings = 16
+	// Kaiser window parameter, about 80 dB of stopband attenuation
+	resampleKaiserBeta = 8.0
+	// relative cutoff frequency to leave room for the transition band
+	resampleCutoff = 0.95
+	// maximum number of the filter phases, which is the output rate divided
+	// by the GCD of the rates
+	maxResamplePhases = 1024
+)
+
+var ErrInvalidSampleRate = errors.New("pcm: invalid sample rate")
+
+// Resampler converts the sample rate of an interleaved stream using a
+// polyphase windowed-sinc filter. Input can be given in chunks of any number
+// of frames; the filter state is carried over between calls.
+type Resampler[T Type] struct {
+	inRate   int
+	outRate  int
+	channels int
+
+	// output rate / input rate = up / down
+	up   int
+	down int
+
+	// half length of the filter in input samples
+	half int
+	// filter coefficients for each phase
+	filter [][]float32
+
+	// interleaved input samples, including the history needed by the filter
+	buf []float32
+	// frame index in buf of the input at or just before the next output
+	index int
+	// sub-sample position of the next output in 1/up units
+	phase int
+
+	inFrames  int64
+	outFrames int64
+}
+
+func NewResampler[T Type](inRate, outRate, channels int) (*Resampler[T], error) {
+	if inRate <= 0 || outRate <= 0 {
+		return nil, fmt.Errorf("pcm.NewResampler: %w: %d -> %d", ErrInvalidSampleRate, inRate, outRate)
+	}
+	if channels <= 0 {
+		return nil, fmt.Errorf("pcm.NewResampler: %w: %d", ErrInvalidChannels, channels)
+	}
+
+	d := gcd(inRate, outRate)
+	r := &Resampler[T]{
+		inRate:   inRate,
+		outRate:  outRate,
+		channels: channels,
+		up:       outRate / d,
+		down:     inRate / d,
+	}
+	if r.up > maxResamplePhases {
+		return nil, fmt.Errorf("pcm.NewResampler: %w: %d -> %d needs %d filter phases", ErrInvalidSampleRate, inRate, outRate, r.up)
+	}
+
+	if r.up != r.down {
+		r.buildFilter()
+	}
+	r.Reset()
+
+	return r, nil
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func (r *Resampler[T]) buildFilter() {
+	// widen the kernel when downsampling, so that it also works as an
+	// anti-aliasing filter
+	scale := min(1.0, float64(r.up)/float64(r.down)) * resampleCutoff
+	r.half = int(math.Ceil(resampleZeroCrossings / scale))
+
+	taps := 2 * r.half
+	r.filter = make([][]float32, r.up)
+	for p := range r.filter {
+		coefs := make([]float32, taps)
+		offset := float64(p) / float64(r.up)
+		var sum float64
+		for k := range coefs {
+			// distance from the output position in input samples
+			x := float64(k-r.half+1) - offset
+			v := scale * sinc(scale*x) * kaiser(x/float64(r.half), resampleKaiserBeta)
+			coefs[k] = float32(v)
+			sum += v
+		}
+		// normalize DC gain of each phase
+		for k := range coefs {
+			coefs[k] = float32(float64(coefs[k]) / sum)
+		}
+		r.filter[p] = coefs
+	}
+}
+
+func sinc(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	x *= math.Pi
+	return math.Sin(x) / x
+}
+
+func kaiser(x, beta float64) float64 {
+	if x <= -1 || x >= 1 {
+		return 0
+	}
+	return besselI0(beta*math.Sqrt(1-x*x)) / besselI0(beta)
+}
+
+// besselI0 returns the zeroth order modified Bessel function of the first
+// kind.
+func besselI0(x float64) float64 {
+	sum, term := 1.0, 1.0
+	for k := 1; k < 50; k++ {
+		term *= (x / (2 * float64(k))) * (x / (2 * float64(k)))
+		sum += term
+		if term < sum*1e-12 {
+			break
+		}
+	}
+	return sum
+}
+
+func (r *Resampler[T]) InRate() int {
+	return r.inRate
+}
+
+func (r *Resampler[T]) OutRate() int {
+	return r.outRate
+}
+
+func (r *Resampler[T]) Channels() int {
+	return r.channels
+}
+
+// Reset clears the filter state to start a new stream.
+func (r *Resampler[T]) Reset() {
+	// the stream is preceded by silence
+	r.buf = make([]float32, r.half*r.channels, 4096*r.channels)
+	r.index = r.half
+	r.phase = 0
+	r.inFrames = 0
+	r.outFrames = 0
+}
+
+// Resample appends the frames resampled from src to dst and returns the
+// extended slice. src must contain whole frames. Some output frames are held
+// back until enough input frames arrive; call Flush at the end of the stream
+// to get them.
+func (r *Resampler[T]) Resample(dst, src []T) ([]T, error) {
+	if len(src)%r.channels != 0 {
+		return dst, fmt.Errorf("pcm.Resampler.Resample: %w: %d samples of %d channels", ErrPartialFrame, len(src), r.channels)
+	}
+
+	if r.up == r.down {
+		return append(dst, src...), nil
+	}
+
+	for _, v := range src {
+		r.buf = append(r.buf, toFloat32(v))
+	}
+	r.inFrames += int64(len(src) / r.channels)
+
+	return r.process(dst, -1), nil
+}
+
+// Flush appends the remaining output samples to dst and resets the
+// resampler.
+func (r *Resampler[T]) Flush(dst []T) []T {
+	if r.up == r.down {
+		return dst
+	}
+
+	// the stream is followed by silence
+	r.buf = append(r.buf, make([]float32, r.half*r.channels)...)
+
+	total := (r.inFrames*int64(r.up) + int64(r.down) - 1) / int64(r.down)
+	dst = r.process(dst, total-r.outFrames)
+
+	r.Reset()
+
+	return dst
+}
+
+func (r *Resampler[T]) process(dst []T, limit int64) []T {
+	channels := r.channels
+	frames := len(r.buf) / channels
+
+	for r.index+r.half < frames && limit != 0 {
+		coefs := r.filter[r.phase]
+		window := r.buf[(r.index-r.half+1)*channels : (r.index+r.half+1)*channels]
+
+		for ch := 0; ch < channels; ch++ {
+			var v float32
+			for k, c := range coefs {
+				v += c * window[k*channels+ch]
+			}
+			dst = append(dst, fromFloat32[T](v))
+		}
+		r.outFrames++
+		if limit > 0 {
+			limit--
+		}
+
+		r.phase += r.down
+		r.index += r.phase / r.up
+		r.phase %= r.up
+	}
+
+	// drop frames no longer needed
+	if drop := r.index - r.half; drop > 0 {
+		n := copy(r.buf, r.buf[drop*channels:])
+		r.buf = r.buf[:n]
+		r.index -= drop
+	}
+
+	return dst
+}
+
+func toFloat32[T Type](v T) float32 {
+	switch v := any(v).(type) {
+	case int16:
+		return int16ToFloat32(v)
+	case float32:
+		return v
+	}
+
+	// unreachable
+	return 0
+}
+
+func fromFloat32[T Type](v float32) T {
+	var t T
+	switch p := any(&t).(type) {
+	case *int16:
+		*p = float32ToInt16(v)
+	case *float32:
+		*p = v
+	}
+	return t
+}
@@ -0,0 +1,201 @@
+package pcm
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func makeSine(rate int, freq float64, samples int, amp float64) []float32 {
+	data := make([]float32, samples)
+	for i := range data {
+		data[i] = float32(amp * math.Sin(2*math.Pi*freq*float64(i)/float64(rate)))
+	}
+	return data
+}
+
+// sineSNR returns the signal to noise ratio of data in dB against the ideal
+// sine wave, ignoring the edges.
+func sineSNR(data []float32, rate int, freq float64, amp float64, skip int) float64 {
+	var signal, noise float64
+	for i := skip; i < len(data)-skip; i++ {
+		want := amp * math.Sin(2*math.Pi*freq*float64(i)/float64(rate))
+		signal += want * want
+		d := float64(data[i]) - want
+		noise += d * d
+	}
+	return 10 * math.Log10(signal/noise)
+}
+
+func rms(data []float32, skip int) float64 {
+	var sum float64
+	for _, v := range data[skip : len(data)-skip] {
+		sum += float64(v) * float64(v)
+	}
+	return math.Sqrt(sum / float64(len(data)-2*skip))
+}
+
+var resampleSineTests = []struct {
+	inRate  int
+	outRate int
+	freq    float64
+}{
+	{24000, 48000, 1000},
+	{22050, 48000, 1000},
+	{16000, 48000, 440},
+	{44100, 48000, 5000},
+	{48000, 24000, 1000},
+	{48000, 22050, 3000},
+	{48000, 48000, 1000},
+}
+
+func TestResamplerSine(t *testing.T) {
+	const amp = 0.5
+
+	for _, tt := range resampleSineTests {
+		t.Run(fmt.Sprintf("%d_%d", tt.inRate, tt.outRate), func(t *testing.T) {
+			r, err := NewResampler[float32](tt.inRate, tt.outRate, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			in := makeSine(tt.inRate, tt.freq, tt.inRate/2, amp)
+			out := resample(t, r, nil, in)
+			out = r.Flush(out)
+
+			wantLen := (len(in)*tt.outRate + tt.inRate - 1) / tt.inRate
+			if len(out) != wantLen {
+				t.Errorf("output length: got %d, want %d", len(out), wantLen)
+			}
+
+			snr := sineSNR(out, tt.outRate, tt.freq, amp, tt.outRate/50)
+			if snr < 75 {
+				t.Errorf("SNR: got %.1f dB, want >= 75 dB", snr)
+			}
+		})
+	}
+}
+
+func TestResamplerAntiAliasing(t *testing.T) {
+	// 20 kHz can not be represented at 22.05 kHz and must be removed
+	r, err := NewResampler[float32](48000, 22050, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in := makeSine(48000, 20000, 24000, 0.5)
+	out := r.Flush(resample(t, r, nil, in))
+
+	level := 20 * math.Log10(rms(out, 500)/(0.5/math.Sqrt2))
+	if level > -80 {
+		t.Errorf("alias level: got %.1f dB, want <= -80 dB", level)
+	}
+}
+
+func TestResamplerStreaming(t *testing.T) {
+	in := makeSine(22050, 1000, 10000, 0.5)
+
+	r, err := NewResampler[float32](22050, 48000, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := r.Flush(resample(t, r, nil, in))
+
+	var got []float32
+	for i, size := 0, 1; i < len(in); i, size = i+size, size*2%997+1 {
+		got = resample(t, r, got, in[i:min(len(in), i+size)])
+	}
+	got = r.Flush(got)
+
+	if len(got) != len(want) {
+		t.Fatalf("output length: got %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("sample %d: got %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestResamplerInt16(t *testing.T) {
+	const amp = 0.5
+
+	sine := makeSine(24000, 1000, 12000, amp)
+	in := make([]int16, len(sine))
+	float32ArrayToInt16Array(in, sine)
+
+	r, err := NewResampler[int16](24000, 48000, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := r.Flush(resample(t, r, nil, in))
+
+	outFloat := make([]float32, len(out))
+	int16ArrayToFloat16Array(outFloat, out)
+
+	// limited by the quantization noise of 16 bit samples
+	snr := sineSNR(outFloat, 48000, 1000, amp, 1000)
+	if snr < 75 {
+		t.Errorf("SNR: got %.1f dB, want >= 75 dB", snr)
+	}
+}
+
+func TestNewResamplerInvalid(t *testing.T) {
+	if _, err := NewResampler[int16](0, 48000, 1); err == nil {
+		t.Error("NewResampler(0, 48000, 1) must fail")
+	}
+	if _, err := NewResampler[int16](24000, 48000, 0); err == nil {
+		t.Error("NewResampler(24000, 48000, 0) must fail")
+	}
+	// coprime rates need too many filter phases
+	if _, err := NewResampler[int16](44101, 48000, 1); !errors.Is(err, ErrInvalidSampleRate) {
+		t.Errorf("NewResampler(44101, 48000, 1): got %v, want %v", err, ErrInvalidSampleRate)
+	}
+}
+
+func TestResamplerPartialFrame(t *testing.T) {
+	r, err := NewResampler[int16](24000, 48000, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := r.Resample(nil, make([]int16, 3)); !errors.Is(err, ErrPartialFrame) {
+		t.Errorf("Resampler.Resample(): got %v, want %v", err, ErrPartialFrame)
+	}
+}
+
+func TestResamplerStereo(t *testing.T) {
+	const amp = 0.5
+
+	left := makeSine(24000, 1000, 12000, amp)
+	right := makeSine(24000, 3000, 12000, amp)
+
+	r, err := NewResampler[float32](24000, 48000, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := r.Flush(resample(t, r, nil, Interleave(nil, left, right)))
+
+	buf := &Buffer[float32]{Channels: 2, Data: out}
+	if got, want := buf.Frames(), 24000; got != want {
+		t.Fatalf("output frames: got %d, want %d", got, want)
+	}
+
+	if snr := sineSNR(buf.Channel(nil, 0), 48000, 1000, amp, 1000); snr < 75 {
+		t.Errorf("SNR of left channel: got %.1f dB, want >= 75 dB", snr)
+	}
+	if snr := sineSNR(buf.Channel(nil, 1), 48000, 3000, amp, 1000); snr < 75 {
+		t.Errorf("SNR of right channel: got %.1f dB, want >= 75 dB", snr)
+	}
+}
+
+func resample[T Type](t *testing.T, r *Resampler[T], dst, src []T) []T {
+	t.Helper()
+
+	out, err := r.Resample(dst, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
@@ -0,0 +1,95 @@
+package pcm
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	DefaultSilenceThreshold = -50.0 // dBFS
+	DefaultSilencePadding   = 50 * time.Millisecond
+)
+
+// SilenceTrimmer removes leading and trailing silence of a stream of
+// interleaved samples. A frame is silent if the amplitudes of all the
+// channels are under the threshold. Silence in the middle of the stream is
+// kept as is.
+type SilenceTrimmer[T Type] struct {
+	channels  int
+	threshold float32
+	// frames of silence to be kept at the edges
+	padding int
+
+	started bool
+	// silent frames held back
+	pending []T
+}
+
+// NewSilenceTrimmer returns a SilenceTrimmer which treats frames under
+// thresholdDB in dBFS as silence, and keeps padding of silence at the edges.
+func NewSilenceTrimmer[T Type](format Format, thresholdDB float64, padding time.Duration) *SilenceTrimmer[T] {
+	return &SilenceTrimmer[T]{
+		channels:  format.Channels,
+		threshold: float32(math.Pow(10, thresholdDB/20)),
+		padding:   int(int64(format.SampleRate) * int64(padding) / int64(time.Second)),
+	}
+}
+
+// Trim appends the frames of src to dst removing leading silence. Silent
+// frames are held back until a sound frame arrives, because they may be
+// trailing silence.
+func (t *SilenceTrimmer[T]) Trim(dst, src []T) []T {
+	channels := t.channels
+
+	for i := 0; i+channels <= len(src); i += channels {
+		frame := src[i : i+channels]
+
+		if t.isSilent(frame) {
+			t.pending = append(t.pending, frame...)
+			if !t.started {
+				// keep only the padding before the sound
+				if over := len(t.pending) - t.padding*channels; over > 0 {
+					t.pending = t.pending[:copy(t.pending, t.pending[over:])]
+				}
+			}
+			continue
+		}
+
+		t.started = true
+		dst = append(dst, t.pending...)
+		t.pending = t.pending[:0]
+		dst = append(dst, frame...)
+	}
+
+	return dst
+}
+
+// Flush appends the padding of trailing silence to dst and resets the
+// trimmer. A stream with no sound results in no frames.
+func (t *SilenceTrimmer[T]) Flush(dst []T) []T {
+	if t.started {
+		dst = append(dst, t.pending[:min(len(t.pending), t.padding*t.channels)]...)
+	}
+
+	t.started = false
+	t.pending = t.pending[:0]
+
+	return dst
+}
+
+func (t *SilenceTrimmer[T]) isSilent(frame []T) bool {
+	for _, v := range frame {
+		if abs32(toFloat32(v)) > t.threshold {
+			return false
+		}
+	}
+	return true
+}
+
+// TrimSilence returns data without leading and trailing silence except for
+// the padding. See SilenceTrimmer for the details.
+func TrimSilence[T Type](data []T, format Format, thresholdDB float64, padding time.Duration) []T {
+	t := NewSilenceTrimmer[T](format, thresholdDB, padding)
+	trimmed := t.Trim(make([]T, 0, len(data)), data)
+	return t.Flush(trimmed)
+}
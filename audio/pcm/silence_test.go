@@ -0,0 +1,90 @@
+package pcm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestTrimSilence(t *testing.T) {
+	// 1 frame = 1 ms
+	format := Format{SampleRate: 1000, Channels: 2, SampleType: SampleTypeInt16}
+
+	frames := func(n int, v int16) []int16 {
+		data := make([]int16, 2*n)
+		for i := range data {
+			data[i] = v
+		}
+		return data
+	}
+	concat := func(parts ...[]int16) []int16 {
+		var data []int16
+		for _, p := range parts {
+			data = append(data, p...)
+		}
+		return data
+	}
+
+	const (
+		silent = 10   // -70 dBFS
+		sound  = 3000 // -21 dBFS
+	)
+
+	tests := []struct {
+		name string
+		in   []int16
+		want []int16
+	}{
+		{
+			name: "edges",
+			in:   concat(frames(100, silent), frames(20, sound), frames(5, silent), frames(20, sound), frames(100, silent)),
+			want: concat(frames(3, silent), frames(20, sound), frames(5, silent), frames(20, sound), frames(3, silent)),
+		},
+		{
+			name: "short edges",
+			in:   concat(frames(1, silent), frames(20, sound), frames(2, silent)),
+			want: concat(frames(1, silent), frames(20, sound), frames(2, silent)),
+		},
+		{
+			name: "silence only",
+			in:   frames(100, silent),
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TrimSilence(tt.in, format, DefaultSilenceThreshold, 3*time.Millisecond)
+			if diff := cmp.Diff(tt.want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("TrimSilence() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSilenceTrimmerStreaming(t *testing.T) {
+	format := Format{SampleRate: 48000, Channels: 1, SampleType: SampleTypeFloat32}
+
+	in := make([]float32, 48000)
+	copy(in[20000:], makeSine(48000, 440, 8000, 0.5))
+
+	want := TrimSilence(in, format, DefaultSilenceThreshold, DefaultSilencePadding)
+
+	trimmer := NewSilenceTrimmer[float32](format, DefaultSilenceThreshold, DefaultSilencePadding)
+	var got []float32
+	for i := 0; i < len(in); i += 960 {
+		got = trimmer.Trim(got, in[i:min(len(in), i+960)])
+	}
+	got = trimmer.Flush(got)
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("SilenceTrimmer mismatch (-want +got):\n%s", diff)
+	}
+
+	// the sine and 50 ms of padding on each side at most
+	if len(got) > 8000+2*2400 {
+		t.Errorf("trimmed length: got %d, want <= %d", len(got), 8000+2*2400)
+	}
+}
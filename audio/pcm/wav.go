@@ -0,0 +1,194 @@
+package pcm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+var (
+	ErrInvalidWAV        = errors.New("pcm: invalid WAV data")
+	ErrUnsupportedFormat = errors.New("pcm: unsupported audio format")
+)
+
+// Audio formats in the fmt chunk.
+const (
+	WAVFormatPCM        = 0x0001
+	WAVFormatIEEEFloat  = 0x0003
+	WAVFormatExtensible = 0xfffe
+)
+
+// WAVInfo is the content of the fmt chunk. For WAVE_FORMAT_EXTENSIBLE, the
+// AudioFormat is taken from the sub format.
+type WAVInfo struct {
+	AudioFormat   int
+	Channels      int
+	SampleRate    int
+	BitsPerSample int
+}
+
+func (info WAVInfo) blockAlign() int {
+	return info.Channels * info.BitsPerSample / 8
+}
+
+// WAVReader reads samples from the data chunk of a RIFF WAVE stream.
+type WAVReader struct {
+	r    io.Reader
+	info WAVInfo
+	// remaining bytes of the data chunk, or -1 if the size is unknown
+	remaining int64
+}
+
+const unknownChunkSize = math.MaxUint32
+
+// NewWAVReader reads the header of a RIFF WAVE stream up to the beginning
+// of the data chunk.
+func NewWAVReader(r io.Reader) (*WAVReader, error) {
+	var riff [12]byte
+	if _, err := io.ReadFull(r, riff[:]); err != nil {
+		return nil, fmt.Errorf("pcm.NewWAVReader: %w: %w", ErrInvalidWAV, err)
+	}
+	if string(riff[0:4]) != "RIFF" || string(riff[8:12]) != "WAVE" {
+		return nil, fmt.Errorf("pcm.NewWAVReader: %w: not a RIFF WAVE stream", ErrInvalidWAV)
+	}
+
+	wr := &WAVReader{
+		r: r,
+	}
+
+	var hasFmt bool
+	for {
+		var header [8]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, fmt.Errorf("pcm.NewWAVReader: %w: %w", ErrInvalidWAV, err)
+		}
+		id := string(header[0:4])
+		size := binary.LittleEndian.Uint32(header[4:8])
+
+		switch id {
+		case "fmt ":
+			if err := wr.readFmt(size); err != nil {
+				return nil, fmt.Errorf("pcm.NewWAVReader: %w", err)
+			}
+			hasFmt = true
+		case "data":
+			if !hasFmt {
+				return nil, fmt.Errorf("pcm.NewWAVReader: %w: data chunk precedes fmt chunk", ErrInvalidWAV)
+			}
+			// streaming encoders may not know the size in advance
+			if size == 0 || size == unknownChunkSize {
+				wr.remaining = -1
+			} else {
+				wr.remaining = int64(size)
+			}
+			return wr, nil
+		default:
+			// skip unknown chunks, which are padded to even size
+			if _, err := io.CopyN(io.Discard, r, int64(size)+int64(size&1)); err != nil {
+				return nil, fmt.Errorf("pcm.NewWAVReader: %w: %w", ErrInvalidWAV, err)
+			}
+		}
+	}
+}
+
+func (wr *WAVReader) readFmt(size uint32) error {
+	if size < 16 {
+		return fmt.Errorf("%w: fmt chunk is too short", ErrInvalidWAV)
+	}
+
+	p := make([]byte, int(size)+int(size&1))
+	if _, err := io.ReadFull(wr.r, p); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidWAV, err)
+	}
+
+	info := WAVInfo{
+		AudioFormat:   int(binary.LittleEndian.Uint16(p[0:2])),
+		Channels:      int(binary.LittleEndian.Uint16(p[2:4])),
+		SampleRate:    int(binary.LittleEndian.Uint32(p[4:8])),
+		BitsPerSample: int(binary.LittleEndian.Uint16(p[14:16])),
+	}
+	if info.AudioFormat == WAVFormatExtensible {
+		// cbSize(2) validBitsPerSample(2) channelMask(4) subFormat(16)
+		if size < 40 {
+			return fmt.Errorf("%w: fmt chunk is too short", ErrInvalidWAV)
+		}
+		info.AudioFormat = int(binary.LittleEndian.Uint16(p[24:26]))
+	}
+	if info.Channels <= 0 || info.SampleRate <= 0 || info.BitsPerSample <= 0 || info.BitsPerSample%8 != 0 {
+		return fmt.Errorf("%w: invalid fmt chunk", ErrInvalidWAV)
+	}
+
+	wr.info = info
+
+	return nil
+}
+
+func (wr *WAVReader) Info() WAVInfo {
+	return wr.info
+}
+
+// Read reads raw sample bytes of the data chunk.
+func (wr *WAVReader) Read(p []byte) (int, error) {
+	if wr.remaining == 0 {
+		return 0, io.EOF
+	}
+	if wr.remaining > 0 && int64(len(p)) > wr.remaining {
+		p = p[:wr.remaining]
+	}
+
+	n, err := wr.r.Read(p)
+	if wr.remaining > 0 {
+		wr.remaining -= int64(n)
+		if err == io.EOF && wr.remaining > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+	}
+
+	return n, err
+}
+
+// WriteWAV writes data as a RIFF WAVE stream. data must contain little
+// endian samples described by info.
+func WriteWAV(w io.Writer, info WAVInfo, data []byte) error {
+	if info.Channels <= 0 || info.SampleRate <= 0 || info.BitsPerSample <= 0 || info.BitsPerSample%8 != 0 {
+		return fmt.Errorf("pcm.WriteWAV: %w", ErrUnsupportedFormat)
+	}
+	if int64(len(data)) > unknownChunkSize-36 {
+		return fmt.Errorf("pcm.WriteWAV: data is too large")
+	}
+
+	pad := len(data) & 1
+
+	var header [44]byte
+	copy(header[0:4], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:8], uint32(36+len(data)+pad))
+	copy(header[8:12], "WAVE")
+
+	copy(header[12:16], "fmt ")
+	binary.LittleEndian.PutUint32(header[16:20], 16)
+	binary.LittleEndian.PutUint16(header[20:22], uint16(info.AudioFormat))
+	binary.LittleEndian.PutUint16(header[22:24], uint16(info.Channels))
+	binary.LittleEndian.PutUint32(header[24:28], uint32(info.SampleRate))
+	binary.LittleEndian.PutUint32(header[28:32], uint32(info.SampleRate*info.blockAlign()))
+	binary.LittleEndian.PutUint16(header[32:34], uint16(info.blockAlign()))
+	binary.LittleEndian.PutUint16(header[34:36], uint16(info.BitsPerSample))
+
+	copy(header[36:40], "data")
+	binary.LittleEndian.PutUint32(header[40:44], uint32(len(data)))
+
+	if _, err := w.Write(header[:]); err != nil {
+		return fmt.Errorf("pcm.WriteWAV: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("pcm.WriteWAV: %w", err)
+	}
+	if pad > 0 {
+		if _, err := w.Write([]byte{0}); err != nil {
+			return fmt.Errorf("pcm.WriteWAV: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package pcm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWAVRoundTrip(t *testing.T) {
+	info := WAVInfo{
+		AudioFormat:   WAVFormatPCM,
+		Channels:      1,
+		SampleRate:    24000,
+		BitsPerSample: 16,
+	}
+	samples := []int16{0, 1, -1, 32767, -32768, 1234}
+	data := make([]byte, Bytes(samples))
+	Encode(data, samples, LittleEndian)
+
+	var buf bytes.Buffer
+	if err := WriteWAV(&buf, info, data); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() != 44+len(data) {
+		t.Errorf("WAV size: got %d, want %d", buf.Len(), 44+len(data))
+	}
+
+	r, err := NewWAVReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(info, r.Info()); diff != "" {
+		t.Errorf("WAVReader.Info() mismatch (-want +got):\n%s", diff)
+	}
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("WAVReader.Read(): got %v, want %v", got, data)
+	}
+}
+
+func appendChunk(p []byte, id string, data []byte) []byte {
+	p = append(p, id...)
+	p = binary.LittleEndian.AppendUint32(p, uint32(len(data)))
+	p = append(p, data...)
+	if len(data)%2 != 0 {
+		p = append(p, 0)
+	}
+	return p
+}
+
+func makeFmtChunk(format, channels, rate, bits int) []byte {
+	var p []byte
+	p = binary.LittleEndian.AppendUint16(p, uint16(format))
+	p = binary.LittleEndian.AppendUint16(p, uint16(channels))
+	p = binary.LittleEndian.AppendUint32(p, uint32(rate))
+	p = binary.LittleEndian.AppendUint32(p, uint32(rate*channels*bits/8))
+	p = binary.LittleEndian.AppendUint16(p, uint16(channels*bits/8))
+	p = binary.LittleEndian.AppendUint16(p, uint16(bits))
+	return p
+}
+
+func makeWAV(chunks ...[]byte) []byte {
+	var body []byte
+	for _, chunk := range chunks {
+		body = append(body, chunk...)
+	}
+	p := []byte("RIFF")
+	p = binary.LittleEndian.AppendUint32(p, uint32(4+len(body)))
+	p = append(p, "WAVE"...)
+	return append(p, body...)
+}
+
+func TestWAVReaderSkipChunks(t *testing.T) {
+	data := []byte{1, 2, 3, 4}
+	p := makeWAV(
+		appendChunk(nil, "fmt ", makeFmtChunk(WAVFormatPCM, 2, 48000, 16)),
+		appendChunk(nil, "LIST", []byte("odd")),
+		appendChunk(nil, "data", data),
+		appendChunk(nil, "id3 ", []byte("trailer")),
+	)
+
+	r, err := NewWAVReader(bytes.NewReader(p))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := r.Info().Channels; got != 2 {
+		t.Errorf("channels: got %d, want 2", got)
+	}
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("WAVReader.Read(): got %v, want %v", got, data)
+	}
+}
+
+func TestWAVReaderExtensible(t *testing.T) {
+	ext := makeFmtChunk(WAVFormatExtensible, 1, 22050, 32)
+	ext = binary.LittleEndian.AppendUint16(ext, 22) // cbSize
+	ext = binary.LittleEndian.AppendUint16(ext, 32) // valid bits
+	ext = binary.LittleEndian.AppendUint32(ext, 4)  // channel mask
+	ext = binary.LittleEndian.AppendUint16(ext, WAVFormatIEEEFloat)
+	ext = append(ext, make([]byte, 14)...)
+
+	p := makeWAV(
+		appendChunk(nil, "fmt ", ext),
+		appendChunk(nil, "data", nil),
+	)
+
+	r, err := NewWAVReader(bytes.NewReader(p))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := WAVInfo{
+		AudioFormat:   WAVFormatIEEEFloat,
+		Channels:      1,
+		SampleRate:    22050,
+		BitsPerSample: 32,
+	}
+	if diff := cmp.Diff(want, r.Info()); diff != "" {
+		t.Errorf("WAVReader.Info() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWAVReaderInvalid(t *testing.T) {
+	tests := map[string][]byte{
+		"raw samples": {0, 0, 1, 0, 2, 0, 3, 0, 4, 0, 5, 0, 6, 0},
+		"no fmt":      makeWAV(appendChunk(nil, "data", []byte{0, 0})),
+		"no data":     makeWAV(appendChunk(nil, "fmt ", makeFmtChunk(WAVFormatPCM, 1, 48000, 16))),
+		"short fmt":   makeWAV(appendChunk(nil, "fmt ", []byte{1, 0, 1, 0})),
+	}
+
+	for name, p := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewWAVReader(bytes.NewReader(p))
+			if !errors.Is(err, ErrInvalidWAV) {
+				t.Errorf("NewWAVReader(): got %v, want %v", err, ErrInvalidWAV)
+			}
+		})
+	}
+}
+
+func TestWAVInfoFormat(t *testing.T) {
+	formats := []Format{
+		{SampleRate: 48000, Channels: 2, SampleType: SampleTypeInt16},
+		{SampleRate: 22050, Channels: 1, SampleType: SampleTypeFloat32},
+	}
+	for _, want := range formats {
+		got, err := want.WAVInfo().Format()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("WAVInfo.Format(): got %v, want %v", got, want)
+		}
+	}
+
+	info := WAVInfo{AudioFormat: WAVFormatPCM, Channels: 1, SampleRate: 8000, BitsPerSample: 8}
+	if _, err := info.Format(); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("WAVInfo.Format(): got %v, want %v", err, ErrUnsupportedFormat)
+	}
+}
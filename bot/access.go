@@ -0,0 +1,242 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/kechako/yomiko/bot/internal/command"
+	"github.com/kechako/yomiko/ent"
+	"github.com/kechako/yomiko/ent/accessrule"
+)
+
+// members with these permissions can run all the commands
+const accessManagerPermissions = discordgo.PermissionAdministrator | discordgo.PermissionManageServer
+
+var accessActions = []struct {
+	action accessrule.Action
+	name   string
+}{
+	{accessrule.ActionJoin, "入室"},
+	{accessrule.ActionLeave, "退室"},
+	{accessrule.ActionSkip, "読み上げのスキップ"},
+	{accessrule.ActionDictionary, "辞書の編集"},
+	{accessrule.ActionSettings, "サーバー設定の変更"},
+}
+
+func accessActionName(action accessrule.Action) string {
+	for _, a := range accessActions {
+		if a.action == action {
+			return a.name
+		}
+	}
+	return string(action)
+}
+
+// restrictedByDefault reports whether only the managers can run the
+// commands of the action when no role is allowed.
+func restrictedByDefault(action accessrule.Action) bool {
+	return action == accessrule.ActionSettings
+}
+
+// withAccess returns a handler running handler only if the member is
+// allowed the action in the guild.
+func (bot *Bot) withAccess(action accessrule.Action, handler command.Handler) command.Handler {
+	return func(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+		roleIDs, err := bot.getAccessRoles(ctx, req.GuildID(), action)
+		if err != nil {
+			bot.logger.Error("failed to get access rules", slog.Any("error", err))
+			return createErrorResponse("エラーが発生しました！", "")
+		}
+
+		if !isAccessAllowed(req.Member(), action, roleIDs) {
+			return createAccessDeniedResponse(action, roleIDs)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// isAccessAllowed reports whether the member is allowed the action. Anyone
+// is allowed if no role is allowed, unless the action is restricted by
+// default.
+func isAccessAllowed(member *discordgo.Member, action accessrule.Action, roleIDs []string) bool {
+	if member == nil {
+		return false
+	}
+	if member.Permissions&accessManagerPermissions != 0 {
+		return true
+	}
+	if len(roleIDs) == 0 {
+		return !restrictedByDefault(action)
+	}
+
+	return slices.ContainsFunc(member.Roles, func(roleID string) bool {
+		return slices.Contains(roleIDs, roleID)
+	})
+}
+
+func createAccessDeniedResponse(action accessrule.Action, roleIDs []string) *discordgo.InteractionResponse {
+	if len(roleIDs) == 0 {
+		return createWarnResponse("権限がありません", fmt.Sprintf("「%s」には「%s」の権限が必要です。", accessActionName(action), permissionNames(discordgo.PermissionManageServer)))
+	}
+
+	return createWarnResponse("権限がありません", fmt.Sprintf("「%s」は %s のロールを持つメンバーだけが実行できます。", accessActionName(action), roleMentions(roleIDs)))
+}
+
+func roleMentions(roleIDs []string) string {
+	mentions := make([]string, len(roleIDs))
+	for i, id := range roleIDs {
+		mentions[i] = "<@&" + id + ">"
+	}
+	return strings.Join(mentions, " ")
+}
+
+func (bot *Bot) accessCommand() *command.Command {
+	actionChoices := make([]*discordgo.ApplicationCommandOptionChoice, len(accessActions))
+	for i, a := range accessActions {
+		actionChoices[i] = &discordgo.ApplicationCommandOptionChoice{
+			Name:  a.name,
+			Value: string(a.action),
+		}
+	}
+	ruleOptions := []*command.Option{
+		{
+			Name:        "action",
+			Description: "許可する操作。",
+			Type:        discordgo.ApplicationCommandOptionString,
+			Choices:     actionChoices,
+			Required:    true,
+		},
+		{
+			Name:        "role",
+			Description: "許可するロール。",
+			Type:        discordgo.ApplicationCommandOptionRole,
+			Required:    true,
+		},
+	}
+
+	return &command.Command{
+		Name:        "yomiko-access",
+		Description: "読子さんのコマンドを実行できるロールを設定します。",
+		Permissions: discordgo.PermissionManageServer,
+		Subcommands: []*command.Command{
+			{
+				Name:        "allow",
+				Description: "操作をロールに許可します。許可されたロールがある操作は、そのロールを持つメンバーだけが実行できます。",
+				Options:     ruleOptions,
+				Handler:     bot.handleAccessAllowCommand,
+			},
+			{
+				Name:        "revoke",
+				Description: "ロールへの操作の許可を取り消します。",
+				Options:     ruleOptions,
+				Handler:     bot.handleAccessRevokeCommand,
+			},
+			{
+				Name:        "list",
+				Description: "操作を許可されたロールを表示します。",
+				Handler:     bot.handleAccessListCommand,
+			},
+		},
+	}
+}
+
+func (bot *Bot) handleAccessAllowCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	action := accessrule.Action(req.String("action"))
+	roleID := req.String("role")
+	if err := accessrule.ActionValidator(action); err != nil {
+		return createWarnResponse("コマンドの指定が正しくありません", "")
+	}
+
+	err := bot.ent.AccessRule.Create().
+		SetGuildID(req.GuildID()).
+		SetAction(action).
+		SetRoleID(roleID).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return createWarnResponse("許可済です", fmt.Sprintf("<@&%s> は既に「%s」を許可されています。", roleID, accessActionName(action)))
+		}
+		bot.logger.Error("failed to create access rule", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	return createSuccessResponse("権限設定", fmt.Sprintf("<@&%s> に「%s」を許可しました。", roleID, accessActionName(action)))
+}
+
+func (bot *Bot) handleAccessRevokeCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	action := accessrule.Action(req.String("action"))
+	roleID := req.String("role")
+
+	n, err := bot.ent.AccessRule.Delete().
+		Where(
+			accessrule.GuildID(req.GuildID()),
+			accessrule.ActionEQ(action),
+			accessrule.RoleID(roleID),
+		).
+		Exec(ctx)
+	if err != nil {
+		bot.logger.Error("failed to delete access rule", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	if n == 0 {
+		return createWarnResponse("許可されていません", fmt.Sprintf("<@&%s> は「%s」を許可されていません。", roleID, accessActionName(action)))
+	}
+
+	return createSuccessResponse("権限設定", fmt.Sprintf("<@&%s> への「%s」の許可を取り消しました。", roleID, accessActionName(action)))
+}
+
+func (bot *Bot) handleAccessListCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	rules, err := bot.ent.AccessRule.Query().
+		Where(accessrule.GuildID(req.GuildID())).
+		Order(ent.Asc(accessrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		bot.logger.Error("failed to get access rules", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	var lines []string
+	for _, a := range accessActions {
+		var roleIDs []string
+		for _, rule := range rules {
+			if rule.Action == a.action {
+				roleIDs = append(roleIDs, rule.RoleID)
+			}
+		}
+
+		allowed := roleMentions(roleIDs)
+		switch {
+		case len(roleIDs) > 0:
+		case restrictedByDefault(a.action):
+			allowed = "サーバー管理者のみ"
+		default:
+			allowed = "全員"
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", a.name, allowed))
+	}
+
+	return createInfoResponse("権限設定", strings.Join(lines, "\n"))
+}
+
+// getAccessRoles returns the IDs of the roles allowed the action in the
+// guild.
+func (bot *Bot) getAccessRoles(ctx context.Context, guildID string, action accessrule.Action) ([]string, error) {
+	roleIDs, err := bot.ent.AccessRule.Query().
+		Where(
+			accessrule.GuildID(guildID),
+			accessrule.ActionEQ(action),
+		).
+		Order(ent.Asc(accessrule.FieldID)).
+		Select(accessrule.FieldRoleID).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.getAccessRoles: %w", err)
+	}
+
+	return roleIDs, nil
+}
@@ -0,0 +1,343 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/kechako/yomiko/audio/pcm"
+	"github.com/kechako/yomiko/bot/internal/command"
+	"github.com/kechako/yomiko/ent/accessrule"
+)
+
+var errInvalidBGM = errors.New("invalid bgm")
+
+const (
+	maxBGMVolume = 100
+	bgmExt       = ".wav"
+	// the whole file is decoded in memory
+	maxBGMDuration = 10 * time.Minute
+)
+
+type bgmOptions struct {
+	dir string
+	// initial volume in percent
+	volume  int
+	ducking float64
+}
+
+// listBGMFiles returns the names of the WAV files in dir.
+func listBGMFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("bot.listBGMFiles: %w", err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !isBGMName(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names, nil
+}
+
+func isBGMName(name string) bool {
+	return name == filepath.Base(name) &&
+		!strings.HasPrefix(name, ".") &&
+		strings.EqualFold(filepath.Ext(name), bgmExt)
+}
+
+// loadBGM decodes the WAV file in dir and returns it as a loop in the format.
+func loadBGM(dir, name string, format pcm.Format) (*pcm.Loop[int16], error) {
+	if !isBGMName(name) {
+		return nil, fmt.Errorf("bot.loadBGM: %w: %q", errInvalidBGM, name)
+	}
+
+	file, err := os.Open(filepath.Join(dir, name))
+	if err != nil {
+		return nil, fmt.Errorf("bot.loadBGM: %w", err)
+	}
+	defer file.Close()
+
+	wr, err := pcm.NewWAVReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("bot.loadBGM: %w: %w", errInvalidBGM, err)
+	}
+	from, err := wr.Info().Format()
+	if err != nil {
+		return nil, fmt.Errorf("bot.loadBGM: %w: %w", errInvalidBGM, err)
+	}
+
+	maxBytes := int64(from.FramesToBytes(int(maxBGMDuration.Seconds()) * from.SampleRate))
+	data, err := io.ReadAll(io.LimitReader(wr, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("bot.loadBGM: %w", err)
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("bot.loadBGM: %w: longer than %v", errInvalidBGM, maxBGMDuration)
+	}
+	data = data[:len(data)-len(data)%from.FrameSize()]
+
+	conv, err := pcm.NewConverter[int16](from, format)
+	if err != nil {
+		return nil, fmt.Errorf("bot.loadBGM: %w: %w", errInvalidBGM, err)
+	}
+	samples := pcm.DecodeAs[int16](nil, data, from.SampleType, pcm.LittleEndian)
+	samples, err = conv.Convert(nil, samples)
+	if err != nil {
+		return nil, fmt.Errorf("bot.loadBGM: %w", err)
+	}
+	samples = conv.Flush(samples)
+
+	return pcm.NewLoop(samples, format), nil
+}
+
+// bgmPlayer plays a BGM in a session until stopped.
+type bgmPlayer struct {
+	name  string
+	mixer *pcm.Mixer[int16]
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// mix appends a frame mixed with the BGM to dst. A nil frame means the BGM
+// alone.
+func (p *bgmPlayer) mix(dst, frame []int16, volume int) []int16 {
+	p.mixer.SetVolume(float64(volume) / 100)
+	return p.mixer.Mix(dst, frame, frameSize)
+}
+
+func (p *bgmPlayer) halt() {
+	close(p.stop)
+	<-p.done
+}
+
+// StartBGM plays src looping under the speech, replacing the current BGM.
+// onError is called if the playback stops by an error.
+func (s *yomikoSession) StartBGM(name string, src pcm.Source[int16], ducking float64, onError func(err error)) {
+	opts := pcm.DefaultMixerOptions()
+	opts.Volume = float64(s.bgmVolume.Load()) / 100
+	opts.Ducking = ducking
+
+	p := &bgmPlayer{
+		name:  name,
+		mixer: pcm.NewMixer(s.format, src, opts),
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+
+	s.bgmMu.Lock()
+	old := s.bgm
+	s.bgm = p
+	s.bgmMu.Unlock()
+
+	if old != nil {
+		old.halt()
+	}
+
+	go func() {
+		if err := s.playBGM(p); err != nil {
+			onError(err)
+		}
+	}()
+}
+
+// StopBGM stops the BGM and reports whether it was playing.
+func (s *yomikoSession) StopBGM() bool {
+	s.bgmMu.Lock()
+	p := s.bgm
+	s.bgm = nil
+	s.bgmMu.Unlock()
+
+	if p == nil {
+		return false
+	}
+	p.halt()
+
+	return true
+}
+
+// SetBGMVolume changes the volume of the BGM in percent, including the one
+// played next.
+func (s *yomikoSession) SetBGMVolume(volume int) {
+	s.bgmVolume.Store(int32(volume))
+}
+
+func (s *yomikoSession) currentBGM() *bgmPlayer {
+	s.bgmMu.Lock()
+	defer s.bgmMu.Unlock()
+	return s.bgm
+}
+
+// playBGM sends the frames of the BGM alone while nothing is read. Read
+// mixes the BGM into the speech while it holds s.mu.
+func (s *yomikoSession) playBGM(p *bgmPlayer) error {
+	defer func() {
+		close(p.done)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.conn != nil && s.currentBGM() == nil {
+			s.setSpeaking(false)
+		}
+	}()
+
+	for {
+		select {
+		case <-p.stop:
+			return nil
+		default:
+		}
+
+		s.mu.Lock()
+		if s.conn == nil {
+			s.mu.Unlock()
+			return nil
+		}
+		s.swapEncoder()
+		s.setSpeaking(true)
+		err := s.sendFrame(nil, p, p.stop)
+		s.mu.Unlock()
+
+		if err != nil {
+			return fmt.Errorf("bot.yomikoSession.playBGM: %w", err)
+		}
+	}
+}
+
+func (bot *Bot) bgmCommand() *command.Command {
+	minVolume := float64(0)
+
+	return &command.Command{
+		Name:        "bgm",
+		Description: "読み上げの背景に流すBGMを操作します。",
+		Subcommands: []*command.Command{
+			{
+				Name:        "start",
+				Description: "BGMを再生します。",
+				Options: []*command.Option{
+					{
+						Name:         "file",
+						Description:  "再生するBGM。",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Autocomplete: bot.autocompleteBGM,
+						Required:     true,
+					},
+				},
+				Handler: bot.withAccess(accessrule.ActionSettings, bot.handleBGMStartCommand),
+			},
+			{
+				Name:        "stop",
+				Description: "BGMを停止します。",
+				Handler:     bot.withAccess(accessrule.ActionSettings, bot.handleBGMStopCommand),
+			},
+			{
+				Name:        "volume",
+				Description: "BGMの音量を設定します。",
+				Options: []*command.Option{
+					{
+						Name:        "volume",
+						Description: "音量 (%)。",
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						MinValue:    &minVolume,
+						MaxValue:    maxBGMVolume,
+						Required:    true,
+					},
+				},
+				Handler: bot.withAccess(accessrule.ActionSettings, bot.handleBGMVolumeCommand),
+			},
+		},
+	}
+}
+
+// autocompleteBGM lists the directory on every request, so that the files
+// added while running are suggested.
+func (bot *Bot) autocompleteBGM(ctx context.Context, req *command.Request, value string) []*discordgo.ApplicationCommandOptionChoice {
+	names, err := listBGMFiles(bot.bgm.dir)
+	if err != nil {
+		bot.logger.Error("failed to list bgm files", slog.Any("error", err))
+		return nil
+	}
+
+	query := strings.ToLower(value)
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, name := range names {
+		if !strings.Contains(strings.ToLower(name), query) {
+			continue
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  strings.TrimSuffix(name, filepath.Ext(name)),
+			Value: name,
+		})
+	}
+
+	return choices
+}
+
+func (bot *Bot) handleBGMStartCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	ys, ok := bot.getSession(req.GuildID())
+	if !ok {
+		return createWarnResponse("読子さんは入室していません", "")
+	}
+
+	name := req.String("file")
+	src, err := loadBGM(bot.bgm.dir, name, outputFormat)
+	if err != nil {
+		if errors.Is(err, errInvalidBGM) || errors.Is(err, fs.ErrNotExist) {
+			return createWarnResponse("BGMを再生できません", fmt.Sprintf("「%s」は再生できるWAVファイルではありません。", name))
+		}
+		bot.logger.Error("failed to load bgm", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	logger := bot.logger.With(slog.String("guild_id", req.GuildID()), slog.String("bgm", name))
+	ys.StartBGM(name, src, bot.bgm.ducking, func(err error) {
+		logger.Error("failed to play bgm", slog.Any("error", err))
+	})
+
+	return createSuccessResponse("BGM", fmt.Sprintf("「%s」を再生します。", strings.TrimSuffix(name, filepath.Ext(name))))
+}
+
+func (bot *Bot) handleBGMStopCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	ys, ok := bot.getSession(req.GuildID())
+	if !ok {
+		return createWarnResponse("読子さんは入室していません", "")
+	}
+
+	if !ys.StopBGM() {
+		return createWarnResponse("BGMは再生されていません", "")
+	}
+
+	return createSuccessResponse("BGM", "BGMを停止しました。")
+}
+
+func (bot *Bot) handleBGMVolumeCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	ys, ok := bot.getSession(req.GuildID())
+	if !ok {
+		return createWarnResponse("読子さんは入室していません", "")
+	}
+
+	volume := int(req.Int("volume"))
+	ys.SetBGMVolume(volume)
+
+	return createSuccessResponse("BGM", fmt.Sprintf("BGMの音量を%d%%に設定しました。", volume))
+}
@@ -11,8 +11,10 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/kechako/yomiko/bot/internal/command"
 	"github.com/kechako/yomiko/bot/internal/replacer"
 	"github.com/kechako/yomiko/ent"
 	"github.com/kechako/yomiko/ent/voicesetting"
@@ -24,6 +26,7 @@ import (
 var (
 	errYomikoAlreadyJoined = errors.New("yomiko already joined")
 	errYomikoHasNotJoined  = errors.New("yomiko has not joined any channels")
+	errYomikoSkipped       = errors.New("yomiko skipped the utterance")
 )
 
 const SampleRate = 48000
@@ -36,14 +39,26 @@ const (
 )
 
 type Bot struct {
-	cfg      *Config
-	s        *discordgo.Session
-	tts      *tts.Client
-	ent      *ent.Client
-	logger   *slog.Logger
-	commands []*discordgo.ApplicationCommand
-
-	replacer *replacer.Replacer
+	cfg    *Config
+	s      discordSession
+	tts    tts.Engine
+	ent    *ent.Client
+	logger *slog.Logger
+
+	router    *command.Router
+	voices    *voiceCatalog
+	replacer  *replacer.Replacer
+	opus      opusOptions
+	recording *recordingOptions
+	bgm       *bgmOptions
+
+	filterMu sync.Mutex
+	// word filters by guild ID
+	filters map[string]*wordFilter
+
+	dictMu sync.Mutex
+	// replacers with the guild dictionaries by guild ID
+	dicts map[string]*replacer.Replacer
 
 	mu       sync.RWMutex
 	sessions map[string]*yomikoSession
@@ -58,39 +73,85 @@ func New(ctx context.Context, cfg *Config) (*Bot, error) {
 		return nil, fmt.Errorf("bot.New: %w", err)
 	}
 
-	ttsOpts := []tts.ClientOption{
-		tts.WithSampleRate(SampleRate),
+	// We need information about guilds (which includes their channels),
+	// messages and voice states.
+	s.Identify.Intents = discordgo.IntentsGuilds | discordgo.IntentsGuildMessages | discordgo.IntentsMessageContent | discordgo.IntentsGuildVoiceStates
+
+	engine, err := newTTSEngine(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("bot.New: %w", err)
 	}
-	if credJSON, err := cfg.getCredentialsJSON(); err != nil {
+
+	e, err := openEnt(ctx, cfg)
+	if err != nil {
 		return nil, fmt.Errorf("bot.New: %w", err)
-	} else if len(credJSON) > 0 {
-		ttsOpts = append(ttsOpts, tts.WithCredentialsJSON(credJSON))
 	}
 
-	c, err := tts.New(ctx, ttsOpts...)
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{}))
+
+	bot, err := newBot(cfg, discordgoSession{s}, engine, e, logger)
 	if err != nil {
 		return nil, fmt.Errorf("bot.New: %w", err)
 	}
 
+	return bot, nil
+}
+
+// openEnt opens the database of cfg and migrates the schema.
+func openEnt(ctx context.Context, cfg *Config) (*ent.Client, error) {
 	e, err := ent.Open("sqlite3", makeDataSourceName(cfg))
 	if err != nil {
-		return nil, fmt.Errorf("bot.New: %w", err)
+		return nil, fmt.Errorf("bot.openEnt: %w", err)
 	}
 
 	if err := e.Schema.Create(ctx); err != nil {
-		return nil, fmt.Errorf("bot.New: %w", err)
+		e.Close()
+		return nil, fmt.Errorf("bot.openEnt: %w", err)
+	}
+
+	return e, nil
+}
+
+// newBot returns a Bot on the session, which is replaced with a fake in
+// tests.
+func newBot(cfg *Config, s discordSession, engine tts.Engine, e *ent.Client, logger *slog.Logger) (*Bot, error) {
+	opusOpts, err := cfg.opusOptions()
+	if err != nil {
+		return nil, fmt.Errorf("bot.newBot: %w", err)
+	}
+
+	recOpts, err := cfg.recordingOptions()
+	if err != nil {
+		return nil, fmt.Errorf("bot.newBot: %w", err)
+	}
+
+	bgmOpts, err := cfg.bgmOptions()
+	if err != nil {
+		return nil, fmt.Errorf("bot.newBot: %w", err)
 	}
 
 	bot := &Bot{
-		cfg:      cfg,
-		s:        s,
-		tts:      c,
-		ent:      e,
-		logger:   slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{})),
-		replacer: makeReplacer(cfg),
-		sessions: make(map[string]*yomikoSession),
-		targets:  make(map[string]string),
+		cfg:       cfg,
+		s:         s,
+		tts:       engine,
+		voices:    newVoiceCatalog(engine),
+		ent:       e,
+		logger:    logger,
+		replacer:  makeReplacer(cfg),
+		opus:      opusOpts,
+		recording: recOpts,
+		bgm:       bgmOpts,
+		filters:   make(map[string]*wordFilter),
+		dicts:     make(map[string]*replacer.Replacer),
+		sessions:  make(map[string]*yomikoSession),
+		targets:   make(map[string]string),
+	}
+
+	router, err := bot.newRouter()
+	if err != nil {
+		return nil, fmt.Errorf("bot.newBot: %w", err)
 	}
+	bot.router = router
 
 	if err := bot.init(); err != nil {
 		return nil, err
@@ -99,6 +160,31 @@ func New(ctx context.Context, cfg *Config) (*Bot, error) {
 	return bot, nil
 }
 
+func newTTSEngine(ctx context.Context, cfg *Config) (tts.Engine, error) {
+	ttsOpts := []tts.ClientOption{
+		tts.WithSampleRate(SampleRate),
+	}
+
+	switch engine := cfg.ttsEngine(); engine {
+	case ttsEngineGoogle:
+		if credJSON, err := cfg.getCredentialsJSON(); err != nil {
+			return nil, fmt.Errorf("bot.newTTSEngine: %w", err)
+		} else if len(credJSON) > 0 {
+			ttsOpts = append(ttsOpts, tts.WithCredentialsJSON(credJSON))
+		}
+
+		c, err := tts.New(ctx, ttsOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("bot.newTTSEngine: %w", err)
+		}
+		return c, nil
+	case ttsEngineFake:
+		return tts.NewFake(ttsOpts...), nil
+	default:
+		return nil, fmt.Errorf("bot.newTTSEngine: unknown engine %q", engine)
+	}
+}
+
 func (bot *Bot) init() error {
 	s := bot.s
 
@@ -113,9 +199,7 @@ func (bot *Bot) init() error {
 
 	s.AddHandler(bot.handleInteractionCreate)
 
-	// We need information about guilds (which includes their channels),
-	// messages and voice states.
-	s.Identify.Intents = discordgo.IntentsGuilds | discordgo.IntentsGuildMessages | discordgo.IntentsMessageContent | discordgo.IntentsGuildVoiceStates
+	s.AddHandler(bot.handleVoiceStateUpdate)
 
 	return nil
 }
@@ -170,28 +254,47 @@ func (bot *Bot) Start(ctx context.Context) error {
 		return fmt.Errorf("bot.Bot.Start: %w", err)
 	}
 
+	if bot.recording != nil && bot.recording.retention > 0 {
+		go bot.pruneRecordings(ctx)
+	}
+
 	<-ctx.Done()
 
 	return nil
 }
 
+// pruneRecordings removes the expired recordings on start and periodically,
+// until the context is canceled.
+func (bot *Bot) pruneRecordings(ctx context.Context) {
+	ticker := time.NewTicker(recordingPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		before := time.Now().Add(-bot.recording.retention)
+		if err := pruneRecordings(bot.recording.dir, before); err != nil {
+			bot.logger.Error("failed to prune recordings", slog.Any("error", err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
 func (bot *Bot) handleReady(s *discordgo.Session, event *discordgo.Ready) {
 	bot.logger.Info("ready")
 	bot.updateGameStatus()
 
-	commands, err := bot.getApplicationCommands(context.Background())
-	if err != nil {
-		bot.logger.Error("failed to get application commands", slog.Any("error", err))
-		return
+	// overwriting is idempotent, so it is safe on every Ready
+	if err := bot.syncCommands(context.Background()); err != nil {
+		bot.logger.Error("failed to register application commands", slog.Any("error", err))
 	}
 
-	for _, cmd := range commands {
-		cmd, err := s.ApplicationCommandCreate(s.State.User.ID, "", cmd)
-		if err != nil {
-			bot.logger.Error("failed to create application command", slog.Any("error", err))
-			continue
-		}
-		bot.commands = append(bot.commands, cmd)
+	// list voices before the first autocomplete
+	if _, err := bot.voices.Voices(context.Background()); err != nil {
+		bot.logger.Error("failed to list voices", slog.Any("error", err))
 	}
 }
 
@@ -205,16 +308,15 @@ func (bot *Bot) updateGameStatus() {
 }
 
 func (bot *Bot) handleMessageCreate(s *discordgo.Session, event *discordgo.MessageCreate) {
-	if event.Author.ID == s.State.User.ID {
+	if event.Author.ID == bot.s.UserID() {
 		return
 	}
 
 	guildID := event.GuildID
 
-	bot.mu.RLock()
-	defer bot.mu.RUnlock()
-
-	ys, ok := bot.sessions[guildID]
+	// not holding bot.mu while reading, so that the commands such as skip
+	// are not blocked behind a waiting writer
+	ys, ok := bot.getSession(guildID)
 	if !ok {
 		return
 	}
@@ -225,12 +327,54 @@ func (bot *Bot) handleMessageCreate(s *discordgo.Session, event *discordgo.Messa
 
 	ctx := context.Background()
 
-	vs, err := bot.getUserVoiceSetting(ctx, event.Author.ID)
+	ignored, err := bot.isIgnoredMessage(ctx, event.Message)
+	if err != nil {
+		bot.logger.Error("failed to check ignored message", slog.Any("error", err))
+		return
+	}
+	if ignored {
+		return
+	}
+
+	filter, err := bot.getWordFilter(ctx, guildID)
+	if err != nil {
+		bot.logger.Error("failed to get word filter", slog.Any("error", err))
+		return
+	}
+
+	opts, err := bot.userSpeechOptions(ctx, event.Author.ID)
 	if err != nil {
 		bot.logger.Error("failed to get user voice setting", slog.Any("error", err))
 		return
 	}
 
+	doc, err := bot.makeSSML(ctx, filter, event.Message)
+	if err != nil {
+		bot.logger.Error("failed to make ssml", slog.Any("error", err))
+		return
+	}
+	if doc == nil {
+		// dropped by the NG words
+		return
+	}
+
+	err = ys.Read(
+		context.Background(),
+		doc,
+		opts...)
+	if err != nil {
+		bot.logger.Error("yomiko failed to read text", slog.Any("error", err))
+	}
+}
+
+// userSpeechOptions returns the options to synthesize speech in the voice
+// setting of the user.
+func (bot *Bot) userSpeechOptions(ctx context.Context, userID string) ([]tts.SynthesizeSpeechOption, error) {
+	vs, err := bot.getUserVoiceSetting(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.userSpeechOptions: %w", err)
+	}
+
 	var opts []tts.SynthesizeSpeechOption
 	if vs != nil {
 		if vs.VoiceName != nil {
@@ -244,33 +388,61 @@ func (bot *Bot) handleMessageCreate(s *discordgo.Session, event *discordgo.Messa
 		}
 	}
 
-	err = ys.Read(
-		context.Background(),
-		bot.makeSSML(event.Message),
-		opts...)
+	return opts, nil
+}
+
+var urlRegexp = regexp.MustCompile(`https?://[^\s]{2,}`)
+
+// makeSSML builds the SSML of the message with the dictionaries of the guild
+// and the author, and the spoken names of the users. It returns nil if the
+// filter drops the message.
+func (bot *Bot) makeSSML(ctx context.Context, filter *wordFilter, msg *discordgo.Message) (*ssml.SSML, error) {
+	r, err := bot.userReplacer(ctx, msg.GuildID, msg.Author.ID)
 	if err != nil {
-		bot.logger.Error("yomiko failed to read text", slog.Any("error", err))
+		return nil, fmt.Errorf("bot.Bot.makeSSML: %w", err)
+	}
+
+	names, err := bot.getSpokenNames(ctx, msg.GuildID, messageUserIDs(msg)...)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.makeSSML: %w", err)
 	}
+
+	return buildSSML(r, filter, names, msg), nil
 }
 
-var urlRegexp = regexp.MustCompile(`https?://[^\s]{2,}`)
+// BuildSSML runs a message through the same pipeline used for reading
+// messages in voice channels, without connecting to Discord.
+func BuildSSML(cfg *Config, author, content string) *ssml.SSML {
+	return buildSSML(makeReplacer(cfg), nil, nil, &discordgo.Message{
+		Author: &discordgo.User{
+			Username: author,
+		},
+		Content: content,
+	})
+}
+
+// buildSSML builds the SSML of the message. names maps the user IDs to the
+// spoken names, which are read instead of the display names. It returns nil
+// if the filter drops the message, after the mentions are expanded.
+func buildSSML(r *replacer.Replacer, filter *wordFilter, names map[string]string, msg *discordgo.Message) *ssml.SSML {
+	content := newMentionReplacer(msg, names).Replace(msg.Content)
+	if filter.Drops(content) {
+		return nil
+	}
 
-func (bot *Bot) makeSSML(msg *discordgo.Message) string {
 	root := ssml.New()
-	author := messageAuthorName(msg)
+	author := messageAuthorName(msg, names)
 
 	// add author
 	authorSentence := &ssml.Sentence{}
-	bot.replacer.Replace(authorSentence, author)
+	r.Replace(authorSentence, author)
 	root.AddNode(&ssml.Paragraph{
 		Nodes: []ssml.Node{
 			authorSentence,
 		},
 	})
 
-	mr := newMentionReplacer(msg)
-
-	s := bufio.NewScanner(strings.NewReader(msg.Content))
+	s := bufio.NewScanner(strings.NewReader(content))
 
 	p := &ssml.Paragraph{}
 	root.AddNode(p)
@@ -279,14 +451,16 @@ func (bot *Bot) makeSSML(msg *discordgo.Message) string {
 		sentence := &ssml.Sentence{}
 		p.AddNode(sentence)
 
-		text := mr.Replace(strings.TrimSpace(s.Text()))
-		bot.replacer.Replace(sentence, text)
+		filter.Replace(sentence, r, strings.TrimSpace(s.Text()))
 	}
 
-	return root.ToSSML()
+	return root
 }
 
-func messageAuthorName(msg *discordgo.Message) (name string) {
+func messageAuthorName(msg *discordgo.Message, names map[string]string) (name string) {
+	if name, ok := names[msg.Author.ID]; ok {
+		return name
+	}
 	if msg.Member != nil {
 		name = msg.Member.Nick
 	}
@@ -300,11 +474,14 @@ func messageAuthorName(msg *discordgo.Message) (name string) {
 	return name
 }
 
-func newMentionReplacer(m *discordgo.Message) *strings.Replacer {
+func newMentionReplacer(m *discordgo.Message, names map[string]string) *strings.Replacer {
 	var oldnew []string
 
 	for _, user := range m.Mentions {
-		username := user.GlobalName
+		username := names[user.ID]
+		if username == "" {
+			username = user.GlobalName
+		}
 		if username == "" {
 			username = user.Username
 		}
@@ -319,153 +496,62 @@ func (bot *Bot) handleGuildCreate(s *discordgo.Session, event *discordgo.GuildCr
 	bot.logger.Info("guild created", slog.String("guild_id", event.ID), slog.String("guild_name", event.Name))
 }
 
-func (bot *Bot) handleInteractionCreate(s *discordgo.Session, event *discordgo.InteractionCreate) {
-	ctx := context.Background()
-
-	guildID := event.GuildID
-	channelID := event.ChannelID
-
-	var res *discordgo.InteractionResponse
-
-	data := event.ApplicationCommandData()
-	switch data.Name {
-	case "yomiko":
-		subCmd := data.Options[0]
-		switch subCmd.Name {
-		case "join":
-			voiceChannelID := subCmd.Options[0].Value.(string)
-
-			ys, err := bot.yomikoJoin(guildID, channelID, voiceChannelID)
-			if err != nil {
-				if errors.Is(err, errYomikoAlreadyJoined) {
-					res = createWarnResponse("入室済です", fmt.Sprintf("読子さんは既に <#%s> に入室しています。\n<#%s> への投稿を読み上げます。", ys.VoiceChannelID(), ys.TextChannelID()))
-				} else {
-					res = createErrorResponse("エラーが発生しました！", "")
-				}
-				break
-			}
+// handleVoiceStateUpdate cleans up the session when yomiko is disconnected
+// from the voice channel by others, such as a moderator.
+func (bot *Bot) handleVoiceStateUpdate(s *discordgo.Session, event *discordgo.VoiceStateUpdate) {
+	if event.UserID != bot.s.UserID() || event.ChannelID != "" {
+		return
+	}
 
-			res = &discordgo.InteractionResponse{
-				Type: discordgo.InteractionResponseChannelMessageWithSource,
-				Data: &discordgo.InteractionResponseData{
-					Embeds: []*discordgo.MessageEmbed{
-						{
-							Title:       "ごきげんよう、読子です",
-							Description: fmt.Sprintf("読子さんは <#%s> に入室しました。", ys.VoiceChannelID()),
-							Color:       colorSuccess,
-						},
-					},
-				},
-			}
-		case "leave":
-			voiceChannelID, err := bot.yomikoLeave(guildID)
-			if err != nil {
-				if errors.Is(err, errYomikoHasNotJoined) {
-					res = createWarnResponse("読子さんは入室していません", "")
-				} else {
-					res = createErrorResponse("エラーが発生しました！", "")
-				}
-				break
-			}
-			res = &discordgo.InteractionResponse{
-				Type: discordgo.InteractionResponseChannelMessageWithSource,
-				Data: &discordgo.InteractionResponseData{
-					Embeds: []*discordgo.MessageEmbed{
-						{
-							Title:       "みなさま、ごきげんよう",
-							Description: fmt.Sprintf("読子さんは <#%s> から退室しました。", voiceChannelID),
-							Color:       colorInfo,
-						},
-					},
-				},
-			}
-		case "voice":
-			voiceName := subCmd.Options[0].Value.(string)
-
-			userID := event.Member.User.ID
-			vs, err := bot.updateUserVoiceName(ctx, userID, voiceName)
-			if err != nil {
-				res = createErrorResponse("エラーが発生しました！", "")
-				break
-			}
+	voiceChannelID, err := bot.yomikoLeave(event.GuildID)
+	if err != nil {
+		if !errors.Is(err, errYomikoHasNotJoined) {
+			bot.logger.Error("failed to clean up session", slog.Any("error", err))
+		}
+		return
+	}
 
-			res = &discordgo.InteractionResponse{
-				Type: discordgo.InteractionResponseChannelMessageWithSource,
-				Data: &discordgo.InteractionResponseData{
-					Embeds: []*discordgo.MessageEmbed{
-						{
-							Title:       "ボイス設定",
-							Description: fmt.Sprintf("読子さんの声を「%s」に設定しました。", *vs.VoiceName),
-							Color:       colorSuccess,
-						},
-					},
-				},
-			}
-		case "speed":
-			speakingRate := subCmd.Options[0].Value.(float64)
-
-			userID := event.Member.User.ID
-			vs, err := bot.updateUserSpeakingRate(ctx, userID, speakingRate)
-			if err != nil {
-				res = createErrorResponse("エラーが発生しました！", "")
-				break
-			}
+	bot.logger.Info("disconnected from voice channel", slog.String("guild_id", event.GuildID), slog.String("voice_channel_id", voiceChannelID))
+}
 
-			res = &discordgo.InteractionResponse{
-				Type: discordgo.InteractionResponseChannelMessageWithSource,
-				Data: &discordgo.InteractionResponseData{
-					Embeds: []*discordgo.MessageEmbed{
-						{
-							Title:       "ボイス設定",
-							Description: fmt.Sprintf("読子さんの読み上げ速度を「%.01f」に設定しました。", *vs.SpeakingRate),
-							Color:       colorSuccess,
-						},
-					},
-				},
-			}
-		case "pitch":
-			pitch := subCmd.Options[0].Value.(float64)
-
-			userID := event.Member.User.ID
-			vs, err := bot.updateUserVoicePitch(ctx, userID, pitch)
-			if err != nil {
-				res = createErrorResponse("エラーが発生しました！", "")
-				break
-			}
+func (bot *Bot) handleInteractionCreate(s *discordgo.Session, event *discordgo.InteractionCreate) {
+	ctx := context.Background()
 
-			res = &discordgo.InteractionResponse{
-				Type: discordgo.InteractionResponseChannelMessageWithSource,
-				Data: &discordgo.InteractionResponseData{
-					Embeds: []*discordgo.MessageEmbed{
-						{
-							Title:       "ボイス設定",
-							Description: fmt.Sprintf("読子さんの声の音程を「%.01f」に設定しました。", *vs.Pitch),
-							Color:       colorSuccess,
-						},
-					},
-				},
-			}
-		case "reset":
-			userID := event.Member.User.ID
-			_, err := bot.resetUserVoiceSetting(ctx, userID)
-			if err != nil {
-				res = createErrorResponse("エラーが発生しました！", "")
-				break
-			}
+	// Discord waits for the response only for 3 seconds
+	deferral := bot.router.Deferral(event)
+	if deferral != nil {
+		err := bot.s.InteractionRespond(event.Interaction, deferral)
+		if err != nil {
+			bot.logger.Error("failed to defer response", slog.Any("error", err))
+			return
+		}
+	}
 
+	res, err := bot.router.Handle(ctx, event)
+	if event.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		if err != nil {
+			bot.logger.Error("failed to autocomplete", slog.Any("error", err))
 			res = &discordgo.InteractionResponse{
-				Type: discordgo.InteractionResponseChannelMessageWithSource,
+				Type: discordgo.InteractionApplicationCommandAutocompleteResult,
 				Data: &discordgo.InteractionResponseData{
-					Embeds: []*discordgo.MessageEmbed{
-						{
-							Title:       "ボイス設定",
-							Description: "読子さんの声の設定を初期値に設定しました。",
-							Color:       colorSuccess,
-						},
-					},
+					Choices: []*discordgo.ApplicationCommandOptionChoice{},
 				},
 			}
 		}
+		bot.s.InteractionRespond(event.Interaction, res)
+		return
+	}
+	if err != nil {
+		var permErr *command.PermissionError
+		switch {
+		case errors.As(err, &permErr):
+			res = createWarnResponse("権限がありません", fmt.Sprintf("このコマンドには「%s」の権限が必要です。", permissionNames(permErr.Permissions)))
+		case errors.Is(err, command.ErrInvalidOption):
+			bot.logger.Warn("invalid command option", slog.Any("error", err))
+			res = createWarnResponse("コマンドの指定が正しくありません", "")
+		default:
+			bot.logger.Error("failed to handle command", slog.Any("error", err))
+		}
 	}
 
 	if res == nil {
@@ -480,7 +566,86 @@ func (bot *Bot) handleInteractionCreate(s *discordgo.Session, event *discordgo.I
 			},
 		}
 	}
-	s.InteractionRespond(event.Interaction, res)
+	if deferral != nil {
+		_, err := bot.s.InteractionResponseEdit(event.Interaction, webhookEdit(res))
+		if err != nil {
+			bot.logger.Error("failed to edit deferred response", slog.Any("error", err))
+		}
+		return
+	}
+	bot.s.InteractionRespond(event.Interaction, res)
+}
+
+// webhookEdit returns the edit replacing the deferred response with res.
+// The flags are those of the deferral, since the edit cannot change them.
+func webhookEdit(res *discordgo.InteractionResponse) *discordgo.WebhookEdit {
+	edit := &discordgo.WebhookEdit{}
+	if res.Data == nil {
+		return edit
+	}
+
+	data := res.Data
+	edit.Content = &data.Content
+	edit.Files = data.Files
+	edit.Attachments = data.Attachments
+	edit.AllowedMentions = data.AllowedMentions
+	// null is not accepted
+	if data.Components != nil {
+		edit.Components = &data.Components
+	}
+	if data.Embeds != nil {
+		edit.Embeds = &data.Embeds
+	}
+
+	return edit
+}
+
+var permissionNameList = []struct {
+	permission int64
+	name       string
+}{
+	{discordgo.PermissionAdministrator, "管理者"},
+	{discordgo.PermissionManageServer, "サーバー管理"},
+}
+
+func permissionNames(permissions int64) string {
+	var names []string
+	for _, p := range permissionNameList {
+		if permissions&p.permission != 0 {
+			names = append(names, p.name)
+		}
+	}
+	return strings.Join(names, "」「")
+}
+
+func createSuccessResponse(title, description string) *discordgo.InteractionResponse {
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds: []*discordgo.MessageEmbed{
+				{
+					Title:       title,
+					Description: description,
+					Color:       colorSuccess,
+				},
+			},
+		},
+	}
+}
+
+func createInfoResponse(title, description string) *discordgo.InteractionResponse {
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds: []*discordgo.MessageEmbed{
+				{
+					Title:       title,
+					Description: description,
+					Color:       colorInfo,
+				},
+			},
+		},
+	}
 }
 
 func createWarnResponse(title, description string) *discordgo.InteractionResponse {
@@ -513,18 +678,14 @@ func createErrorResponse(title, description string) *discordgo.InteractionRespon
 	}
 }
 
-func (bot *Bot) cleanupApplicationCommands() {
-	for _, cmd := range bot.commands {
-		err := bot.s.ApplicationCommandDelete(bot.s.State.User.ID, "", cmd.ID)
-		if err != nil {
-			bot.logger.Error("failed to delete application command", slog.Any("error", err))
-		}
-	}
-}
-
-func (bot *Bot) yomikoJoin(guildID, textChannelID, voiceChannelID string) (*yomikoSession, error) {
+func (bot *Bot) yomikoJoin(ctx context.Context, guildID, textChannelID, voiceChannelID string) (*yomikoSession, error) {
 	defer bot.updateGameStatus()
 
+	gs, err := bot.getGuildSetting(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.yomikoJoin: %w", err)
+	}
+
 	bot.mu.Lock()
 	defer bot.mu.Unlock()
 
@@ -533,15 +694,35 @@ func (bot *Bot) yomikoJoin(guildID, textChannelID, voiceChannelID string) (*yomi
 		return ys, errYomikoAlreadyJoined
 	}
 
-	ys, err := newYomikoSession(bot.s, bot.tts, guildID, textChannelID, voiceChannelID)
+	var recorder *sessionRecorder
+	if bot.recording != nil {
+		recorder = newSessionRecorder(*bot.recording, outputFormat, bot.logger, guildID, textChannelID, voiceChannelID)
+	}
+
+	ys, err = newYomikoSession(bot.s, bot.tts, bot.cfg, bot.opus.withGuildSetting(gs), recorder, guildID, textChannelID, voiceChannelID)
 	if err != nil {
+		if recorder != nil {
+			err = errors.Join(err, recorder.Close())
+		}
 		return nil, fmt.Errorf("bot.Bot.yomikoJoin: %w", err)
 	}
+	if bot.bgm != nil {
+		ys.SetBGMVolume(bot.bgm.volume)
+	}
 	bot.sessions[guildID] = ys
 
 	return ys, nil
 }
 
+// getSession returns the session in the guild, if joined.
+func (bot *Bot) getSession(guildID string) (*yomikoSession, bool) {
+	bot.mu.RLock()
+	defer bot.mu.RUnlock()
+
+	ys, ok := bot.sessions[guildID]
+	return ys, ok
+}
+
 func (bot *Bot) yomikoLeave(guildID string) (string, error) {
 	defer bot.updateGameStatus()
 
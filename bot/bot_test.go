@@ -0,0 +1,1218 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/go-cmp/cmp"
+	"github.com/kechako/yomiko/audio/pcm"
+	"github.com/kechako/yomiko/bot/internal/replacer"
+	"github.com/kechako/yomiko/ent"
+	"github.com/kechako/yomiko/ent/guildsetting"
+)
+
+const (
+	testGuildID        = "guild"
+	testTextChannelID  = "text"
+	testVoiceChannelID = "voice"
+)
+
+func TestJoinReadLeave(t *testing.T) {
+	h := newHarness(t, nil)
+	member := testMember("user", 0)
+
+	res := h.command(testGuildID, testTextChannelID, member, "yomiko join", channelOption("voice-channel", testVoiceChannelID))
+	if embed := responseEmbed(t, res); embed.Color != colorSuccess {
+		t.Fatalf("join: got %q, want success", embed.Title)
+	}
+
+	conn := h.voice(testGuildID)
+	if conn == nil || conn.channelID != testVoiceChannelID {
+		t.Fatalf("join: not connected to %q", testVoiceChannelID)
+	}
+	if got, want := h.discord.status, "1 個のサーバーで読み上げ"; got != want {
+		t.Errorf("status: got %q, want %q", got, want)
+	}
+
+	h.message(testGuildID, testTextChannelID, member.User, "こんにちは")
+	read := len(conn.Packets())
+	if read == 0 {
+		t.Fatal("message in the text channel is not read")
+	}
+
+	h.message(testGuildID, "other", member.User, "こんにちは")
+	h.message(testGuildID, testTextChannelID, &discordgo.User{ID: testBotUserID}, "こんにちは")
+	if got := len(conn.Packets()); got != read {
+		t.Errorf("got %d packets, want %d", got, read)
+	}
+
+	res = h.command(testGuildID, testTextChannelID, member, "yomiko leave")
+	if embed := responseEmbed(t, res); embed.Color != colorInfo {
+		t.Fatalf("leave: got %q, want info", embed.Title)
+	}
+	if !conn.Disconnected() {
+		t.Error("leave: not disconnected")
+	}
+}
+
+func TestCommandWarnings(t *testing.T) {
+	member := testMember("user", 0)
+
+	tests := []struct {
+		setup   []string
+		command string
+		title   string
+	}{
+		{
+			command: "yomiko leave",
+			title:   "読子さんは入室していません",
+		},
+		{
+			setup:   []string{"yomiko join"},
+			command: "yomiko join",
+			title:   "入室済です",
+		},
+		{
+			command: "yomiko opus reset",
+			title:   "権限がありません",
+		},
+		{
+			command: "yomiko skip",
+			title:   "読子さんは入室していません",
+		},
+		{
+			setup:   []string{"yomiko join"},
+			command: "yomiko skip",
+			title:   "読み上げ中の投稿はありません",
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			h := newHarness(t, nil)
+
+			run := func(path string) *discordgo.InteractionResponse {
+				var opts []*discordgo.ApplicationCommandInteractionDataOption
+				if path == "yomiko join" {
+					opts = append(opts, channelOption("voice-channel", testVoiceChannelID))
+				}
+				return h.command(testGuildID, testTextChannelID, member, path, opts...)
+			}
+
+			for _, path := range tt.setup {
+				run(path)
+			}
+
+			embed := responseEmbed(t, run(tt.command))
+			if embed.Color != colorWarn || embed.Title != tt.title {
+				t.Errorf("got %q (%#x), want %q (warn)", embed.Title, embed.Color, tt.title)
+			}
+		})
+	}
+}
+
+func TestVoiceStateUpdateDisconnected(t *testing.T) {
+	h := newHarness(t, nil)
+	member := testMember("user", 0)
+
+	h.command(testGuildID, testTextChannelID, member, "yomiko join", channelOption("voice-channel", testVoiceChannelID))
+	conn := h.voice(testGuildID)
+	if conn == nil {
+		t.Fatal("join: not connected")
+	}
+
+	// other users leaving do not matter
+	h.voiceStateUpdate(testGuildID, member.User.ID, "")
+	if conn.Disconnected() {
+		t.Fatal("disconnected by other users leaving")
+	}
+
+	// disconnected by a moderator
+	h.voiceStateUpdate(testGuildID, testBotUserID, "")
+	if !conn.Disconnected() {
+		t.Error("session is not cleaned up")
+	}
+
+	h.message(testGuildID, testTextChannelID, member.User, "こんにちは")
+	if got := len(conn.Packets()); got != 0 {
+		t.Errorf("got %d packets after disconnected, want 0", got)
+	}
+}
+
+func TestReadyRegistersCommands(t *testing.T) {
+	h := newHarness(t, nil)
+
+	// resumed sessions receive Ready again
+	h.discord.dispatch(&discordgo.Ready{})
+	h.discord.dispatch(&discordgo.Ready{})
+
+	var names []string
+	commands := h.discord.commands[""]
+	for _, cmd := range commands {
+		names = append(names, cmd.Name)
+	}
+	if diff := cmp.Diff([]string{"yomiko", "yomiko-access"}, names); diff != "" {
+		t.Fatalf("commands mismatch (-want +got):\n%s", diff)
+	}
+	if p := commands[1].DefaultMemberPermissions; p == nil || *p != discordgo.PermissionManageServer {
+		t.Errorf("yomiko-access: got default member permissions %v, want manage server", p)
+	}
+
+	var got []string
+	for _, opt := range commands[0].Options {
+		got = append(got, opt.Name)
+	}
+	want := []string{"join", "leave", "skip", "voice", "speed", "pitch", "reset", "preview", "ignore", "ngword", "dict", "mydict", "callme", "opus"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("subcommands mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestVoiceAutocomplete(t *testing.T) {
+	member := testMember("user", 0)
+
+	tests := []struct {
+		value   string
+		options []*discordgo.ApplicationCommandInteractionDataOption
+		want    []string
+	}{
+		{
+			value: "",
+			want:  []string{"ja-JP-Fake-A", "ja-JP-Fake-B", "ja-JP-Fake-C"},
+		},
+		{
+			value: "fake-b",
+			want:  []string{"ja-JP-Fake-B"},
+		},
+		{
+			value: "女性",
+			want:  []string{"ja-JP-Fake-A"},
+		},
+		{
+			value:   "",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{option("gender", "neutral")},
+			want:    []string{"ja-JP-Fake-C"},
+		},
+		{
+			value:   "",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{option("engine", "Neural2")},
+			want:    []string{},
+		},
+		{
+			value: "unknown",
+			want:  []string{},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			h := newHarness(t, nil)
+
+			choices := h.autocomplete(testGuildID, testTextChannelID, member, "yomiko voice", option("voice", tt.value), tt.options...)
+
+			got := []string{}
+			for _, c := range choices {
+				got = append(got, c.Value.(string))
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("autocomplete mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVoiceCommand(t *testing.T) {
+	member := testMember("user", 0)
+
+	tests := []struct {
+		voice string
+		color int
+	}{
+		{voice: "ja-JP-Fake-B", color: colorSuccess},
+		{voice: "ja-JP-Unknown", color: colorWarn},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			h := newHarness(t, nil)
+
+			embed := responseEmbed(t, h.command(testGuildID, testTextChannelID, member, "yomiko voice", option("voice", tt.voice)))
+			if embed.Color != tt.color {
+				t.Errorf("got %q (%#x), want %#x", embed.Title, embed.Color, tt.color)
+			}
+		})
+	}
+}
+
+func TestPreview(t *testing.T) {
+	member := testMember("user", 0)
+
+	t.Run("file", func(t *testing.T) {
+		h := newHarness(t, nil)
+
+		res := h.command(testGuildID, testTextChannelID, member, "yomiko preview", option("voice", "ja-JP-Fake-B"), option("text", "テスト"))
+		if embed := responseEmbed(t, res); embed.Color != colorSuccess {
+			t.Fatalf("got %q, want success", embed.Title)
+		}
+		if len(h.discord.deferred) != 1 {
+			t.Errorf("got deferred responses %v, want 1", h.discord.deferred)
+		}
+		if res.Data.Flags&discordgo.MessageFlagsEphemeral == 0 {
+			t.Error("response is not ephemeral")
+		}
+		if len(res.Data.Files) != 1 {
+			t.Fatalf("got %d files, want 1", len(res.Data.Files))
+		}
+
+		wr, err := pcm.NewWAVReader(res.Data.Files[0].Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(wr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(data) == 0 {
+			t.Error("preview has no sound")
+		}
+	})
+
+	t.Run("voice_channel", func(t *testing.T) {
+		h := newHarness(t, nil)
+
+		h.command(testGuildID, testTextChannelID, member, "yomiko join", channelOption("voice-channel", testVoiceChannelID))
+		conn := h.voice(testGuildID)
+		if conn == nil {
+			t.Fatal("join: not connected")
+		}
+
+		res := h.command(testGuildID, testTextChannelID, member, "yomiko preview", option("voice", "ja-JP-Fake-C"))
+		if embed := responseEmbed(t, res); embed.Color != colorSuccess {
+			t.Fatalf("got %q, want success", embed.Title)
+		}
+		if len(res.Data.Files) != 0 {
+			t.Error("preview in the voice channel has files")
+		}
+
+		// read in the background
+		deadline := time.Now().Add(5 * time.Second)
+		for len(conn.Packets()) == 0 {
+			if time.Now().After(deadline) {
+				t.Fatal("preview is not read")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		h := newHarness(t, nil)
+
+		for _, opts := range [][]*discordgo.ApplicationCommandInteractionDataOption{
+			{option("voice", "ja-JP-Unknown")},
+			{option("voice", "ja-JP-Fake-A"), option("text", strings.Repeat("あ", maxPreviewLength+1))},
+		} {
+			if embed := responseEmbed(t, h.command(testGuildID, testTextChannelID, member, "yomiko preview", opts...)); embed.Color != colorWarn {
+				t.Errorf("got %q, want warn", embed.Title)
+			}
+		}
+	})
+}
+
+func TestCommandGuilds(t *testing.T) {
+	guildIDs := []string{"dev1", "dev2"}
+	h := newHarness(t, &Config{
+		Commands: &CommandsConfig{GuildIDs: guildIDs},
+	})
+
+	h.discord.dispatch(&discordgo.Ready{})
+
+	for _, guildID := range guildIDs {
+		if got := len(h.discord.commands[guildID]); got != 2 {
+			t.Errorf("guild %q: got %d commands, want 2", guildID, got)
+		}
+	}
+	if got := len(h.discord.commands[""]); got != 0 {
+		t.Errorf("global: got %d commands, want 0", got)
+	}
+
+	if err := h.bot.deleteCommands(context.Background(), []string{"dev1"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(h.discord.commands["dev1"]); got != 0 {
+		t.Errorf("guild %q: got %d commands after delete, want 0", "dev1", got)
+	}
+	if got := len(h.discord.commands["dev2"]); got != 2 {
+		t.Errorf("guild %q: got %d commands after delete, want 2", "dev2", got)
+	}
+}
+
+func TestAccessRules(t *testing.T) {
+	const (
+		roleID    = "role"
+		managerID = "manager"
+	)
+	manager := testMember(managerID, discordgo.PermissionManageServer)
+	member := testMember("user", 0)
+	roleMember := testMember("role-user", 0)
+	roleMember.Roles = []string{roleID}
+
+	tests := []struct {
+		rules   []string
+		member  *discordgo.Member
+		command string
+		options []*discordgo.ApplicationCommandInteractionDataOption
+		color   int
+	}{
+		// anyone can leave by default
+		{member: member, command: "yomiko leave", color: colorInfo},
+		{rules: []string{"leave"}, member: member, command: "yomiko leave", color: colorWarn},
+		{rules: []string{"leave"}, member: roleMember, command: "yomiko leave", color: colorInfo},
+		{rules: []string{"leave"}, member: manager, command: "yomiko leave", color: colorInfo},
+		// settings are for managers by default
+		{member: member, command: "yomiko opus reset", color: colorWarn},
+		{member: roleMember, command: "yomiko opus reset", color: colorWarn},
+		{rules: []string{"settings"}, member: roleMember, command: "yomiko opus reset", color: colorSuccess},
+		{member: manager, command: "yomiko opus reset", color: colorSuccess},
+		{member: member, command: "yomiko bgm stop", color: colorWarn},
+		{member: member, command: "yomiko bgm volume", options: []*discordgo.ApplicationCommandInteractionDataOption{option("volume", 50)}, color: colorWarn},
+		{rules: []string{"settings"}, member: roleMember, command: "yomiko bgm volume", options: []*discordgo.ApplicationCommandInteractionDataOption{option("volume", 50)}, color: colorSuccess},
+		{member: manager, command: "yomiko bgm volume", options: []*discordgo.ApplicationCommandInteractionDataOption{option("volume", 50)}, color: colorSuccess},
+		// rules of the other actions
+		{rules: []string{"join"}, member: member, command: "yomiko leave", color: colorInfo},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			h := newHarness(t, &Config{
+				BGM: &BGMConfig{Directory: t.TempDir()},
+			})
+
+			h.command(testGuildID, testTextChannelID, manager, "yomiko join", channelOption("voice-channel", testVoiceChannelID))
+			for _, action := range tt.rules {
+				res := h.command(testGuildID, testTextChannelID, manager, "yomiko-access allow", option("action", action), roleOption("role", roleID))
+				if embed := responseEmbed(t, res); embed.Color != colorSuccess {
+					t.Fatalf("allow: got %q, want success", embed.Title)
+				}
+			}
+
+			res := h.command(testGuildID, testTextChannelID, tt.member, tt.command, tt.options...)
+			embed := responseEmbed(t, res)
+			if tt.color == colorWarn {
+				if embed.Color != colorWarn || embed.Title != "権限がありません" {
+					t.Errorf("got %q (%#x), want denied", embed.Title, embed.Color)
+				}
+				return
+			}
+			if embed.Color != tt.color {
+				t.Errorf("got %q (%#x), want %#x", embed.Title, embed.Color, tt.color)
+			}
+		})
+	}
+}
+
+func TestAccessCommand(t *testing.T) {
+	h := newHarness(t, nil)
+	manager := testMember("manager", discordgo.PermissionManageServer)
+
+	run := func(path string, opts ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.MessageEmbed {
+		return responseEmbed(t, h.command(testGuildID, testTextChannelID, manager, path, opts...))
+	}
+
+	if embed := run("yomiko-access allow", option("action", "skip"), roleOption("role", "a")); embed.Color != colorSuccess {
+		t.Errorf("allow: got %q", embed.Title)
+	}
+	if embed := run("yomiko-access allow", option("action", "skip"), roleOption("role", "a")); embed.Color != colorWarn {
+		t.Errorf("allow twice: got %q", embed.Title)
+	}
+	if embed := run("yomiko-access list"); !strings.Contains(embed.Description, "読み上げのスキップ: <@&a>") {
+		t.Errorf("list: got %q", embed.Description)
+	}
+	if embed := run("yomiko-access revoke", option("action", "skip"), roleOption("role", "a")); embed.Color != colorSuccess {
+		t.Errorf("revoke: got %q", embed.Title)
+	}
+	if embed := run("yomiko-access revoke", option("action", "skip"), roleOption("role", "a")); embed.Color != colorWarn {
+		t.Errorf("revoke twice: got %q", embed.Title)
+	}
+
+	res := h.command(testGuildID, testTextChannelID, testMember("user", 0), "yomiko-access list")
+	if embed := responseEmbed(t, res); embed.Title != "権限がありません" {
+		t.Errorf("list by a member: got %q", embed.Title)
+	}
+}
+
+func TestIgnore(t *testing.T) {
+	manager := testMember("manager", discordgo.PermissionManageServer)
+
+	tests := []struct {
+		setup   [][]*discordgo.ApplicationCommandInteractionDataOption
+		path    string
+		message *discordgo.Message
+		read    bool
+	}{
+		{
+			message: &discordgo.Message{Author: &discordgo.User{ID: "user"}},
+			read:    true,
+		},
+		{
+			setup:   [][]*discordgo.ApplicationCommandInteractionDataOption{{userOption("user", "user")}},
+			path:    "yomiko ignore add",
+			message: &discordgo.Message{Author: &discordgo.User{ID: "user"}},
+			read:    false,
+		},
+		{
+			setup:   [][]*discordgo.ApplicationCommandInteractionDataOption{{userOption("user", "other")}},
+			path:    "yomiko ignore add",
+			message: &discordgo.Message{Author: &discordgo.User{ID: "user"}},
+			read:    true,
+		},
+		{
+			setup: [][]*discordgo.ApplicationCommandInteractionDataOption{{roleOption("role", "muted")}},
+			path:  "yomiko ignore add",
+			message: &discordgo.Message{
+				Author: &discordgo.User{ID: "user"},
+				Member: &discordgo.Member{Roles: []string{"muted"}},
+			},
+			read: false,
+		},
+		{
+			setup:   [][]*discordgo.ApplicationCommandInteractionDataOption{{option("read", false)}},
+			path:    "yomiko ignore bots",
+			message: &discordgo.Message{Author: &discordgo.User{ID: "other-bot", Bot: true}},
+			read:    false,
+		},
+		{
+			setup:   [][]*discordgo.ApplicationCommandInteractionDataOption{{option("read", false)}},
+			path:    "yomiko ignore bots",
+			message: &discordgo.Message{Author: &discordgo.User{ID: "hook", Bot: true}, WebhookID: "hook"},
+			read:    true,
+		},
+		{
+			setup:   [][]*discordgo.ApplicationCommandInteractionDataOption{{option("read", false)}},
+			path:    "yomiko ignore webhooks",
+			message: &discordgo.Message{Author: &discordgo.User{ID: "hook", Bot: true}, WebhookID: "hook"},
+			read:    false,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			h := newHarness(t, nil)
+
+			h.command(testGuildID, testTextChannelID, manager, "yomiko join", channelOption("voice-channel", testVoiceChannelID))
+			conn := h.voice(testGuildID)
+			if conn == nil {
+				t.Fatal("join: not connected")
+			}
+			for _, opts := range tt.setup {
+				if embed := responseEmbed(t, h.command(testGuildID, testTextChannelID, manager, tt.path, opts...)); embed.Color != colorSuccess {
+					t.Fatalf("%s: got %q, want success", tt.path, embed.Title)
+				}
+			}
+
+			msg := *tt.message
+			msg.GuildID = testGuildID
+			msg.ChannelID = testTextChannelID
+			msg.Content = "こんにちは"
+			h.discord.dispatch(&discordgo.MessageCreate{Message: &msg})
+
+			if got := len(conn.Packets()) > 0; got != tt.read {
+				t.Errorf("read: got %v, want %v", got, tt.read)
+			}
+		})
+	}
+}
+
+func TestIgnoreCommand(t *testing.T) {
+	h := newHarness(t, nil)
+	manager := testMember("manager", discordgo.PermissionManageServer)
+
+	run := func(path string, opts ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.MessageEmbed {
+		return responseEmbed(t, h.command(testGuildID, testTextChannelID, manager, path, opts...))
+	}
+
+	if embed := run("yomiko ignore add"); embed.Color != colorWarn {
+		t.Errorf("add without target: got %q", embed.Title)
+	}
+	if embed := run("yomiko ignore add", userOption("user", "a"), roleOption("role", "b")); embed.Color != colorWarn {
+		t.Errorf("add with both: got %q", embed.Title)
+	}
+	run("yomiko ignore add", userOption("user", "a"))
+	if embed := run("yomiko ignore add", userOption("user", "a")); embed.Color != colorWarn {
+		t.Errorf("add twice: got %q", embed.Title)
+	}
+	run("yomiko ignore add", roleOption("role", "b"))
+	run("yomiko ignore webhooks", option("read", false))
+
+	want := "ユーザー: <@a>\nロール: <@&b>\n他のボット: 読み上げる\nWebhook: 読み上げない"
+	if embed := run("yomiko ignore list"); embed.Description != want {
+		t.Errorf("list: got %q, want %q", embed.Description, want)
+	}
+
+	if embed := run("yomiko ignore remove", userOption("user", "a")); embed.Color != colorSuccess {
+		t.Errorf("remove: got %q", embed.Title)
+	}
+	if embed := run("yomiko ignore remove", userOption("user", "a")); embed.Color != colorWarn {
+		t.Errorf("remove twice: got %q", embed.Title)
+	}
+
+	res := h.command(testGuildID, testTextChannelID, testMember("user", 0), "yomiko ignore add", userOption("user", "manager"))
+	if embed := responseEmbed(t, res); embed.Title != "権限がありません" {
+		t.Errorf("add by a member: got %q", embed.Title)
+	}
+}
+
+func TestWordFilter(t *testing.T) {
+	replacement := "ほにゃらら"
+	words := []*ent.NGWord{
+		{Pattern: "bad"},
+		{Pattern: `ba+ka`, Regex: true},
+	}
+
+	tests := []struct {
+		mode     guildsetting.NgWordMode
+		content  string
+		mentions []*discordgo.User
+		want     string
+		drop     bool
+	}{
+		{
+			mode:    guildsetting.NgWordModeBleep,
+			content: "a BAD word",
+			want:    `<speak><p><s>a</s></p><p><s>a <say-as interpret-as="expletive">BAD</say-as> word</s></p></speak>`,
+		},
+		{
+			mode:    guildsetting.NgWordModeReplace,
+			content: "baaaka and bad",
+			want:    `<speak><p><s>a</s></p><p><s><sub alias="ほにゃらら">baaaka</sub> and <sub alias="ほにゃらら">bad</sub></s></p></speak>`,
+		},
+		{
+			mode:    guildsetting.NgWordModeDrop,
+			content: "baka",
+			drop:    true,
+		},
+		{
+			mode:    guildsetting.NgWordModeDrop,
+			content: "good",
+			want:    `<speak><p><s>a</s></p><p><s>good</s></p></speak>`,
+		},
+		{
+			mode:     guildsetting.NgWordModeDrop,
+			content:  "<@u1> hi",
+			mentions: []*discordgo.User{{ID: "u1", Username: "baka"}},
+			drop:     true,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			f, err := newWordFilter(words, &ent.GuildSetting{
+				NgWordMode:        &tt.mode,
+				NgWordReplacement: &replacement,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc := buildSSML(replacer.New(), f, nil, &discordgo.Message{
+				Author:   &discordgo.User{Username: "a"},
+				Content:  tt.content,
+				Mentions: tt.mentions,
+			})
+			if got := doc == nil; got != tt.drop {
+				t.Fatalf("buildSSML(): dropped %v, want %v", got, tt.drop)
+			}
+			if tt.drop {
+				return
+			}
+
+			var b strings.Builder
+			doc.WriteSSML(&b)
+			if got := b.String(); got != tt.want {
+				t.Errorf("buildSSML(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNGWordCommand(t *testing.T) {
+	h := newHarness(t, nil)
+	manager := testMember("manager", discordgo.PermissionManageServer)
+
+	run := func(path string, opts ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.MessageEmbed {
+		return responseEmbed(t, h.command(testGuildID, testTextChannelID, manager, path, opts...))
+	}
+
+	if embed := run("yomiko ngword add", option("word", "("), option("regex", true)); embed.Color != colorWarn {
+		t.Errorf("add invalid regex: got %q", embed.Title)
+	}
+	if embed := run("yomiko ngword add", option("word", "a*"), option("regex", true)); embed.Color != colorWarn {
+		t.Errorf("add regex matching empty text: got %q", embed.Title)
+	}
+	if embed := run("yomiko ngword add", option("word", "ダメ")); embed.Color != colorSuccess {
+		t.Errorf("add: got %q", embed.Title)
+	}
+	if embed := run("yomiko ngword add", option("word", "ダメ")); embed.Color != colorWarn {
+		t.Errorf("add twice: got %q", embed.Title)
+	}
+	if embed := run("yomiko ngword mode", option("mode", "drop")); embed.Color != colorSuccess {
+		t.Errorf("mode: got %q", embed.Title)
+	}
+	if embed := run("yomiko ngword list"); embed.Description != "扱い: 投稿を読み上げない\n\n`ダメ`" {
+		t.Errorf("list: got %q", embed.Description)
+	}
+
+	run("yomiko join", channelOption("voice-channel", testVoiceChannelID))
+	conn := h.voice(testGuildID)
+	if conn == nil {
+		t.Fatal("join: not connected")
+	}
+	h.message(testGuildID, testTextChannelID, manager.User, "それはダメです")
+	if got := len(conn.Packets()); got != 0 {
+		t.Errorf("message with an NG word: got %d packets, want 0", got)
+	}
+
+	if embed := run("yomiko ngword remove", option("word", "ダメ")); embed.Color != colorSuccess {
+		t.Errorf("remove: got %q", embed.Title)
+	}
+	h.message(testGuildID, testTextChannelID, manager.User, "それはダメです")
+	if got := len(conn.Packets()); got == 0 {
+		t.Error("message without NG words is not read")
+	}
+
+	res := h.command(testGuildID, testTextChannelID, testMember("user", 0), "yomiko ngword mode", option("mode", "bleep"))
+	if embed := responseEmbed(t, res); embed.Title != "権限がありません" {
+		t.Errorf("mode by a member: got %q", embed.Title)
+	}
+}
+
+func TestMyDictCommand(t *testing.T) {
+	h := newHarness(t, &Config{
+		Replacements: []*Replacement{
+			{From: "yomiko", To: "よみこ"},
+		},
+	})
+	member := testMember("user", 0)
+
+	run := func(path string, opts ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.MessageEmbed {
+		return responseEmbed(t, h.command(testGuildID, testTextChannelID, member, path, opts...))
+	}
+
+	if embed := run("yomiko mydict list"); embed.Description != "辞書に登録された言葉はありません。" {
+		t.Errorf("list empty: got %q", embed.Description)
+	}
+	if embed := run("yomiko mydict add", option("word", "yomiko"), option("reading", "どくこ")); embed.Color != colorSuccess {
+		t.Errorf("add: got %q", embed.Title)
+	}
+	if embed := run("yomiko mydict add", option("word", "猫"), option("reading", "にゃんこ")); embed.Color != colorSuccess {
+		t.Errorf("add: got %q", embed.Title)
+	}
+	if embed := run("yomiko mydict add", option("word", "猫"), option("reading", "ねこ")); embed.Description != "「猫」の読み方を「ねこ」に変更しました。" {
+		t.Errorf("add existing word: got %q", embed.Description)
+	}
+	if embed := run("yomiko mydict add", option("word", " "), option("reading", "なし")); embed.Color != colorWarn {
+		t.Errorf("add empty word: got %q", embed.Title)
+	}
+	if embed := run("yomiko mydict list"); embed.Description != "yomiko → どくこ\n猫 → ねこ" {
+		t.Errorf("list: got %q", embed.Description)
+	}
+
+	tests := []struct {
+		userID string
+		want   string
+	}{
+		{
+			userID: "user",
+			want:   `<speak><p><s><sub alias="どくこ">yomiko</sub></s></p><p><s><sub alias="どくこ">yomiko</sub>の<sub alias="ねこ">猫</sub></s></p></speak>`,
+		},
+		{
+			userID: "other",
+			want:   `<speak><p><s><sub alias="よみこ">yomiko</sub></s></p><p><s><sub alias="よみこ">yomiko</sub>の猫</s></p></speak>`,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			doc, err := h.bot.makeSSML(context.Background(), nil, &discordgo.Message{
+				Author:  &discordgo.User{ID: tt.userID, Username: "yomiko"},
+				Content: "yomikoの猫",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var b strings.Builder
+			doc.WriteSSML(&b)
+			if got := b.String(); got != tt.want {
+				t.Errorf("Bot.makeSSML(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	choices := h.autocomplete(testGuildID, testTextChannelID, member, "yomiko mydict remove", option("word", "猫"))
+	if len(choices) != 1 || choices[0].Value != "猫" {
+		t.Errorf("autocomplete: got %v", choices)
+	}
+
+	if embed := run("yomiko mydict remove", option("word", "猫")); embed.Color != colorSuccess {
+		t.Errorf("remove: got %q", embed.Title)
+	}
+	if embed := run("yomiko mydict remove", option("word", "猫")); embed.Color != colorWarn {
+		t.Errorf("remove twice: got %q", embed.Title)
+	}
+}
+
+func TestCallMe(t *testing.T) {
+	h := newHarness(t, nil)
+	member := testMember("user", 0)
+
+	run := func(path string, opts ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.MessageEmbed {
+		return responseEmbed(t, h.command(testGuildID, testTextChannelID, member, path, opts...))
+	}
+
+	if embed := run("yomiko callme", option("name", "ゆーざー")); embed.Color != colorSuccess {
+		t.Errorf("callme: got %q", embed.Title)
+	}
+	if embed := run("yomiko callme", option("name", "ユーザー")); embed.Color != colorSuccess {
+		t.Errorf("callme again: got %q", embed.Title)
+	}
+	if embed := run("yomiko callme", option("name", strings.Repeat("あ", maxSpokenNameLength+1))); embed.Color != colorWarn {
+		t.Errorf("callme with a long name: got %q", embed.Title)
+	}
+
+	tests := []struct {
+		guildID string
+		want    string
+	}{
+		{
+			guildID: testGuildID,
+			want:    `<speak><p><s>ユーザー</s></p><p><s>ユーザーさん、おはよう</s></p></speak>`,
+		},
+		{
+			guildID: "other-guild",
+			want:    `<speak><p><s>user★</s></p><p><s>user★さん、おはよう</s></p></speak>`,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			user := &discordgo.User{ID: "user", Username: "user★"}
+			doc, err := h.bot.makeSSML(context.Background(), nil, &discordgo.Message{
+				GuildID:  tt.guildID,
+				Author:   user,
+				Content:  "<@user>さん、おはよう",
+				Mentions: []*discordgo.User{user},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var b strings.Builder
+			doc.WriteSSML(&b)
+			if got := b.String(); got != tt.want {
+				t.Errorf("Bot.makeSSML(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if embed := run("yomiko callme"); embed.Color != colorSuccess {
+		t.Errorf("reset: got %q", embed.Title)
+	}
+	if embed := run("yomiko callme"); embed.Color != colorInfo {
+		t.Errorf("reset twice: got %q", embed.Title)
+	}
+}
+
+func TestDictCommand(t *testing.T) {
+	files := map[string]string{
+		"/dict.csv":  "word,reading\n猫,にゃんこ\n読子,よみこ\n読子,よみこ\n",
+		"/dict.txt":  "猫,にゃんこ\n",
+		"/dict.json": `[{"from": "犬", "to": ""}]`,
+		// larger than the size of the attachment
+		"/large.csv": strings.Repeat("a", maxDictFileSize+1),
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, files[r.URL.Path])
+	}))
+	defer srv.Close()
+
+	h := newHarness(t, nil)
+	member := testMember("user", 0)
+
+	run := func(path string, opts ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.InteractionResponse {
+		return h.command(testGuildID, testTextChannelID, member, path, opts...)
+	}
+	file := func(name string, size int) *discordgo.ApplicationCommandInteractionDataOption {
+		return h.attachmentOption("file", name, srv.URL+"/"+name, size)
+	}
+
+	if embed := responseEmbed(t, run("yomiko dict add", option("word", "猫"), option("reading", "ねこ"))); embed.Color != colorSuccess {
+		t.Errorf("add: got %q", embed.Title)
+	}
+
+	if len(h.discord.deferred) != 0 {
+		t.Errorf("add: got deferred responses %v", h.discord.deferred)
+	}
+	if embed := responseEmbed(t, run("yomiko dict add", option("word", "猫"), option("reading", "ねこ"))); embed.Title != "登録済です" {
+		t.Errorf("add twice: got %q", embed.Title)
+	}
+
+	embed := responseEmbed(t, run("yomiko dict import", file("dict.csv", 100), option("dry-run", true)))
+	if len(h.discord.deferred) != 1 {
+		t.Errorf("import: got deferred responses %v", h.discord.deferred)
+	}
+	if want := "追加: 1件、変更: 1件、変更なし: 0件\nファイル内の重複: 読子 (後の読み方を使います)\n```diff\n+ 読子 → よみこ\n- 猫 → ねこ\n+ 猫 → にゃんこ\n```"; embed.Color != colorInfo || embed.Description != want {
+		t.Errorf("import dry run: got %q", embed.Description)
+	}
+	if embed := responseEmbed(t, run("yomiko dict list")); embed.Description != "猫 → ねこ" {
+		t.Errorf("list after dry run: got %q", embed.Description)
+	}
+
+	if embed := responseEmbed(t, run("yomiko dict import", file("dict.csv", 100))); embed.Color != colorSuccess {
+		t.Errorf("import: got %q", embed.Title)
+	}
+	if embed := responseEmbed(t, run("yomiko dict list")); embed.Description != "猫 → にゃんこ\n読子 → よみこ" {
+		t.Errorf("list: got %q", embed.Description)
+	}
+
+	for i, opts := range [][]*discordgo.ApplicationCommandInteractionDataOption{
+		{file("dict.txt", 100)},
+		{file("dict.csv", maxDictFileSize+1)},
+		{file("dict.json", 100)},
+		{file("dict.json", 100), option("format", "toml")},
+	} {
+		if embed := responseEmbed(t, run("yomiko dict import", opts...)); embed.Color != colorWarn {
+			t.Errorf("import %d: got %q", i, embed.Title)
+		}
+	}
+
+	if embed := responseEmbed(t, run("yomiko dict import", file("large.csv", 100))); embed.Title != "ファイルが大きすぎます" {
+		t.Errorf("import large: got %q", embed.Title)
+	}
+
+	res := run("yomiko dict export", option("format", "json"))
+	if len(res.Data.Files) != 1 || res.Data.Files[0].Name != "dict.json" {
+		t.Fatalf("export: got files %v", res.Data.Files)
+	}
+	b, err := io.ReadAll(res.Data.Files[0].Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "[\n  {\n    \"from\": \"猫\",\n    \"to\": \"にゃんこ\"\n  },\n  {\n    \"from\": \"読子\",\n    \"to\": \"よみこ\"\n  }\n]\n"; string(b) != want {
+		t.Errorf("export: got %q", b)
+	}
+
+	run("yomiko mydict add", option("word", "猫"), option("reading", "ぬこ"))
+
+	tests := []struct {
+		userID string
+		want   string
+	}{
+		{
+			userID: "user",
+			want:   `<speak><p><s>a</s></p><p><s><sub alias="ぬこ">猫</sub>と<sub alias="よみこ">読子</sub></s></p></speak>`,
+		},
+		{
+			userID: "other",
+			want:   `<speak><p><s>a</s></p><p><s><sub alias="にゃんこ">猫</sub>と<sub alias="よみこ">読子</sub></s></p></speak>`,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			doc, err := h.bot.makeSSML(context.Background(), nil, &discordgo.Message{
+				GuildID: testGuildID,
+				Author:  &discordgo.User{ID: tt.userID, Username: "a"},
+				Content: "猫と読子",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var b strings.Builder
+			doc.WriteSSML(&b)
+			if got := b.String(); got != tt.want {
+				t.Errorf("Bot.makeSSML(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if embed := responseEmbed(t, run("yomiko dict remove", option("word", "読子"))); embed.Color != colorSuccess {
+		t.Errorf("remove: got %q", embed.Title)
+	}
+	doc, err := h.bot.makeSSML(context.Background(), nil, &discordgo.Message{
+		GuildID: testGuildID,
+		Author:  &discordgo.User{ID: "other", Username: "a"},
+		Content: "読子",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sb strings.Builder
+	doc.WriteSSML(&sb)
+	if got, want := sb.String(), `<speak><p><s>a</s></p><p><s>読子</s></p></speak>`; got != want {
+		t.Errorf("Bot.makeSSML() after remove: got %s, want %s", got, want)
+	}
+}
+
+func TestMyDictListTruncated(t *testing.T) {
+	h := newHarness(t, nil)
+	member := testMember("user", 0)
+
+	for i := range maxUserDictEntries {
+		word := fmt.Sprintf("%03d%s", i, strings.Repeat("あ", maxDictWord-3))
+		res := h.command(testGuildID, testTextChannelID, member, "yomiko mydict add", option("word", word), option("reading", strings.Repeat("い", maxDictReading)))
+		if embed := responseEmbed(t, res); embed.Color != colorSuccess {
+			t.Fatalf("add: got %q", embed.Title)
+		}
+	}
+
+	embed := responseEmbed(t, h.command(testGuildID, testTextChannelID, member, "yomiko mydict list"))
+	if n := utf8.RuneCountInString(embed.Description); n > 4096 {
+		t.Errorf("list: got %d characters", n)
+	}
+	if !strings.HasSuffix(embed.Description, "件") {
+		t.Error("list: got no number of the omitted entries")
+	}
+}
+
+func TestDictImportLongDiff(t *testing.T) {
+	// many words, each of which is duplicated
+	var b strings.Builder
+	for range 2 {
+		for i := range 2000 {
+			fmt.Fprintf(&b, "%05d%s,い\n", i, strings.Repeat("あ", maxDictWord-5))
+		}
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, b.String())
+	}))
+	defer srv.Close()
+
+	h := newHarness(t, nil)
+	member := testMember("user", 0)
+
+	file := h.attachmentOption("file", "dict.csv", srv.URL+"/dict.csv", b.Len())
+	embed := responseEmbed(t, h.command(testGuildID, testTextChannelID, member, "yomiko dict import", file))
+	if embed.Color != colorSuccess {
+		t.Fatalf("import: got %q", embed.Title)
+	}
+	if n := utf8.RuneCountInString(embed.Description); n > 4096 {
+		t.Errorf("import: got %d characters", n)
+	}
+}
+
+func TestCreateRecordingFile(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var got []string
+	for range 3 {
+		file, err := createRecordingFile(dir, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := file.WriteString("recording"); err != nil {
+			t.Fatal(err)
+		}
+		if err := file.Close(); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, filepath.Base(file.Name()))
+	}
+
+	want := []string{
+		"20240102-030405.opus",
+		"20240102-030405-1.opus",
+		"20240102-030405-2.opus",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("createRecordingFile names mismatch (-want +got):\n%s", diff)
+	}
+
+	// the earlier recordings are not truncated
+	for _, name := range want {
+		b, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != "recording" {
+			t.Errorf("%s: got %q", name, b)
+		}
+	}
+}
+
+func TestBGMAutocomplete(t *testing.T) {
+	dir := t.TempDir()
+	for i := range 30 {
+		if err := os.WriteFile(filepath.Join(dir, fmt.Sprintf("track-%02d.wav", i)), nil, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	h := newHarness(t, &Config{
+		BGM: &BGMConfig{Directory: dir},
+	})
+	member := testMember("user", 0)
+
+	// more files than the limit of the choices are listed
+	choices := h.autocomplete(testGuildID, testTextChannelID, member, "yomiko bgm start", option("file", ""))
+	if len(choices) != 25 {
+		t.Errorf("autocomplete: got %d choices, want 25", len(choices))
+	}
+
+	// the files added while running are suggested
+	if err := os.WriteFile(filepath.Join(dir, "Rain.wav"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	choices = h.autocomplete(testGuildID, testTextChannelID, member, "yomiko bgm start", option("file", "rain"))
+	want := []*discordgo.ApplicationCommandOptionChoice{
+		{Name: "Rain", Value: "Rain.wav"},
+	}
+	if diff := cmp.Diff(want, choices); diff != "" {
+		t.Errorf("autocomplete mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestOpusOptionsWithGuildSetting(t *testing.T) {
+	on, off := true, false
+	bitrate := 64000
+
+	tests := []struct {
+		opts opusOptions
+		gs   *ent.GuildSetting
+		want opusOptions
+	}{
+		{
+			opts: opusOptions{fec: true, packetLoss: 20},
+			gs:   nil,
+			want: opusOptions{fec: true, packetLoss: 20},
+		},
+		{
+			opts: opusOptions{},
+			gs:   &ent.GuildSetting{OpusFec: &on},
+			want: opusOptions{fec: true, packetLoss: defaultOpusPacketLoss},
+		},
+		{
+			opts: opusOptions{fec: true, packetLoss: 20},
+			gs:   &ent.GuildSetting{OpusFec: &on},
+			want: opusOptions{fec: true, packetLoss: 20},
+		},
+		{
+			opts: opusOptions{fec: true, packetLoss: 20},
+			gs:   &ent.GuildSetting{OpusFec: &off},
+			want: opusOptions{},
+		},
+		{
+			opts: opusOptions{fec: true, packetLoss: 20},
+			gs:   &ent.GuildSetting{OpusBitrate: &bitrate},
+			want: opusOptions{bitrate: bitrate, fec: true, packetLoss: 20},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			got := tt.opts.withGuildSetting(tt.gs)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(opusOptions{})); diff != "" {
+				t.Errorf("opusOptions.withGuildSetting() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWebhookEdit(t *testing.T) {
+	content := "content"
+	embeds := []*discordgo.MessageEmbed{{Title: "title"}}
+	components := []discordgo.MessageComponent{discordgo.ActionsRow{}}
+	files := []*discordgo.File{{Name: "a.wav"}}
+	mentions := &discordgo.MessageAllowedMentions{}
+
+	tests := []struct {
+		res  *discordgo.InteractionResponse
+		want *discordgo.WebhookEdit
+	}{
+		{
+			res:  &discordgo.InteractionResponse{},
+			want: &discordgo.WebhookEdit{},
+		},
+		{
+			res: &discordgo.InteractionResponse{
+				Data: &discordgo.InteractionResponseData{
+					Content:         content,
+					Embeds:          embeds,
+					Components:      components,
+					Files:           files,
+					AllowedMentions: mentions,
+				},
+			},
+			want: &discordgo.WebhookEdit{
+				Content:         &content,
+				Embeds:          &embeds,
+				Components:      &components,
+				Files:           files,
+				AllowedMentions: mentions,
+			},
+		},
+		{
+			res: &discordgo.InteractionResponse{
+				Data: &discordgo.InteractionResponseData{},
+			},
+			want: &discordgo.WebhookEdit{
+				Content: new(string),
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, webhookEdit(tt.res)); diff != "" {
+				t.Errorf("webhookEdit() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRecording(t *testing.T) {
+	dir := t.TempDir()
+	h := newHarness(t, &Config{
+		Recording: &RecordingConfig{Enabled: true, Directory: dir},
+	})
+	member := testMember("user", 0)
+
+	h.command(testGuildID, testTextChannelID, member, "yomiko join", channelOption("voice-channel", testVoiceChannelID))
+	h.message(testGuildID, testTextChannelID, member.User, "こんにちは")
+	var sent int
+	for _, p := range h.voice(testGuildID).Packets() {
+		sent += len(p)
+	}
+	if sent == 0 {
+		t.Fatal("message is not read")
+	}
+	// the queued packets are written before leaving
+	h.command(testGuildID, testTextChannelID, member, "yomiko leave")
+
+	entries, err := os.ReadDir(filepath.Join(dir, testGuildID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d recordings, want 1", len(entries))
+	}
+	b, err := os.ReadFile(filepath.Join(dir, testGuildID, entries[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the packets and the Ogg pages
+	if len(b) <= sent {
+		t.Errorf("recording has %d bytes, sent %d bytes", len(b), sent)
+	}
+}
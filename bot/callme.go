@@ -0,0 +1,115 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/kechako/yomiko/bot/internal/command"
+	"github.com/kechako/yomiko/ent/spokenname"
+)
+
+const maxSpokenNameLength = 32
+
+func (bot *Bot) callMeCommand() *command.Command {
+	return &command.Command{
+		Name:        "callme",
+		Description: "このサーバーであなたの名前を読み上げる読み方を設定します。",
+		Options: []*command.Option{
+			{
+				Name:        "name",
+				Description: fmt.Sprintf("名前の読み方 (%d文字まで)。省略すると設定を解除します。", maxSpokenNameLength),
+				Type:        discordgo.ApplicationCommandOptionString,
+			},
+		},
+		Handler: bot.handleCallMeCommand,
+	}
+}
+
+func (bot *Bot) handleCallMeCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	name := strings.TrimSpace(req.String("name"))
+	if name == "" {
+		return bot.resetSpokenName(ctx, req)
+	}
+	if utf8.RuneCountInString(name) > maxSpokenNameLength {
+		return createWarnResponse("名前が長すぎます", fmt.Sprintf("名前の読み方は%d文字までです。", maxSpokenNameLength))
+	}
+
+	guildID, userID := req.GuildID(), req.UserID()
+	n, err := bot.ent.SpokenName.Update().
+		Where(
+			spokenname.GuildID(guildID),
+			spokenname.UserID(userID),
+		).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		bot.logger.Error("failed to update spoken name", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	if n == 0 {
+		err = bot.ent.SpokenName.Create().
+			SetGuildID(guildID).
+			SetUserID(userID).
+			SetName(name).
+			Exec(ctx)
+		if err != nil {
+			bot.logger.Error("failed to create spoken name", slog.Any("error", err))
+			return createErrorResponse("エラーが発生しました！", "")
+		}
+	}
+
+	return createSuccessResponse("名前の読み方", fmt.Sprintf("あなたの名前を「%s」と読み上げます。", name))
+}
+
+func (bot *Bot) resetSpokenName(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	n, err := bot.ent.SpokenName.Delete().
+		Where(
+			spokenname.GuildID(req.GuildID()),
+			spokenname.UserID(req.UserID()),
+		).
+		Exec(ctx)
+	if err != nil {
+		bot.logger.Error("failed to delete spoken name", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	if n == 0 {
+		return createInfoResponse("名前の読み方", "名前の読み方は設定されていません。")
+	}
+
+	return createSuccessResponse("名前の読み方", "名前の読み方の設定を解除しました。")
+}
+
+// getSpokenNames returns the spoken names of the users in the guild by the
+// user IDs. The users without the spoken names are not included.
+func (bot *Bot) getSpokenNames(ctx context.Context, guildID string, userIDs ...string) (map[string]string, error) {
+	names, err := bot.ent.SpokenName.Query().
+		Where(
+			spokenname.GuildID(guildID),
+			spokenname.UserIDIn(userIDs...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.getSpokenNames: %w", err)
+	}
+
+	m := make(map[string]string, len(names))
+	for _, n := range names {
+		m[n.UserID] = n.Name
+	}
+
+	return m, nil
+}
+
+// messageUserIDs returns the IDs of the author and the mentioned users of
+// the message.
+func messageUserIDs(msg *discordgo.Message) []string {
+	ids := []string{msg.Author.ID}
+	for _, user := range msg.Mentions {
+		ids = append(ids, user.ID)
+	}
+	return ids
+}
@@ -2,117 +2,210 @@ package bot
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/kechako/yomiko/bot/internal/command"
+	"github.com/kechako/yomiko/ent/accessrule"
 	"github.com/kechako/yomiko/tts"
 )
 
-func (bot *Bot) getApplicationCommands(ctx context.Context) ([]*discordgo.ApplicationCommand, error) {
-	voices, err := bot.tts.ListVoices(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("bot.Bot.getApplicationCommands: %w", err)
-	}
-	voiceChoices := make([]*discordgo.ApplicationCommandOptionChoice, len(voices))
-	for i, voice := range voices {
-		var gender string
-		switch voice.SsmlGender {
-		case tts.GenderMale:
-			gender = "男性"
-		case tts.GenderFemale:
-			gender = "女性"
-		case tts.GenderNeutral:
-			gender = "中性"
-		}
-
-		voiceChoices[i] = &discordgo.ApplicationCommandOptionChoice{
-			Name:  fmt.Sprintf("%s (%s)", voice.Name, gender),
-			Value: voice.Name,
-		}
-	}
-
+func (bot *Bot) newRouter() (*command.Router, error) {
 	var (
 		minSpeed = float64(tts.MinSpeakingRate)
-		maxSpeed = float64(tts.MaxSpeakingRate)
 		minPitch = float64(tts.MinPitch)
-		maxPitch = float64(tts.MaxPitch)
 	)
 
-	return []*discordgo.ApplicationCommand{
-		{
-			Name:        "yomiko",
-			Description: "読子さんに指示を出します。",
-			Type:        discordgo.ChatApplicationCommand,
-			Options: []*discordgo.ApplicationCommandOption{
-				{
-					Name:        "join",
-					Description: "読子さんをボイスチャンネルに入室させます。",
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Options: []*discordgo.ApplicationCommandOption{
-						{
-							Name:         "voice-channel",
-							Description:  "読子さんが入室するボイスチャンネル。",
-							Type:         discordgo.ApplicationCommandOptionChannel,
-							ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildVoice},
-							Required:     true,
-						},
+	yomiko := &command.Command{
+		Name:        "yomiko",
+		Description: "読子さんに指示を出します。",
+		Subcommands: []*command.Command{
+			{
+				Name:        "join",
+				Description: "読子さんをボイスチャンネルに入室させます。",
+				Options: []*command.Option{
+					{
+						Name:         "voice-channel",
+						Description:  "読子さんが入室するボイスチャンネル。",
+						Type:         discordgo.ApplicationCommandOptionChannel,
+						ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildVoice},
+						Required:     true,
 					},
 				},
-				{
-					Name:        "leave",
-					Description: "読子さんをボイスチャンネルから退室させます。",
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-				},
-				{
-					Name:        "voice",
-					Description: "読子さんの声を変更します。",
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Options: []*discordgo.ApplicationCommandOption{
-						{
-							Name:        "voice",
-							Description: "読子さんの声。",
-							Type:        discordgo.ApplicationCommandOptionString,
-							Choices:     voiceChoices,
-							Required:    true,
-						},
+				Handler: bot.withAccess(accessrule.ActionJoin, bot.handleJoinCommand),
+			},
+			{
+				Name:        "leave",
+				Description: "読子さんをボイスチャンネルから退室させます。",
+				Handler:     bot.withAccess(accessrule.ActionLeave, bot.handleLeaveCommand),
+			},
+			{
+				Name:        "skip",
+				Description: "読み上げ中の投稿を読み飛ばします。",
+				Handler:     bot.withAccess(accessrule.ActionSkip, bot.handleSkipCommand),
+			},
+			{
+				Name:        "voice",
+				Description: "読子さんの声を変更します。",
+				Options: []*command.Option{
+					{
+						Name:         "voice",
+						Description:  "読子さんの声。名前や性別、種類で絞り込めます。",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Autocomplete: bot.autocompleteVoice,
+						Required:     true,
 					},
-				},
-				{
-					Name:        "speed",
-					Description: "読子さんの読み上げ速度を変更します。",
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Options: []*discordgo.ApplicationCommandOption{
-						{
-							Name:        "speed",
-							Description: "読子さんの読み上げ速度。",
-							Type:        discordgo.ApplicationCommandOptionNumber,
-							MinValue:    &minSpeed,
-							MaxValue:    maxSpeed,
-							Required:    true,
-						},
+					{
+						Name:        "gender",
+						Description: "候補に表示する声の性別。",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Choices:     genderChoices(),
+					},
+					{
+						Name:        "engine",
+						Description: "候補に表示する声の種類。",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Choices:     familyChoices(),
 					},
 				},
-				{
-					Name:        "pitch",
-					Description: "読子さんの声の音程を変更します。",
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
-					Options: []*discordgo.ApplicationCommandOption{
-						{
-							Name:        "pitch",
-							Description: "読子さんの声の音程。",
-							Type:        discordgo.ApplicationCommandOptionNumber,
-							MinValue:    &minPitch,
-							MaxValue:    maxPitch,
-							Required:    true,
-						},
+				Handler: bot.handleVoiceCommand,
+			},
+			{
+				Name:        "speed",
+				Description: "読子さんの読み上げ速度を変更します。",
+				Options: []*command.Option{
+					{
+						Name:        "speed",
+						Description: "読子さんの読み上げ速度。",
+						Type:        discordgo.ApplicationCommandOptionNumber,
+						MinValue:    &minSpeed,
+						MaxValue:    tts.MaxSpeakingRate,
+						Required:    true,
 					},
 				},
-				{
-					Name:        "reset",
-					Description: "読子さんの声の設定を初期値に設定します。",
-					Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Handler: bot.handleSpeedCommand,
+			},
+			{
+				Name:        "pitch",
+				Description: "読子さんの声の音程を変更します。",
+				Options: []*command.Option{
+					{
+						Name:        "pitch",
+						Description: "読子さんの声の音程。",
+						Type:        discordgo.ApplicationCommandOptionNumber,
+						MinValue:    &minPitch,
+						MaxValue:    tts.MaxPitch,
+						Required:    true,
+					},
 				},
+				Handler: bot.handlePitchCommand,
 			},
+			{
+				Name:        "reset",
+				Description: "読子さんの声の設定を初期値に設定します。",
+				Handler:     bot.handleResetCommand,
+			},
+			bot.previewCommand(),
+			bot.ignoreCommand(),
+			bot.ngWordCommand(),
+			bot.dictCommand(),
+			bot.myDictCommand(),
+			bot.callMeCommand(),
+			bot.opusCommand(),
 		},
-	}, nil
+	}
+	if bot.bgm != nil {
+		yomiko.Subcommands = append(yomiko.Subcommands, bot.bgmCommand())
+	}
+
+	router, err := command.NewRouter(yomiko, bot.accessCommand())
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.newRouter: %w", err)
+	}
+
+	return router, nil
+}
+
+func (bot *Bot) handleJoinCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	ys, err := bot.yomikoJoin(ctx, req.GuildID(), req.ChannelID(), req.String("voice-channel"))
+	if err != nil {
+		if errors.Is(err, errYomikoAlreadyJoined) {
+			return createWarnResponse("入室済です", fmt.Sprintf("読子さんは既に <#%s> に入室しています。\n<#%s> への投稿を読み上げます。", ys.VoiceChannelID(), ys.TextChannelID()))
+		}
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	return createSuccessResponse("ごきげんよう、読子です", fmt.Sprintf("読子さんは <#%s> に入室しました。", ys.VoiceChannelID()))
+}
+
+func (bot *Bot) handleLeaveCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	voiceChannelID, err := bot.yomikoLeave(req.GuildID())
+	if err != nil {
+		if errors.Is(err, errYomikoHasNotJoined) {
+			return createWarnResponse("読子さんは入室していません", "")
+		}
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	return createInfoResponse("みなさま、ごきげんよう", fmt.Sprintf("読子さんは <#%s> から退室しました。", voiceChannelID))
+}
+
+func (bot *Bot) handleSkipCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	ys, ok := bot.getSession(req.GuildID())
+	if !ok {
+		return createWarnResponse("読子さんは入室していません", "")
+	}
+
+	if !ys.Skip() {
+		return createWarnResponse("読み上げ中の投稿はありません", "")
+	}
+
+	return createSuccessResponse("スキップ", "読み上げ中の投稿を読み飛ばしました。")
+}
+
+func (bot *Bot) handleVoiceCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	// any text can be sent without choosing from autocomplete
+	voice, err := bot.voices.Find(ctx, req.String("voice"))
+	if err != nil {
+		bot.logger.Error("failed to list voices", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	if voice == nil {
+		return createWarnResponse("声が見つかりません", fmt.Sprintf("「%s」という声はありません。候補から選んでください。", req.String("voice")))
+	}
+
+	vs, err := bot.updateUserVoiceName(ctx, req.UserID(), voice.GetName())
+	if err != nil {
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	return createSuccessResponse("ボイス設定", fmt.Sprintf("読子さんの声を「%s」に設定しました。", *vs.VoiceName))
+}
+
+func (bot *Bot) handleSpeedCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	vs, err := bot.updateUserSpeakingRate(ctx, req.UserID(), req.Float("speed"))
+	if err != nil {
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	return createSuccessResponse("ボイス設定", fmt.Sprintf("読子さんの読み上げ速度を「%.01f」に設定しました。", *vs.SpeakingRate))
+}
+
+func (bot *Bot) handlePitchCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	vs, err := bot.updateUserVoicePitch(ctx, req.UserID(), req.Float("pitch"))
+	if err != nil {
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	return createSuccessResponse("ボイス設定", fmt.Sprintf("読子さんの声の音程を「%.01f」に設定しました。", *vs.Pitch))
+}
+
+func (bot *Bot) handleResetCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	if _, err := bot.resetUserVoiceSetting(ctx, req.UserID()); err != nil {
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	return createSuccessResponse("ボイス設定", "読子さんの声の設定を初期値に設定しました。")
 }
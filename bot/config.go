@@ -3,11 +3,14 @@ package bot
 import (
 	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/BurntSushi/toml"
+	"github.com/kechako/yomiko/audio/pcm"
 )
 
 type Replacement struct {
@@ -15,12 +18,113 @@ type Replacement struct {
 	To   string `toml:"to"`
 }
 
+// LoudnessConfig configures the loudness normalization of synthesized
+// speech. Zero values mean the defaults.
+type LoudnessConfig struct {
+	Disabled bool `toml:"disabled"`
+	// Target is the integrated loudness in LUFS (default: -18).
+	Target float64 `toml:"target"`
+	// TruePeak is the ceiling of the limiter in dBTP (default: -1).
+	TruePeak float64 `toml:"true_peak"`
+	// MaxGain is the maximum gain in dB (default: 20).
+	MaxGain float64 `toml:"max_gain"`
+}
+
+// SilenceConfig configures the silence around utterances. Zero values mean
+// the defaults.
+type SilenceConfig struct {
+	// DisableTrim disables trimming silence at the edges of utterances.
+	DisableTrim bool `toml:"disable_trim"`
+	// Threshold is the level under which audio is silent in dBFS
+	// (default: -50).
+	Threshold float64 `toml:"threshold"`
+	// PaddingMs is the silence kept at the edges in milliseconds
+	// (default: 50).
+	PaddingMs int `toml:"padding_ms"`
+	// GapMs is the minimum silence between consecutive utterances in
+	// milliseconds (default: 300). A negative value disables the gap.
+	GapMs int `toml:"gap_ms"`
+}
+
+// OpusConfig configures the Opus encoder. Each guild can override the
+// settings by /yomiko opus. Zero values mean the defaults of libopus.
+type OpusConfig struct {
+	// Application is "voip" (default) or "audio". "audio" is suitable for
+	// music-quality voices.
+	Application string `toml:"application"`
+	// Bitrate is the bitrate in bits per second, from 6000 to 510000.
+	Bitrate int `toml:"bitrate"`
+	// Complexity is the computational complexity from 0 to 10.
+	Complexity *int `toml:"complexity"`
+	// FEC enables the inband forward error correction.
+	FEC bool `toml:"fec"`
+	// PacketLoss is the expected packet loss in percent, which FEC is
+	// tuned for (default: 10 if FEC is enabled).
+	PacketLoss int `toml:"packet_loss"`
+	// DTX enables the discontinuous transmission.
+	DTX bool `toml:"dtx"`
+}
+
+// RecordingConfig configures the recording of what yomiko says, to settle
+// moderation disputes. Only the operator of the bot can enable it.
+type RecordingConfig struct {
+	Enabled bool `toml:"enabled"`
+	// Directory is where the recordings are saved in a subdirectory per
+	// guild.
+	Directory string `toml:"directory"`
+	// RotateMinutes is the maximum duration of a file in minutes
+	// (default: 60).
+	RotateMinutes int `toml:"rotate_minutes"`
+	// RetentionDays is the number of days the recordings are kept
+	// (default: 30). A negative value keeps them forever.
+	RetentionDays int `toml:"retention_days"`
+}
+
+const (
+	ttsEngineGoogle = "google"
+	ttsEngineFake   = "fake"
+)
+
+// TTSConfig selects the engine synthesizing speech.
+type TTSConfig struct {
+	// Engine is "google" (default), or "fake" which plays tones instead of
+	// speaking, without network or credentials, for tests and development.
+	Engine string `toml:"engine"`
+}
+
+// CommandsConfig configures where the slash commands are registered.
+type CommandsConfig struct {
+	// GuildIDs are the guilds the commands are registered to instead of
+	// globally. Guild commands are updated immediately, which is useful in
+	// development.
+	GuildIDs []string `toml:"guild_ids"`
+}
+
+// BGMConfig configures the background music played under the speech. The
+// BGM is disabled if no directory is specified.
+type BGMConfig struct {
+	// Directory is where the WAV files of the BGM are placed.
+	Directory string `toml:"directory"`
+	// Volume is the initial volume in percent (default: 20).
+	Volume int `toml:"volume"`
+	// Ducking is the gain in dB applied to the BGM while yomiko is
+	// speaking (default: -12).
+	Ducking float64 `toml:"ducking"`
+}
+
 type Config struct {
-	Token           string         `toml:"token"`
-	CredentialsJSON string         `toml:"credentials_json"`
-	CredentialsFile string         `toml:"credentials_file"`
-	DatabasePath    string         `toml:"database_path"`
-	Replacements    []*Replacement `toml:"replacements"`
+	Token           string           `toml:"token"`
+	CredentialsJSON string           `toml:"credentials_json"`
+	CredentialsFile string           `toml:"credentials_file"`
+	DatabasePath    string           `toml:"database_path"`
+	TTS             *TTSConfig       `toml:"tts"`
+	Replacements    []*Replacement   `toml:"replacements"`
+	Loudness        *LoudnessConfig  `toml:"loudness"`
+	Silence         *SilenceConfig   `toml:"silence"`
+	Opus            *OpusConfig      `toml:"opus"`
+	Recording       *RecordingConfig `toml:"recording"`
+	BGM             *BGMConfig       `toml:"bgm"`
+	Commands        *CommandsConfig  `toml:"commands"`
 }
 
 func ReadConfigFile(name string) (*Config, error) {
@@ -88,3 +192,177 @@ func (cfg *Config) getCredentialsJSON() ([]byte, error) {
 
 	return nil, nil
 }
+
+// loudnessOptions returns the options of the loudness normalization, or nil
+// if it is disabled.
+func (cfg *Config) loudnessOptions() *pcm.LoudnessOptions {
+	opts := pcm.DefaultLoudnessOptions()
+
+	lc := cfg.Loudness
+	if lc == nil {
+		return &opts
+	}
+	if lc.Disabled {
+		return nil
+	}
+
+	if lc.Target != 0 {
+		opts.Target = lc.Target
+	}
+	if lc.TruePeak != 0 {
+		opts.TruePeak = lc.TruePeak
+	}
+	if lc.MaxGain != 0 {
+		opts.MaxGain = lc.MaxGain
+	}
+
+	return &opts
+}
+
+const defaultUtteranceGap = 300 * time.Millisecond
+
+type silenceOptions struct {
+	trim      bool
+	threshold float64
+	padding   time.Duration
+	gap       time.Duration
+}
+
+func (cfg *Config) silenceOptions() silenceOptions {
+	opts := silenceOptions{
+		trim:      true,
+		threshold: pcm.DefaultSilenceThreshold,
+		padding:   pcm.DefaultSilencePadding,
+		gap:       defaultUtteranceGap,
+	}
+
+	sc := cfg.Silence
+	if sc == nil {
+		return opts
+	}
+
+	opts.trim = !sc.DisableTrim
+	if sc.Threshold != 0 {
+		opts.threshold = sc.Threshold
+	}
+	if sc.PaddingMs != 0 {
+		opts.padding = time.Duration(sc.PaddingMs) * time.Millisecond
+	}
+	if sc.GapMs < 0 {
+		opts.gap = 0
+	} else if sc.GapMs > 0 {
+		opts.gap = time.Duration(sc.GapMs) * time.Millisecond
+	}
+
+	return opts
+}
+
+// opusOptions returns the options of the Opus encoder.
+func (cfg *Config) opusOptions() (opusOptions, error) {
+	opts := defaultOpusOptions()
+
+	oc := cfg.Opus
+	if oc == nil {
+		return opts, nil
+	}
+
+	if oc.Application != "" {
+		opts.application = opusApplication(oc.Application)
+	}
+	opts.bitrate = oc.Bitrate
+	if oc.Complexity != nil {
+		opts.complexity = *oc.Complexity
+	}
+	opts.fec = oc.FEC
+	opts.packetLoss = oc.PacketLoss
+	if opts.fec && opts.packetLoss == 0 {
+		opts.packetLoss = defaultOpusPacketLoss
+	}
+	opts.dtx = oc.DTX
+
+	if err := opts.validate(); err != nil {
+		return opusOptions{}, fmt.Errorf("bot.Config.opusOptions: %w", err)
+	}
+
+	return opts, nil
+}
+
+const (
+	defaultRecordingRotation  = time.Hour
+	defaultRecordingRetention = 30 * 24 * time.Hour
+)
+
+// recordingOptions returns the options of the recording, or nil if it is
+// disabled.
+func (cfg *Config) recordingOptions() (*recordingOptions, error) {
+	rc := cfg.Recording
+	if rc == nil || !rc.Enabled {
+		return nil, nil
+	}
+
+	if rc.Directory == "" {
+		return nil, errors.New("bot.Config.recordingOptions: directory is not specified")
+	}
+
+	opts := &recordingOptions{
+		dir:       rc.Directory,
+		rotate:    defaultRecordingRotation,
+		retention: defaultRecordingRetention,
+	}
+	if rc.RotateMinutes > 0 {
+		opts.rotate = time.Duration(rc.RotateMinutes) * time.Minute
+	}
+	if rc.RetentionDays < 0 {
+		opts.retention = 0
+	} else if rc.RetentionDays > 0 {
+		opts.retention = time.Duration(rc.RetentionDays) * 24 * time.Hour
+	}
+
+	return opts, nil
+}
+
+const defaultBGMVolume = 20
+
+// bgmOptions returns the options of the BGM, or nil if it is disabled.
+func (cfg *Config) bgmOptions() (*bgmOptions, error) {
+	bc := cfg.BGM
+	if bc == nil || bc.Directory == "" {
+		return nil, nil
+	}
+
+	opts := &bgmOptions{
+		dir:     bc.Directory,
+		volume:  defaultBGMVolume,
+		ducking: pcm.DefaultDucking,
+	}
+	if bc.Volume < 0 || bc.Volume > maxBGMVolume {
+		return nil, fmt.Errorf("bot.Config.bgmOptions: volume %d is out of range [0, %d]", bc.Volume, maxBGMVolume)
+	}
+	if bc.Volume > 0 {
+		opts.volume = bc.Volume
+	}
+	if bc.Ducking > 0 {
+		return nil, fmt.Errorf("bot.Config.bgmOptions: ducking %g must not be positive", bc.Ducking)
+	}
+	if bc.Ducking < 0 {
+		opts.ducking = bc.Ducking
+	}
+
+	return opts, nil
+}
+
+// commandGuildIDs returns the guilds the commands are registered to. An
+// empty ID means the global commands.
+func (cfg *Config) commandGuildIDs() []string {
+	if cfg.Commands == nil || len(cfg.Commands.GuildIDs) == 0 {
+		return []string{""}
+	}
+	return cfg.Commands.GuildIDs
+}
+
+func (cfg *Config) ttsEngine() string {
+	if cfg.TTS == nil || cfg.TTS.Engine == "" {
+		return ttsEngineGoogle
+	}
+	return cfg.TTS.Engine
+}
@@ -0,0 +1,565 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/kechako/yomiko/bot/internal/command"
+	"github.com/kechako/yomiko/bot/internal/dictfile"
+	"github.com/kechako/yomiko/bot/internal/replacer"
+	"github.com/kechako/yomiko/ent"
+	"github.com/kechako/yomiko/ent/accessrule"
+	"github.com/kechako/yomiko/ent/guilddictentry"
+)
+
+const (
+	maxGuildDictEntries = 10000
+	maxDictFileSize     = 1 << 20
+	// a stalled download does not hold the interaction
+	dictDownloadTimeout = 30 * time.Second
+	// the entries created at once, within the limit of the SQLite variables
+	dictBatchSize = 1000
+
+	// the embed description is up to 4096 characters
+	maxDictListLength = 3000
+	// the duplicates are shown with the diff, within the limit
+	maxDictDuplicatesLength = 500
+	// Discord shows 25 choices at most
+	maxDictChoices = 25
+)
+
+var (
+	errInvalidDictEntry   = errors.New("invalid dictionary entry")
+	errTooManyDictEntries = errors.New("too many dictionary entries")
+	errDictFileTooLarge   = errors.New("dictionary file is too large")
+)
+
+// DictDiff is the difference made by importing a dictionary.
+type DictDiff = dictfile.Diff
+
+// ImportDictionary imports the dictionary in the format, like "csv", into
+// the dictionary of the guild, and returns the difference. The entries not
+// in the dictionary are kept. Nothing is changed if dryRun.
+func ImportDictionary(ctx context.Context, cfg *Config, guildID string, r io.Reader, format string, dryRun bool) (*DictDiff, error) {
+	f, err := dictfile.ParseFormat(format)
+	if err != nil {
+		return nil, fmt.Errorf("bot.ImportDictionary: %w", err)
+	}
+
+	entries, err := dictfile.Read(r, f)
+	if err != nil {
+		return nil, fmt.Errorf("bot.ImportDictionary: %w", err)
+	}
+
+	e, err := openEnt(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("bot.ImportDictionary: %w", err)
+	}
+	defer e.Close()
+
+	diff, err := importGuildDict(ctx, e, guildID, entries, dryRun)
+	if err != nil {
+		return nil, fmt.Errorf("bot.ImportDictionary: %w", err)
+	}
+
+	return diff, nil
+}
+
+// ExportDictionary writes the dictionary of the guild in the format, like
+// "csv".
+func ExportDictionary(ctx context.Context, cfg *Config, guildID string, w io.Writer, format string) error {
+	f, err := dictfile.ParseFormat(format)
+	if err != nil {
+		return fmt.Errorf("bot.ExportDictionary: %w", err)
+	}
+
+	e, err := openEnt(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("bot.ExportDictionary: %w", err)
+	}
+	defer e.Close()
+
+	entries, err := getGuildDictEntries(ctx, e, guildID)
+	if err != nil {
+		return fmt.Errorf("bot.ExportDictionary: %w", err)
+	}
+
+	if err := dictfile.Write(w, f, entries); err != nil {
+		return fmt.Errorf("bot.ExportDictionary: %w", err)
+	}
+
+	return nil
+}
+
+func getGuildDictEntries(ctx context.Context, e *ent.Client, guildID string) ([]dictfile.Entry, error) {
+	rows, err := e.GuildDictEntry.Query().
+		Where(guilddictentry.GuildID(guildID)).
+		Order(ent.Asc(guilddictentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("bot.getGuildDictEntries: %w", err)
+	}
+
+	entries := make([]dictfile.Entry, len(rows))
+	for i, row := range rows {
+		entries[i] = dictfile.Entry{
+			Word:    row.Word,
+			Reading: row.Reading,
+		}
+	}
+
+	return entries, nil
+}
+
+func validateDictEntry(e dictfile.Entry) error {
+	if e.Word == "" || e.Reading == "" ||
+		utf8.RuneCountInString(e.Word) > maxDictWord ||
+		utf8.RuneCountInString(e.Reading) > maxDictReading {
+		return fmt.Errorf("%w: %q", errInvalidDictEntry, e.Word)
+	}
+	return nil
+}
+
+// importGuildDict adds and updates the entries of the dictionary of the
+// guild in a transaction, and returns the difference.
+func importGuildDict(ctx context.Context, e *ent.Client, guildID string, entries []dictfile.Entry, dryRun bool) (*dictfile.Diff, error) {
+	for _, entry := range entries {
+		if err := validateDictEntry(entry); err != nil {
+			return nil, fmt.Errorf("bot.importGuildDict: %w", err)
+		}
+	}
+
+	tx, err := e.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("bot.importGuildDict: %w", err)
+	}
+
+	existing, err := getGuildDictEntries(ctx, tx.Client(), guildID)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("bot.importGuildDict: %w", err))
+	}
+
+	diff := dictfile.Compare(existing, entries)
+	if len(existing)+len(diff.Added) > maxGuildDictEntries {
+		return nil, rollback(tx, fmt.Errorf("bot.importGuildDict: %w: %d", errTooManyDictEntries, len(existing)+len(diff.Added)))
+	}
+	if dryRun || diff.Empty() {
+		if err := tx.Rollback(); err != nil {
+			return nil, fmt.Errorf("bot.importGuildDict: %w", err)
+		}
+		return diff, nil
+	}
+
+	for batch := range slices.Chunk(diff.Added, dictBatchSize) {
+		creates := make([]*ent.GuildDictEntryCreate, len(batch))
+		for i, entry := range batch {
+			creates[i] = tx.GuildDictEntry.Create().
+				SetGuildID(guildID).
+				SetWord(entry.Word).
+				SetReading(entry.Reading)
+		}
+		if err := tx.GuildDictEntry.CreateBulk(creates...).Exec(ctx); err != nil {
+			return nil, rollback(tx, fmt.Errorf("bot.importGuildDict: %w", err))
+		}
+	}
+
+	for _, c := range diff.Changed {
+		err := tx.GuildDictEntry.Update().
+			Where(
+				guilddictentry.GuildID(guildID),
+				guilddictentry.Word(c.Word),
+			).
+			SetReading(c.NewReading).
+			Exec(ctx)
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("bot.importGuildDict: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("bot.importGuildDict: %w", err)
+	}
+
+	return diff, nil
+}
+
+// withDictEntries returns the replacer replacing with the entries before r.
+func withDictEntries(r *replacer.Replacer, entries []dictfile.Entry) *replacer.Replacer {
+	oldnew := make([]string, 0, len(entries)*2)
+	for _, e := range entries {
+		oldnew = append(oldnew, e.Word, e.Reading)
+	}
+
+	return r.With(oldnew...)
+}
+
+// getGuildReplacer returns the replacer of the messages in the guild, which
+// is cached until the dictionary of the guild is changed.
+func (bot *Bot) getGuildReplacer(ctx context.Context, guildID string) (*replacer.Replacer, error) {
+	bot.dictMu.Lock()
+	defer bot.dictMu.Unlock()
+
+	if r, ok := bot.dicts[guildID]; ok {
+		return r, nil
+	}
+
+	entries, err := getGuildDictEntries(ctx, bot.ent, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.getGuildReplacer: %w", err)
+	}
+
+	r := withDictEntries(bot.replacer, entries)
+	bot.dicts[guildID] = r
+
+	return r, nil
+}
+
+func (bot *Bot) invalidateGuildReplacer(guildID string) {
+	bot.dictMu.Lock()
+	defer bot.dictMu.Unlock()
+	delete(bot.dicts, guildID)
+}
+
+func dictFormatChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, len(dictfile.Formats))
+	for i, f := range dictfile.Formats {
+		choices[i] = &discordgo.ApplicationCommandOptionChoice{
+			Name:  strings.ToUpper(string(f)),
+			Value: string(f),
+		}
+	}
+	return choices
+}
+
+func (bot *Bot) dictCommand() *command.Command {
+	return &command.Command{
+		Name:        "dict",
+		Description: "サーバーの読み方の辞書を編集します。",
+		Subcommands: []*command.Command{
+			{
+				Name:        "add",
+				Description: "言葉の読み方を登録します。登録済の言葉は読み方を変更します。",
+				Options: []*command.Option{
+					{
+						Name:        "word",
+						Description: fmt.Sprintf("言葉 (%d文字まで)。", maxDictWord),
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    true,
+					},
+					{
+						Name:        "reading",
+						Description: fmt.Sprintf("読み方 (%d文字まで)。", maxDictReading),
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    true,
+					},
+				},
+				Handler: bot.withAccess(accessrule.ActionDictionary, bot.handleDictAddCommand),
+			},
+			{
+				Name:        "remove",
+				Description: "言葉を辞書から削除します。",
+				Options: []*command.Option{
+					{
+						Name:         "word",
+						Description:  "削除する言葉。",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Autocomplete: bot.autocompleteDictWord,
+						Required:     true,
+					},
+				},
+				Handler: bot.withAccess(accessrule.ActionDictionary, bot.handleDictRemoveCommand),
+			},
+			{
+				Name:        "list",
+				Description: "辞書に登録された言葉を表示します。",
+				Handler:     bot.handleDictListCommand,
+			},
+			{
+				Name:        "import",
+				Description: "CSV、JSON、TOML のファイルから辞書に言葉を登録します。",
+				Options: []*command.Option{
+					{
+						Name:        "file",
+						Description: "辞書のファイル。",
+						Type:        discordgo.ApplicationCommandOptionAttachment,
+						Required:    true,
+					},
+					{
+						Name:        "format",
+						Description: "ファイルの形式。省略するとファイル名の拡張子で判断します。",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Choices:     dictFormatChoices(),
+					},
+					{
+						Name:        "dry-run",
+						Description: "True の場合は登録せずに変更内容だけを表示します。",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+					},
+				},
+				Handler:  bot.withAccess(accessrule.ActionDictionary, bot.handleDictImportCommand),
+				Deferred: true,
+			},
+			{
+				Name:        "export",
+				Description: "辞書をファイルに出力します。",
+				Options: []*command.Option{
+					{
+						Name:        "format",
+						Description: "ファイルの形式。省略すると CSV です。",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Choices:     dictFormatChoices(),
+					},
+				},
+				Handler: bot.handleDictExportCommand,
+			},
+		},
+	}
+}
+
+func (bot *Bot) handleDictAddCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	entry := dictfile.Entry{
+		Word:    strings.TrimSpace(req.String("word")),
+		Reading: strings.TrimSpace(req.String("reading")),
+	}
+
+	diff, err := importGuildDict(ctx, bot.ent, req.GuildID(), []dictfile.Entry{entry}, false)
+	if err != nil {
+		return bot.createDictErrorResponse(err)
+	}
+	if diff.Unchanged > 0 {
+		return createWarnResponse("登録済です", fmt.Sprintf("「%s」は既に「%s」と読むように登録されています。", entry.Word, entry.Reading))
+	}
+	bot.invalidateGuildReplacer(req.GuildID())
+
+	if len(diff.Changed) > 0 {
+		return createSuccessResponse("辞書", fmt.Sprintf("「%s」の読み方を「%s」に変更しました。", entry.Word, entry.Reading))
+	}
+	return createSuccessResponse("辞書", fmt.Sprintf("「%s」を「%s」と読むように登録しました。", entry.Word, entry.Reading))
+}
+
+func (bot *Bot) handleDictRemoveCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	word := strings.TrimSpace(req.String("word"))
+
+	n, err := bot.ent.GuildDictEntry.Delete().
+		Where(
+			guilddictentry.GuildID(req.GuildID()),
+			guilddictentry.Word(word),
+		).
+		Exec(ctx)
+	if err != nil {
+		bot.logger.Error("failed to delete guild dictionary entry", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	if n == 0 {
+		return createWarnResponse("登録されていません", fmt.Sprintf("「%s」は辞書に登録されていません。", word))
+	}
+	bot.invalidateGuildReplacer(req.GuildID())
+
+	return createSuccessResponse("辞書", fmt.Sprintf("「%s」を辞書から削除しました。", word))
+}
+
+func (bot *Bot) handleDictListCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	entries, err := getGuildDictEntries(ctx, bot.ent, req.GuildID())
+	if err != nil {
+		bot.logger.Error("failed to get guild dictionary", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	if len(entries) == 0 {
+		return createInfoResponse("辞書", "辞書に登録された言葉はありません。")
+	}
+
+	lines := make([]string, len(entries))
+	for i, e := range entries {
+		lines[i] = fmt.Sprintf("%s → %s", e.Word, e.Reading)
+	}
+
+	return createInfoResponse("辞書", truncateLines(lines, maxDictListLength))
+}
+
+func (bot *Bot) handleDictImportCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	attachment := req.Attachment("file")
+	if attachment == nil {
+		return createWarnResponse("コマンドの指定が正しくありません", "ファイルを添付してください。")
+	}
+	if attachment.Size > maxDictFileSize {
+		return createWarnResponse("ファイルが大きすぎます", fmt.Sprintf("辞書のファイルは%dMBまでです。", maxDictFileSize>>20))
+	}
+
+	format, err := dictfile.FormatFromName(attachment.Filename)
+	if req.Has("format") {
+		format, err = dictfile.ParseFormat(req.String("format"))
+	}
+	if err != nil {
+		return createWarnResponse("ファイルの形式がわかりません", "CSV、JSON、TOML のファイルを添付するか、形式を指定してください。")
+	}
+
+	b, err := bot.downloadAttachment(ctx, attachment)
+	if errors.Is(err, errDictFileTooLarge) {
+		return createWarnResponse("ファイルが大きすぎます", fmt.Sprintf("辞書のファイルは%dMBまでです。", maxDictFileSize>>20))
+	}
+	if err != nil {
+		bot.logger.Error("failed to download attachment", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	entries, err := dictfile.Read(bytes.NewReader(b), format)
+	if err != nil {
+		return createWarnResponse("ファイルを読み込めません", fmt.Sprintf("%s の形式で読み込めませんでした。", strings.ToUpper(string(format))))
+	}
+
+	dryRun := req.Bool("dry-run")
+	diff, err := importGuildDict(ctx, bot.ent, req.GuildID(), entries, dryRun)
+	if err != nil {
+		return bot.createDictErrorResponse(err)
+	}
+	if !dryRun {
+		bot.invalidateGuildReplacer(req.GuildID())
+	}
+
+	desc := fmt.Sprintf("追加: %d件、変更: %d件、変更なし: %d件", len(diff.Added), len(diff.Changed), diff.Unchanged)
+	if len(diff.Duplicates) > 0 {
+		desc += fmt.Sprintf("\nファイル内の重複: %s (後の読み方を使います)", truncateJoin(diff.Duplicates, "、", maxDictDuplicatesLength))
+	}
+	if !diff.Empty() {
+		lines := strings.Split(strings.TrimSuffix(diff.String(), "\n"), "\n")
+		desc += "\n```diff\n" + truncateLines(lines, maxDictListLength) + "\n```"
+	}
+
+	if dryRun {
+		return createInfoResponse("辞書のインポート (確認のみ)", desc)
+	}
+	return createSuccessResponse("辞書のインポート", desc)
+}
+
+func (bot *Bot) handleDictExportCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	format := dictfile.CSV
+	if req.Has("format") {
+		f, err := dictfile.ParseFormat(req.String("format"))
+		if err != nil {
+			return createWarnResponse("コマンドの指定が正しくありません", "")
+		}
+		format = f
+	}
+
+	entries, err := getGuildDictEntries(ctx, bot.ent, req.GuildID())
+	if err != nil {
+		bot.logger.Error("failed to get guild dictionary", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	var buf bytes.Buffer
+	if err := dictfile.Write(&buf, format, entries); err != nil {
+		bot.logger.Error("failed to write dictionary", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	res := createSuccessResponse("辞書のエクスポート", fmt.Sprintf("%d件の言葉を出力しました。", len(entries)))
+	res.Data.Files = []*discordgo.File{
+		{
+			Name:        "dict." + string(format),
+			ContentType: "text/plain; charset=utf-8",
+			Reader:      &buf,
+		},
+	}
+
+	return res
+}
+
+func (bot *Bot) createDictErrorResponse(err error) *discordgo.InteractionResponse {
+	switch {
+	case errors.Is(err, errInvalidDictEntry):
+		return createWarnResponse("辞書に登録できません", fmt.Sprintf("言葉は%d文字以下、読み方は%d文字以下で指定してください。", maxDictWord, maxDictReading))
+	case errors.Is(err, errTooManyDictEntries):
+		return createWarnResponse("辞書に登録できません", fmt.Sprintf("辞書には%d個まで登録できます。", maxGuildDictEntries))
+	}
+
+	bot.logger.Error("failed to import guild dictionary", slog.Any("error", err))
+	return createErrorResponse("エラーが発生しました！", "")
+}
+
+func (bot *Bot) downloadAttachment(ctx context.Context, attachment *discordgo.MessageAttachment) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, dictDownloadTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, attachment.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.downloadAttachment: %w", err)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.downloadAttachment: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bot.Bot.downloadAttachment: %s", res.Status)
+	}
+
+	// the size of the attachment is not trusted
+	b, err := io.ReadAll(io.LimitReader(res.Body, maxDictFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.downloadAttachment: %w", err)
+	}
+	if len(b) > maxDictFileSize {
+		return nil, fmt.Errorf("bot.Bot.downloadAttachment: %w", errDictFileTooLarge)
+	}
+
+	return b, nil
+}
+
+func (bot *Bot) autocompleteDictWord(ctx context.Context, req *command.Request, value string) []*discordgo.ApplicationCommandOptionChoice {
+	words, err := bot.ent.GuildDictEntry.Query().
+		Where(
+			guilddictentry.GuildID(req.GuildID()),
+			guilddictentry.WordContains(value),
+		).
+		Order(ent.Asc(guilddictentry.FieldID)).
+		Limit(maxDictChoices).
+		Select(guilddictentry.FieldWord).
+		Strings(ctx)
+	if err != nil {
+		bot.logger.Error("failed to get guild dictionary", slog.Any("error", err))
+		return nil
+	}
+
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, len(words))
+	for i, w := range words {
+		choices[i] = &discordgo.ApplicationCommandOptionChoice{
+			Name:  w,
+			Value: w,
+		}
+	}
+
+	return choices
+}
+
+// truncateLines joins the lines up to max characters, with the number of
+// the omitted lines.
+func truncateLines(lines []string, max int) string {
+	return truncateJoin(lines, "\n", max)
+}
+
+// truncateJoin joins the items with sep up to max characters, with the
+// number of the omitted items.
+func truncateJoin(items []string, sep string, max int) string {
+	n := 0
+	for i, item := range items {
+		n += utf8.RuneCountInString(item) + utf8.RuneCountInString(sep)
+		if n > max {
+			return strings.Join(items[:i], sep) + sep + fmt.Sprintf("…ほか%d件", len(items)-i)
+		}
+	}
+	return strings.Join(items, sep)
+}
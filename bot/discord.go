@@ -0,0 +1,77 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// discordSession is the subset of *discordgo.Session used by the bot, so that
+// it can be replaced in tests.
+type discordSession interface {
+	AddHandler(handler any) func()
+	Open() error
+	Close() error
+	UpdateGameStatus(idle int, name string) error
+	ApplicationCommandBulkOverwrite(appID, guildID string, commands []*discordgo.ApplicationCommand, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error)
+	InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error
+	InteractionResponseEdit(interaction *discordgo.Interaction, newresp *discordgo.WebhookEdit, options ...discordgo.RequestOption) (*discordgo.Message, error)
+	ChannelVoiceJoin(guildID, channelID string, mute, deaf bool) (voiceConnection, error)
+	// UserID returns the ID of the bot user.
+	UserID() string
+	// ApplicationID returns the ID of the application, which is also
+	// available before connecting to the gateway.
+	ApplicationID(ctx context.Context) (string, error)
+}
+
+// voiceConnection is the subset of *discordgo.VoiceConnection used by the
+// bot.
+type voiceConnection interface {
+	Speaking(speaking bool) error
+	Disconnect() error
+	// OpusSend returns the channel sending Opus packets.
+	OpusSend() chan<- []byte
+}
+
+type discordgoSession struct {
+	*discordgo.Session
+}
+
+var _ discordSession = discordgoSession{}
+
+func (s discordgoSession) ChannelVoiceJoin(guildID, channelID string, mute, deaf bool) (voiceConnection, error) {
+	conn, err := s.Session.ChannelVoiceJoin(guildID, channelID, mute, deaf)
+	if err != nil {
+		return nil, err
+	}
+	return discordgoVoiceConnection{conn}, nil
+}
+
+func (s discordgoSession) UserID() string {
+	return s.State.User.ID
+}
+
+func (s discordgoSession) ApplicationID(ctx context.Context) (string, error) {
+	if s.State.User != nil {
+		return s.State.User.ID, nil
+	}
+
+	// the ID of a bot user is the ID of its application
+	u, err := s.User("@me", discordgo.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("bot.discordgoSession.ApplicationID: %w", err)
+	}
+
+	return u.ID, nil
+}
+
+type discordgoVoiceConnection struct {
+	*discordgo.VoiceConnection
+}
+
+var _ voiceConnection = discordgoVoiceConnection{}
+
+func (c discordgoVoiceConnection) OpusSend() chan<- []byte {
+	return c.VoiceConnection.OpusSend
+}
@@ -0,0 +1,64 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kechako/yomiko/ent"
+	"github.com/kechako/yomiko/ent/guildsetting"
+)
+
+func (bot *Bot) updateGuildSetting(ctx context.Context, guildID string, f func(m *ent.GuildSettingMutation)) (*ent.GuildSetting, error) {
+	tx, err := bot.ent.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.updateGuildSetting: %w", err)
+	}
+	gs, err := tx.GuildSetting.Query().
+		Where(guildsetting.GuildID(guildID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, rollback(tx, fmt.Errorf("bot.Bot.updateGuildSetting: %w", err))
+	}
+
+	if gs == nil {
+		// create
+		create := tx.GuildSetting.Create().
+			SetGuildID(guildID)
+
+		f(create.Mutation())
+
+		gs, err = create.Save(ctx)
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("bot.Bot.updateGuildSetting: %w", err))
+		}
+	} else {
+		// update
+		update := tx.GuildSetting.UpdateOne(gs)
+		f(update.Mutation())
+
+		gs, err = update.Save(ctx)
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("bot.Bot.updateGuildSetting: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("bot.Bot.updateGuildSetting: %w", err)
+	}
+
+	return gs, nil
+}
+
+func (bot *Bot) getGuildSetting(ctx context.Context, guildID string) (*ent.GuildSetting, error) {
+	gs, err := bot.ent.GuildSetting.Query().
+		Where(guildsetting.GuildID(guildID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("bot.Bot.getGuildSetting: %w", err)
+	}
+
+	return gs, nil
+}
@@ -0,0 +1,471 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/kechako/yomiko/ent/enttest"
+	"github.com/kechako/yomiko/tts"
+)
+
+const (
+	testBotUserID = "bot"
+	// packets buffered in a fake voice connection; sending more blocks
+	fakeOpusBuffer = 1 << 14
+)
+
+// fakeDiscord is a discordSession recording what the bot does, and
+// dispatching synthetic events to the handlers.
+type fakeDiscord struct {
+	mu        sync.Mutex
+	handlers  []any
+	responses map[string]*discordgo.InteractionResponse
+	// IDs of the interactions responded by the edit of the deferred response
+	deferred map[string]bool
+	// commands by guild ID, where "" is the global commands
+	commands map[string][]*discordgo.ApplicationCommand
+	voices   map[string]*fakeVoiceConnection
+	status   string
+}
+
+var _ discordSession = (*fakeDiscord)(nil)
+
+func newFakeDiscord() *fakeDiscord {
+	return &fakeDiscord{
+		responses: make(map[string]*discordgo.InteractionResponse),
+		deferred:  make(map[string]bool),
+		commands:  make(map[string][]*discordgo.ApplicationCommand),
+		voices:    make(map[string]*fakeVoiceConnection),
+	}
+}
+
+func (d *fakeDiscord) AddHandler(handler any) func() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.handlers = append(d.handlers, handler)
+	return func() {}
+}
+
+func (d *fakeDiscord) Open() error {
+	return nil
+}
+
+func (d *fakeDiscord) Close() error {
+	return nil
+}
+
+func (d *fakeDiscord) UpdateGameStatus(idle int, name string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.status = name
+	return nil
+}
+
+func (d *fakeDiscord) ApplicationCommandBulkOverwrite(appID, guildID string, commands []*discordgo.ApplicationCommand, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if commands == nil {
+		return nil, fmt.Errorf("commands must not be null")
+	}
+
+	created := make([]*discordgo.ApplicationCommand, len(commands))
+	for i, cmd := range commands {
+		c := *cmd
+		c.ID = fmt.Sprintf("command-%s-%d", guildID, i)
+		c.ApplicationID = appID
+		c.GuildID = guildID
+		created[i] = &c
+	}
+	if len(created) == 0 {
+		delete(d.commands, guildID)
+	} else {
+		d.commands[guildID] = created
+	}
+
+	return created, nil
+}
+
+func (d *fakeDiscord) InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.responses[interaction.ID] = resp
+	return nil
+}
+
+// InteractionResponseEdit replaces the deferred response with the edit.
+func (d *fakeDiscord) InteractionResponseEdit(interaction *discordgo.Interaction, newresp *discordgo.WebhookEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	res, ok := d.responses[interaction.ID]
+	if !ok || res.Type != discordgo.InteractionResponseDeferredChannelMessageWithSource {
+		return nil, fmt.Errorf("interaction %s is not deferred", interaction.ID)
+	}
+
+	data := &discordgo.InteractionResponseData{
+		Files:           newresp.Files,
+		Attachments:     newresp.Attachments,
+		AllowedMentions: newresp.AllowedMentions,
+	}
+	// the flags of the deferral are kept
+	if res.Data != nil {
+		data.Flags = res.Data.Flags
+	}
+	if newresp.Content != nil {
+		data.Content = *newresp.Content
+	}
+	if newresp.Components != nil {
+		data.Components = *newresp.Components
+	}
+	if newresp.Embeds != nil {
+		data.Embeds = *newresp.Embeds
+	}
+	d.responses[interaction.ID] = &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: data,
+	}
+	d.deferred[interaction.ID] = true
+
+	return &discordgo.Message{ID: interaction.ID}, nil
+}
+
+func (d *fakeDiscord) ChannelVoiceJoin(guildID, channelID string, mute, deaf bool) (voiceConnection, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	conn := &fakeVoiceConnection{
+		channelID: channelID,
+		opus:      make(chan []byte, fakeOpusBuffer),
+	}
+	d.voices[guildID] = conn
+
+	return conn, nil
+}
+
+func (d *fakeDiscord) UserID() string {
+	return testBotUserID
+}
+
+func (d *fakeDiscord) ApplicationID(ctx context.Context) (string, error) {
+	return testBotUserID, nil
+}
+
+// dispatch calls the handlers of the event like discordgo, but
+// synchronously.
+func (d *fakeDiscord) dispatch(event any) {
+	d.mu.Lock()
+	handlers := append([]any(nil), d.handlers...)
+	d.mu.Unlock()
+
+	for _, h := range handlers {
+		switch h := h.(type) {
+		case func(*discordgo.Session, *discordgo.MessageCreate):
+			if e, ok := event.(*discordgo.MessageCreate); ok {
+				h(nil, e)
+			}
+		case func(*discordgo.Session, *discordgo.InteractionCreate):
+			if e, ok := event.(*discordgo.InteractionCreate); ok {
+				h(nil, e)
+			}
+		case func(*discordgo.Session, *discordgo.VoiceStateUpdate):
+			if e, ok := event.(*discordgo.VoiceStateUpdate); ok {
+				h(nil, e)
+			}
+		case func(*discordgo.Session, *discordgo.Ready):
+			if e, ok := event.(*discordgo.Ready); ok {
+				h(nil, e)
+			}
+		case func(*discordgo.Session, *discordgo.GuildCreate):
+			if e, ok := event.(*discordgo.GuildCreate); ok {
+				h(nil, e)
+			}
+		}
+	}
+}
+
+// fakeVoiceConnection buffers the Opus packets sent, instead of sending them
+// every 20 ms.
+type fakeVoiceConnection struct {
+	channelID string
+	opus      chan []byte
+
+	mu           sync.Mutex
+	packets      [][]byte
+	speaking     []bool
+	disconnected bool
+}
+
+var _ voiceConnection = (*fakeVoiceConnection)(nil)
+
+func (c *fakeVoiceConnection) Speaking(speaking bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.speaking = append(c.speaking, speaking)
+	return nil
+}
+
+func (c *fakeVoiceConnection) Disconnect() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.disconnected = true
+	return nil
+}
+
+func (c *fakeVoiceConnection) OpusSend() chan<- []byte {
+	return c.opus
+}
+
+// Packets returns all the packets sent so far.
+func (c *fakeVoiceConnection) Packets() [][]byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for {
+		select {
+		case p := <-c.opus:
+			c.packets = append(c.packets, p)
+		default:
+			return c.packets
+		}
+	}
+}
+
+func (c *fakeVoiceConnection) Disconnected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.disconnected
+}
+
+// harness runs a Bot with the fake Discord and the fake TTS engine, and an
+// in-memory database.
+type harness struct {
+	t       *testing.T
+	bot     *Bot
+	discord *fakeDiscord
+
+	nextID int
+	// attachments of the commands by ID
+	attachments map[string]*discordgo.MessageAttachment
+}
+
+func newHarness(t *testing.T, cfg *Config) *harness {
+	t.Helper()
+
+	if cfg == nil {
+		cfg = &Config{}
+	}
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", strings.ReplaceAll(t.Name(), "/", "_"))
+	e := enttest.Open(t, "sqlite3", dsn)
+
+	discord := newFakeDiscord()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	bot, err := newBot(cfg, discord, tts.NewFake(tts.WithSampleRate(SampleRate)), e, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := bot.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return &harness{
+		t:           t,
+		bot:         bot,
+		discord:     discord,
+		attachments: make(map[string]*discordgo.MessageAttachment),
+	}
+}
+
+func (h *harness) id() string {
+	h.nextID++
+	return fmt.Sprintf("%d", h.nextID)
+}
+
+// command runs the slash command of the path, like "yomiko join" or
+// "yomiko opus set", and returns the response.
+func (h *harness) command(guildID, channelID string, member *discordgo.Member, path string, options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.InteractionResponse {
+	h.t.Helper()
+	return h.interact(discordgo.InteractionApplicationCommand, guildID, channelID, member, path, options)
+}
+
+// autocomplete requests the choices of the focused option while typing the
+// slash command of the path.
+func (h *harness) autocomplete(guildID, channelID string, member *discordgo.Member, path string, focused *discordgo.ApplicationCommandInteractionDataOption, options ...*discordgo.ApplicationCommandInteractionDataOption) []*discordgo.ApplicationCommandOptionChoice {
+	h.t.Helper()
+
+	focused.Focused = true
+	res := h.interact(discordgo.InteractionApplicationCommandAutocomplete, guildID, channelID, member, path, append(options, focused))
+	if res.Type != discordgo.InteractionApplicationCommandAutocompleteResult || res.Data == nil {
+		h.t.Fatalf("not an autocomplete result: %#v", res)
+	}
+
+	return res.Data.Choices
+}
+
+func (h *harness) interact(typ discordgo.InteractionType, guildID, channelID string, member *discordgo.Member, path string, options []*discordgo.ApplicationCommandInteractionDataOption) *discordgo.InteractionResponse {
+	h.t.Helper()
+
+	names := strings.Fields(path)
+	for i := len(names) - 1; i > 0; i-- {
+		typ := discordgo.ApplicationCommandOptionSubCommand
+		if i < len(names)-1 {
+			typ = discordgo.ApplicationCommandOptionSubCommandGroup
+		}
+		options = []*discordgo.ApplicationCommandInteractionDataOption{
+			{
+				Name:    names[i],
+				Type:    typ,
+				Options: options,
+			},
+		}
+	}
+
+	id := h.id()
+	h.discord.dispatch(&discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			ID:        id,
+			Type:      typ,
+			GuildID:   guildID,
+			ChannelID: channelID,
+			Member:    member,
+			Data: discordgo.ApplicationCommandInteractionData{
+				ID:      "command-" + names[0],
+				Name:    names[0],
+				Options: options,
+				Resolved: &discordgo.ApplicationCommandInteractionDataResolved{
+					Attachments: h.attachments,
+				},
+			},
+		},
+	})
+
+	h.discord.mu.Lock()
+	defer h.discord.mu.Unlock()
+	res, ok := h.discord.responses[id]
+	if !ok {
+		h.t.Fatalf("no response to %q", path)
+	}
+
+	return res
+}
+
+// message posts a message and waits until it is read.
+func (h *harness) message(guildID, channelID string, author *discordgo.User, content string) {
+	h.discord.dispatch(&discordgo.MessageCreate{
+		Message: &discordgo.Message{
+			ID:        h.id(),
+			GuildID:   guildID,
+			ChannelID: channelID,
+			Author:    author,
+			Content:   content,
+		},
+	})
+}
+
+func (h *harness) voiceStateUpdate(guildID, userID, channelID string) {
+	h.discord.dispatch(&discordgo.VoiceStateUpdate{
+		VoiceState: &discordgo.VoiceState{
+			GuildID:   guildID,
+			UserID:    userID,
+			ChannelID: channelID,
+		},
+	})
+}
+
+// voice returns the voice connection in the guild, or nil if not joined.
+func (h *harness) voice(guildID string) *fakeVoiceConnection {
+	h.discord.mu.Lock()
+	defer h.discord.mu.Unlock()
+	return h.discord.voices[guildID]
+}
+
+func testMember(userID string, permissions int64) *discordgo.Member {
+	return &discordgo.Member{
+		User:        &discordgo.User{ID: userID, Username: userID},
+		Permissions: permissions,
+	}
+}
+
+func option(name string, value any) *discordgo.ApplicationCommandInteractionDataOption {
+	opt := &discordgo.ApplicationCommandInteractionDataOption{
+		Name:  name,
+		Value: value,
+	}
+
+	switch v := value.(type) {
+	case string:
+		opt.Type = discordgo.ApplicationCommandOptionString
+	case bool:
+		opt.Type = discordgo.ApplicationCommandOptionBoolean
+	case int:
+		// numbers are decoded from JSON as float64
+		opt.Type = discordgo.ApplicationCommandOptionInteger
+		opt.Value = float64(v)
+	case float64:
+		opt.Type = discordgo.ApplicationCommandOptionNumber
+	}
+
+	return opt
+}
+
+func channelOption(name, channelID string) *discordgo.ApplicationCommandInteractionDataOption {
+	return &discordgo.ApplicationCommandInteractionDataOption{
+		Name:  name,
+		Type:  discordgo.ApplicationCommandOptionChannel,
+		Value: channelID,
+	}
+}
+
+// attachmentOption returns the option of the file, which is downloaded from
+// url.
+func (h *harness) attachmentOption(name, filename, url string, size int) *discordgo.ApplicationCommandInteractionDataOption {
+	id := h.id()
+	h.attachments[id] = &discordgo.MessageAttachment{
+		ID:       id,
+		URL:      url,
+		Filename: filename,
+		Size:     size,
+	}
+
+	return &discordgo.ApplicationCommandInteractionDataOption{
+		Name:  name,
+		Type:  discordgo.ApplicationCommandOptionAttachment,
+		Value: id,
+	}
+}
+
+func userOption(name, userID string) *discordgo.ApplicationCommandInteractionDataOption {
+	return &discordgo.ApplicationCommandInteractionDataOption{
+		Name:  name,
+		Type:  discordgo.ApplicationCommandOptionUser,
+		Value: userID,
+	}
+}
+
+func roleOption(name, roleID string) *discordgo.ApplicationCommandInteractionDataOption {
+	return &discordgo.ApplicationCommandInteractionDataOption{
+		Name:  name,
+		Type:  discordgo.ApplicationCommandOptionRole,
+		Value: roleID,
+	}
+}
+
+func responseEmbed(t *testing.T, res *discordgo.InteractionResponse) *discordgo.MessageEmbed {
+	t.Helper()
+
+	if res == nil || res.Data == nil || len(res.Data.Embeds) == 0 {
+		t.Fatalf("response has no embeds: %#v", res)
+	}
+
+	return res.Data.Embeds[0]
+}
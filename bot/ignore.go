@@ -0,0 +1,249 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/kechako/yomiko/bot/internal/command"
+	"github.com/kechako/yomiko/ent"
+	"github.com/kechako/yomiko/ent/accessrule"
+	"github.com/kechako/yomiko/ent/ignoreentry"
+)
+
+func (bot *Bot) ignoreCommand() *command.Command {
+	targetOptions := []*command.Option{
+		{
+			Name:        "user",
+			Description: "対象のユーザー。",
+			Type:        discordgo.ApplicationCommandOptionUser,
+		},
+		{
+			Name:        "role",
+			Description: "対象のロール。",
+			Type:        discordgo.ApplicationCommandOptionRole,
+		},
+	}
+	readOptions := []*command.Option{
+		{
+			Name:        "read",
+			Description: "読み上げる場合は True。",
+			Type:        discordgo.ApplicationCommandOptionBoolean,
+			Required:    true,
+		},
+	}
+
+	return &command.Command{
+		Name:        "ignore",
+		Description: "読み上げないユーザーやロールを設定します。",
+		Subcommands: []*command.Command{
+			{
+				Name:        "add",
+				Description: "ユーザーかロールの投稿を読み上げないようにします。",
+				Options:     targetOptions,
+				Handler:     bot.withAccess(accessrule.ActionSettings, bot.handleIgnoreAddCommand),
+			},
+			{
+				Name:        "remove",
+				Description: "ユーザーかロールの投稿を再び読み上げるようにします。",
+				Options:     targetOptions,
+				Handler:     bot.withAccess(accessrule.ActionSettings, bot.handleIgnoreRemoveCommand),
+			},
+			{
+				Name:        "list",
+				Description: "読み上げないユーザーとロールを表示します。",
+				Handler:     bot.handleIgnoreListCommand,
+			},
+			{
+				Name:        "bots",
+				Description: "他のボットの投稿を読み上げるかを設定します。",
+				Options:     readOptions,
+				Handler:     bot.withAccess(accessrule.ActionSettings, bot.handleIgnoreBotsCommand),
+			},
+			{
+				Name:        "webhooks",
+				Description: "Webhook の投稿を読み上げるかを設定します。",
+				Options:     readOptions,
+				Handler:     bot.withAccess(accessrule.ActionSettings, bot.handleIgnoreWebhooksCommand),
+			},
+		},
+	}
+}
+
+// ignoreTarget returns the user or the role of the request, and its
+// mention.
+func ignoreTarget(req *command.Request) (ignoreentry.Kind, string, string, bool) {
+	user, role := req.String("user"), req.String("role")
+	switch {
+	case user != "" && role == "":
+		return ignoreentry.KindUser, user, "<@" + user + ">", true
+	case role != "" && user == "":
+		return ignoreentry.KindRole, role, "<@&" + role + ">", true
+	}
+	return "", "", "", false
+}
+
+func (bot *Bot) handleIgnoreAddCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	kind, targetID, mention, ok := ignoreTarget(req)
+	if !ok {
+		return createWarnResponse("コマンドの指定が正しくありません", "ユーザーかロールのどちらかを指定してください。")
+	}
+
+	err := bot.ent.IgnoreEntry.Create().
+		SetGuildID(req.GuildID()).
+		SetKind(kind).
+		SetTargetID(targetID).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return createWarnResponse("設定済です", fmt.Sprintf("%s の投稿は既に読み上げません。", mention))
+		}
+		bot.logger.Error("failed to create ignore entry", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	return createSuccessResponse("読み上げ対象の設定", fmt.Sprintf("%s の投稿を読み上げないようにしました。", mention))
+}
+
+func (bot *Bot) handleIgnoreRemoveCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	kind, targetID, mention, ok := ignoreTarget(req)
+	if !ok {
+		return createWarnResponse("コマンドの指定が正しくありません", "ユーザーかロールのどちらかを指定してください。")
+	}
+
+	n, err := bot.ent.IgnoreEntry.Delete().
+		Where(
+			ignoreentry.GuildID(req.GuildID()),
+			ignoreentry.KindEQ(kind),
+			ignoreentry.TargetID(targetID),
+		).
+		Exec(ctx)
+	if err != nil {
+		bot.logger.Error("failed to delete ignore entry", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	if n == 0 {
+		return createWarnResponse("設定されていません", fmt.Sprintf("%s の投稿は読み上げる設定です。", mention))
+	}
+
+	return createSuccessResponse("読み上げ対象の設定", fmt.Sprintf("%s の投稿を再び読み上げるようにしました。", mention))
+}
+
+func (bot *Bot) handleIgnoreListCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	entries, err := bot.ent.IgnoreEntry.Query().
+		Where(ignoreentry.GuildID(req.GuildID())).
+		Order(ent.Asc(ignoreentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		bot.logger.Error("failed to get ignore entries", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	gs, err := bot.getGuildSetting(ctx, req.GuildID())
+	if err != nil {
+		bot.logger.Error("failed to get guild setting", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	var users, roles []string
+	for _, e := range entries {
+		switch e.Kind {
+		case ignoreentry.KindUser:
+			users = append(users, "<@"+e.TargetID+">")
+		case ignoreentry.KindRole:
+			roles = append(roles, "<@&"+e.TargetID+">")
+		}
+	}
+
+	lines := []string{
+		"ユーザー: " + joinOrNone(users),
+		"ロール: " + joinOrNone(roles),
+		"他のボット: " + readOrIgnore(gs == nil || gs.ReadBots == nil || *gs.ReadBots),
+		"Webhook: " + readOrIgnore(gs == nil || gs.ReadWebhooks == nil || *gs.ReadWebhooks),
+	}
+
+	return createInfoResponse("読み上げ対象の設定", strings.Join(lines, "\n"))
+}
+
+func joinOrNone(s []string) string {
+	if len(s) == 0 {
+		return "なし"
+	}
+	return strings.Join(s, " ")
+}
+
+func readOrIgnore(read bool) string {
+	if read {
+		return "読み上げる"
+	}
+	return "読み上げない"
+}
+
+func (bot *Bot) handleIgnoreBotsCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	read := req.Bool("read")
+	_, err := bot.updateGuildSetting(ctx, req.GuildID(), func(m *ent.GuildSettingMutation) {
+		m.SetReadBots(read)
+	})
+	if err != nil {
+		bot.logger.Error("failed to update guild setting", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	return createSuccessResponse("読み上げ対象の設定", fmt.Sprintf("他のボットの投稿を%s設定にしました。", readOrIgnore(read)))
+}
+
+func (bot *Bot) handleIgnoreWebhooksCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	read := req.Bool("read")
+	_, err := bot.updateGuildSetting(ctx, req.GuildID(), func(m *ent.GuildSettingMutation) {
+		m.SetReadWebhooks(read)
+	})
+	if err != nil {
+		bot.logger.Error("failed to update guild setting", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	return createSuccessResponse("読み上げ対象の設定", fmt.Sprintf("Webhook の投稿を%s設定にしました。", readOrIgnore(read)))
+}
+
+// isIgnoredMessage reports whether the message is not read by the settings
+// of the guild.
+func (bot *Bot) isIgnoredMessage(ctx context.Context, msg *discordgo.Message) (bool, error) {
+	gs, err := bot.getGuildSetting(ctx, msg.GuildID)
+	if err != nil {
+		return false, fmt.Errorf("bot.Bot.isIgnoredMessage: %w", err)
+	}
+	if gs != nil {
+		// the author of a webhook message is a bot user
+		if msg.WebhookID != "" && gs.ReadWebhooks != nil && !*gs.ReadWebhooks {
+			return true, nil
+		}
+		if msg.WebhookID == "" && msg.Author.Bot && gs.ReadBots != nil && !*gs.ReadBots {
+			return true, nil
+		}
+	}
+
+	pred := ignoreentry.And(
+		ignoreentry.KindEQ(ignoreentry.KindUser),
+		ignoreentry.TargetID(msg.Author.ID),
+	)
+	if msg.Member != nil && len(msg.Member.Roles) > 0 {
+		pred = ignoreentry.Or(pred, ignoreentry.And(
+			ignoreentry.KindEQ(ignoreentry.KindRole),
+			ignoreentry.TargetIDIn(msg.Member.Roles...),
+		))
+	}
+
+	ignored, err := bot.ent.IgnoreEntry.Query().
+		Where(
+			ignoreentry.GuildID(msg.GuildID),
+			pred,
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("bot.Bot.isIgnoredMessage: %w", err)
+	}
+
+	return ignored, nil
+}
@@ -0,0 +1,540 @@
+// Package command routes slash commands to their handlers. Commands are
+// defined declaratively, and the definitions generate both the application
+// commands registered to Discord and the parsing and validation of the
+// interactions.
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+var (
+	ErrUnknownCommand   = errors.New("unknown command")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrInvalidOption    = errors.New("invalid option")
+)
+
+// Handler handles a command and returns the response.
+type Handler func(ctx context.Context, req *Request) *discordgo.InteractionResponse
+
+// AutocompleteHandler returns the choices for the value being typed. The
+// options of the request are not validated.
+type AutocompleteHandler func(ctx context.Context, req *Request, value string) []*discordgo.ApplicationCommandOptionChoice
+
+// maximum number of the choices of autocomplete
+const maxChoices = 25
+
+// Command is a command, a subcommand group or a subcommand. A command has
+// either Subcommands or a Handler.
+type Command struct {
+	Name        string
+	Description string
+	// Permissions are required for the member to run the command and its
+	// subcommands. Administrator has all the permissions. The permissions
+	// of a top-level command are also registered as the default member
+	// permissions, so that Discord hides the command from other members.
+	Permissions int64
+	Subcommands []*Command
+	Options     []*Option
+	Handler     Handler
+	// Deferred is set if the handler can take longer than Discord waits
+	// for the response. The response is deferred before the handler runs,
+	// and the response of the handler replaces the deferred one.
+	Deferred bool
+	// Ephemeral makes the deferred response visible only to the member.
+	// The flags of the deferred response cannot be changed by the edit.
+	Ephemeral bool
+}
+
+type Option struct {
+	Name        string
+	Description string
+	Type        discordgo.ApplicationCommandOptionType
+	Required    bool
+	Choices     []*discordgo.ApplicationCommandOptionChoice
+	// LoadChoices returns the choices when the commands are registered.
+	LoadChoices func(ctx context.Context) ([]*discordgo.ApplicationCommandOptionChoice, error)
+	// Autocomplete suggests the choices while the value is typed, instead
+	// of the static choices.
+	Autocomplete AutocompleteHandler
+	MinValue     *float64
+	MaxValue     float64
+	ChannelTypes []discordgo.ChannelType
+}
+
+// PermissionError is the error returned when the member does not have the
+// permissions to run a command.
+type PermissionError struct {
+	Path        string
+	Permissions int64
+}
+
+func (e *PermissionError) Error() string {
+	return fmt.Sprintf("%s: %q requires permissions %#x", ErrPermissionDenied, e.Path, e.Permissions)
+}
+
+func (e *PermissionError) Is(target error) bool {
+	return target == ErrPermissionDenied
+}
+
+type Router struct {
+	commands []*Command
+}
+
+// NewRouter returns a Router of the top-level commands.
+func NewRouter(commands ...*Command) (*Router, error) {
+	for _, cmd := range commands {
+		if err := validate(cmd, 0); err != nil {
+			return nil, fmt.Errorf("command.NewRouter: %w", err)
+		}
+	}
+	if err := checkDuplicates(commands); err != nil {
+		return nil, fmt.Errorf("command.NewRouter: %w", err)
+	}
+
+	return &Router{
+		commands: commands,
+	}, nil
+}
+
+func validate(cmd *Command, depth int) error {
+	if cmd.Name == "" {
+		return errors.New("command has no name")
+	}
+	if (cmd.Handler == nil) == (len(cmd.Subcommands) == 0) {
+		return fmt.Errorf("command %q must have either subcommands or a handler", cmd.Name)
+	}
+	// a command, a subcommand group and a subcommand at most
+	if len(cmd.Subcommands) > 0 && depth >= 2 {
+		return fmt.Errorf("command %q is nested too deeply", cmd.Name)
+	}
+	if len(cmd.Subcommands) > 0 && len(cmd.Options) > 0 {
+		return fmt.Errorf("command %q has both subcommands and options", cmd.Name)
+	}
+
+	for _, sub := range cmd.Subcommands {
+		if err := validate(sub, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return checkDuplicates(cmd.Subcommands)
+}
+
+func checkDuplicates(commands []*Command) error {
+	names := make(map[string]bool, len(commands))
+	for _, cmd := range commands {
+		if names[cmd.Name] {
+			return fmt.Errorf("duplicate command %q", cmd.Name)
+		}
+		names[cmd.Name] = true
+	}
+	return nil
+}
+
+// ApplicationCommands returns the application commands to be registered.
+func (r *Router) ApplicationCommands(ctx context.Context) ([]*discordgo.ApplicationCommand, error) {
+	commands := make([]*discordgo.ApplicationCommand, 0, len(r.commands))
+	for _, cmd := range r.commands {
+		options, err := applicationCommandOptions(ctx, cmd, 0)
+		if err != nil {
+			return nil, fmt.Errorf("command.Router.ApplicationCommands: %w", err)
+		}
+
+		var permissions *int64
+		if cmd.Permissions != 0 {
+			permissions = &cmd.Permissions
+		}
+
+		commands = append(commands, &discordgo.ApplicationCommand{
+			Name:                     cmd.Name,
+			Description:              cmd.Description,
+			Type:                     discordgo.ChatApplicationCommand,
+			DefaultMemberPermissions: permissions,
+			Options:                  options,
+		})
+	}
+
+	return commands, nil
+}
+
+func applicationCommandOptions(ctx context.Context, cmd *Command, depth int) ([]*discordgo.ApplicationCommandOption, error) {
+	var options []*discordgo.ApplicationCommandOption
+
+	for _, sub := range cmd.Subcommands {
+		typ := discordgo.ApplicationCommandOptionSubCommand
+		if len(sub.Subcommands) > 0 {
+			typ = discordgo.ApplicationCommandOptionSubCommandGroup
+		}
+
+		subOptions, err := applicationCommandOptions(ctx, sub, depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, &discordgo.ApplicationCommandOption{
+			Name:        sub.Name,
+			Description: sub.Description,
+			Type:        typ,
+			Options:     subOptions,
+		})
+	}
+
+	for _, opt := range cmd.Options {
+		choices := opt.Choices
+		if opt.LoadChoices != nil {
+			loaded, err := opt.LoadChoices(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("choices of %q: %w", opt.Name, err)
+			}
+			choices = append(choices[:len(choices):len(choices)], loaded...)
+		}
+
+		options = append(options, &discordgo.ApplicationCommandOption{
+			Name:         opt.Name,
+			Description:  opt.Description,
+			Type:         opt.Type,
+			Required:     opt.Required,
+			Choices:      choices,
+			Autocomplete: opt.Autocomplete != nil,
+			MinValue:     opt.MinValue,
+			MaxValue:     opt.MaxValue,
+			ChannelTypes: opt.ChannelTypes,
+		})
+	}
+
+	return options, nil
+}
+
+// Handle runs the handler of the command of the interaction, after checking
+// the permissions and the options. Autocomplete interactions are handled by
+// the autocomplete handler of the focused option.
+func (r *Router) Handle(ctx context.Context, event *discordgo.InteractionCreate) (*discordgo.InteractionResponse, error) {
+	switch event.Type {
+	case discordgo.InteractionApplicationCommand:
+	case discordgo.InteractionApplicationCommandAutocomplete:
+		res, err := r.autocomplete(ctx, event)
+		if err != nil {
+			return nil, fmt.Errorf("command.Router.Handle: %w", err)
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("command.Router.Handle: %w: interaction type %v", ErrUnknownCommand, event.Type)
+	}
+
+	cmd, path, options, err := r.resolve(event)
+	if err != nil {
+		return nil, fmt.Errorf("command.Router.Handle: %w", err)
+	}
+
+	req, err := newRequest(event, path, cmd.Options, options)
+	if err != nil {
+		return nil, fmt.Errorf("command.Router.Handle: %w", err)
+	}
+
+	return cmd.Handler(ctx, req), nil
+}
+
+// Deferral returns the response deferring the interaction before it is
+// handled, or nil if the command responds at once. It is nil if the command
+// is not found or not permitted, since the error is responded at once.
+func (r *Router) Deferral(event *discordgo.InteractionCreate) *discordgo.InteractionResponse {
+	if event.Type != discordgo.InteractionApplicationCommand {
+		return nil
+	}
+
+	cmd, _, _, err := r.resolve(event)
+	if err != nil || !cmd.Deferred {
+		return nil
+	}
+
+	res := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	}
+	if cmd.Ephemeral {
+		res.Data = &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		}
+	}
+
+	return res
+}
+
+func (r *Router) autocomplete(ctx context.Context, event *discordgo.InteractionCreate) (*discordgo.InteractionResponse, error) {
+	cmd, path, options, err := r.resolve(event)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &Request{
+		Event:   event,
+		Path:    path,
+		options: make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options)),
+	}
+
+	var focused *discordgo.ApplicationCommandInteractionDataOption
+	for _, opt := range options {
+		req.options[opt.Name] = opt
+		if opt.Focused {
+			focused = opt
+		}
+	}
+	if focused == nil {
+		return nil, fmt.Errorf("%w: no focused option", ErrInvalidOption)
+	}
+
+	var def *Option
+	for _, d := range cmd.Options {
+		if d.Name == focused.Name {
+			def = d
+			break
+		}
+	}
+	if def == nil || def.Autocomplete == nil {
+		return nil, fmt.Errorf("%w: option %q has no autocomplete", ErrInvalidOption, focused.Name)
+	}
+
+	// the value being typed is a string even for numbers
+	value := fmt.Sprint(focused.Value)
+	choices := def.Autocomplete(ctx, req, value)
+	if len(choices) > maxChoices {
+		choices = choices[:maxChoices]
+	}
+	if choices == nil {
+		// Discord rejects null choices
+		choices = []*discordgo.ApplicationCommandOptionChoice{}
+	}
+
+	return &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{
+			Choices: choices,
+		},
+	}, nil
+}
+
+// resolve returns the command of the interaction, its path and its options,
+// after checking the permissions.
+func (r *Router) resolve(event *discordgo.InteractionCreate) (*Command, []string, []*discordgo.ApplicationCommandInteractionDataOption, error) {
+	data := event.ApplicationCommandData()
+	cmd := find(r.commands, data.Name)
+	if cmd == nil {
+		return nil, nil, nil, fmt.Errorf("%w: %q", ErrUnknownCommand, data.Name)
+	}
+
+	path := []string{cmd.Name}
+	options := data.Options
+	for {
+		if !hasPermissions(event.Member, cmd.Permissions) {
+			return nil, nil, nil, &PermissionError{
+				Path:        strings.Join(path, " "),
+				Permissions: cmd.Permissions,
+			}
+		}
+
+		if cmd.Handler != nil {
+			break
+		}
+
+		if len(options) != 1 || !isSubcommand(options[0].Type) {
+			return nil, nil, nil, fmt.Errorf("%w: %q has no subcommand", ErrUnknownCommand, strings.Join(path, " "))
+		}
+		sub := find(cmd.Subcommands, options[0].Name)
+		if sub == nil {
+			return nil, nil, nil, fmt.Errorf("%w: %q", ErrUnknownCommand, strings.Join(append(path, options[0].Name), " "))
+		}
+
+		cmd = sub
+		path = append(path, cmd.Name)
+		options = options[0].Options
+	}
+
+	return cmd, path, options, nil
+}
+
+func find(commands []*Command, name string) *Command {
+	for _, cmd := range commands {
+		if cmd.Name == name {
+			return cmd
+		}
+	}
+	return nil
+}
+
+func isSubcommand(t discordgo.ApplicationCommandOptionType) bool {
+	return t == discordgo.ApplicationCommandOptionSubCommand || t == discordgo.ApplicationCommandOptionSubCommandGroup
+}
+
+func hasPermissions(member *discordgo.Member, permissions int64) bool {
+	if permissions == 0 {
+		return true
+	}
+	if member == nil {
+		return false
+	}
+	if member.Permissions&discordgo.PermissionAdministrator != 0 {
+		return true
+	}
+	return member.Permissions&permissions == permissions
+}
+
+// Request is a command to be handled, with the options checked against the
+// definition.
+type Request struct {
+	Event *discordgo.InteractionCreate
+	// Path is the names of the command and the subcommands.
+	Path []string
+
+	options map[string]*discordgo.ApplicationCommandInteractionDataOption
+}
+
+func newRequest(event *discordgo.InteractionCreate, path []string, defs []*Option, options []*discordgo.ApplicationCommandInteractionDataOption) (*Request, error) {
+	req := &Request{
+		Event:   event,
+		Path:    path,
+		options: make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options)),
+	}
+
+	for _, opt := range options {
+		var def *Option
+		for _, d := range defs {
+			if d.Name == opt.Name {
+				def = d
+				break
+			}
+		}
+		if def == nil {
+			return nil, fmt.Errorf("%w: unknown option %q", ErrInvalidOption, opt.Name)
+		}
+		if err := checkValue(def, opt); err != nil {
+			return nil, err
+		}
+		req.options[opt.Name] = opt
+	}
+
+	for _, def := range defs {
+		if _, ok := req.options[def.Name]; def.Required && !ok {
+			return nil, fmt.Errorf("%w: option %q is required", ErrInvalidOption, def.Name)
+		}
+	}
+
+	return req, nil
+}
+
+func checkValue(def *Option, opt *discordgo.ApplicationCommandInteractionDataOption) error {
+	if opt.Type != def.Type {
+		return fmt.Errorf("%w: option %q is %v, want %v", ErrInvalidOption, opt.Name, opt.Type, def.Type)
+	}
+
+	var ok bool
+	switch def.Type {
+	case discordgo.ApplicationCommandOptionString,
+		discordgo.ApplicationCommandOptionUser,
+		discordgo.ApplicationCommandOptionChannel,
+		discordgo.ApplicationCommandOptionRole,
+		discordgo.ApplicationCommandOptionMentionable,
+		discordgo.ApplicationCommandOptionAttachment:
+		_, ok = opt.Value.(string)
+	case discordgo.ApplicationCommandOptionBoolean:
+		_, ok = opt.Value.(bool)
+	case discordgo.ApplicationCommandOptionInteger, discordgo.ApplicationCommandOptionNumber:
+		// numbers are decoded from JSON as float64
+		var v float64
+		v, ok = opt.Value.(float64)
+		if ok && def.Type == discordgo.ApplicationCommandOptionInteger && v != math.Trunc(v) {
+			ok = false
+		}
+		if ok && (def.MinValue != nil && v < *def.MinValue || def.MaxValue != 0 && v > def.MaxValue) {
+			return fmt.Errorf("%w: option %q is out of range", ErrInvalidOption, opt.Name)
+		}
+	}
+	if !ok {
+		return fmt.Errorf("%w: option %q has a value of %T", ErrInvalidOption, opt.Name, opt.Value)
+	}
+
+	return nil
+}
+
+func (r *Request) GuildID() string {
+	return r.Event.GuildID
+}
+
+func (r *Request) ChannelID() string {
+	return r.Event.ChannelID
+}
+
+func (r *Request) Member() *discordgo.Member {
+	return r.Event.Member
+}
+
+// UserID returns the ID of the user running the command, in a guild or not.
+func (r *Request) UserID() string {
+	if r.Event.Member != nil && r.Event.Member.User != nil {
+		return r.Event.Member.User.ID
+	}
+	if r.Event.User != nil {
+		return r.Event.User.ID
+	}
+	return ""
+}
+
+// Has reports whether the option is specified.
+func (r *Request) Has(name string) bool {
+	_, ok := r.options[name]
+	return ok
+}
+
+// String returns the value of a string option, or the ID of a user,
+// channel, role, mentionable or attachment option. It returns "" if the
+// option is not specified.
+func (r *Request) String(name string) string {
+	v, _ := r.value(name).(string)
+	return v
+}
+
+// Attachment returns the attachment of an attachment option, or nil if not
+// specified.
+func (r *Request) Attachment(name string) *discordgo.MessageAttachment {
+	id := r.String(name)
+	if id == "" {
+		return nil
+	}
+
+	resolved := r.Event.ApplicationCommandData().Resolved
+	if resolved == nil {
+		return nil
+	}
+	return resolved.Attachments[id]
+}
+
+// Int returns the value of an integer option, or 0 if not specified.
+func (r *Request) Int(name string) int64 {
+	v, _ := r.value(name).(float64)
+	return int64(v)
+}
+
+// Float returns the value of a number option, or 0 if not specified.
+func (r *Request) Float(name string) float64 {
+	v, _ := r.value(name).(float64)
+	return v
+}
+
+// Bool returns the value of a boolean option, or false if not specified.
+func (r *Request) Bool(name string) bool {
+	v, _ := r.value(name).(bool)
+	return v
+}
+
+func (r *Request) value(name string) any {
+	opt, ok := r.options[name]
+	if !ok {
+		return nil
+	}
+	return opt.Value
+}
@@ -0,0 +1,447 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newTestRouter(t *testing.T) *Router {
+	t.Helper()
+
+	minValue := float64(0)
+	handler := func(ctx context.Context, req *Request) *discordgo.InteractionResponse {
+		return &discordgo.InteractionResponse{
+			Data: &discordgo.InteractionResponseData{
+				Content: fmt.Sprintf("%s %q %d %v %v", strings.Join(req.Path, " "), req.String("name"), req.Int("count"), req.Bool("flag"), req.Has("count")),
+			},
+		}
+	}
+
+	r, err := NewRouter(&Command{
+		Name:        "test",
+		Description: "test",
+		Subcommands: []*Command{
+			{
+				Name:        "run",
+				Description: "run",
+				Options: []*Option{
+					{Name: "name", Type: discordgo.ApplicationCommandOptionString, Required: true},
+					{Name: "count", Type: discordgo.ApplicationCommandOptionInteger, MinValue: &minValue, MaxValue: 10},
+					{Name: "flag", Type: discordgo.ApplicationCommandOptionBoolean},
+				},
+				Handler: handler,
+			},
+			{
+				Name:        "admin",
+				Description: "admin",
+				Permissions: discordgo.PermissionManageServer,
+				Subcommands: []*Command{
+					{Name: "do", Description: "do", Handler: handler},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return r
+}
+
+func option(name string, typ discordgo.ApplicationCommandOptionType, value any, options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.ApplicationCommandInteractionDataOption {
+	return &discordgo.ApplicationCommandInteractionDataOption{
+		Name:    name,
+		Type:    typ,
+		Value:   value,
+		Options: options,
+	}
+}
+
+func subcommand(name string, options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.ApplicationCommandInteractionDataOption {
+	return option(name, discordgo.ApplicationCommandOptionSubCommand, nil, options...)
+}
+
+func TestRouterHandle(t *testing.T) {
+	r := newTestRouter(t)
+
+	tests := []struct {
+		name        string
+		permissions int64
+		options     []*discordgo.ApplicationCommandInteractionDataOption
+		want        string
+		err         error
+	}{
+		{
+			name: "test",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				subcommand("run", option("name", discordgo.ApplicationCommandOptionString, "a")),
+			},
+			want: `test run "a" 0 false false`,
+		},
+		{
+			name: "test",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				subcommand("run",
+					option("name", discordgo.ApplicationCommandOptionString, "b"),
+					option("count", discordgo.ApplicationCommandOptionInteger, float64(3)),
+					option("flag", discordgo.ApplicationCommandOptionBoolean, true),
+				),
+			},
+			want: `test run "b" 3 true true`,
+		},
+		{
+			name: "test",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				subcommand("run"),
+			},
+			err: ErrInvalidOption,
+		},
+		{
+			name: "test",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				subcommand("run", option("name", discordgo.ApplicationCommandOptionInteger, float64(1))),
+			},
+			err: ErrInvalidOption,
+		},
+		{
+			name: "test",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				subcommand("run",
+					option("name", discordgo.ApplicationCommandOptionString, "a"),
+					option("count", discordgo.ApplicationCommandOptionInteger, float64(11)),
+				),
+			},
+			err: ErrInvalidOption,
+		},
+		{
+			name: "test",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				subcommand("run",
+					option("name", discordgo.ApplicationCommandOptionString, "a"),
+					option("count", discordgo.ApplicationCommandOptionInteger, 1.5),
+				),
+			},
+			err: ErrInvalidOption,
+		},
+		{
+			name: "test",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				subcommand("run",
+					option("name", discordgo.ApplicationCommandOptionString, "a"),
+					option("unknown", discordgo.ApplicationCommandOptionString, "a"),
+				),
+			},
+			err: ErrInvalidOption,
+		},
+		{
+			name: "test",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				option("admin", discordgo.ApplicationCommandOptionSubCommandGroup, nil, subcommand("do")),
+			},
+			err: ErrPermissionDenied,
+		},
+		{
+			name:        "test",
+			permissions: discordgo.PermissionManageServer,
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				option("admin", discordgo.ApplicationCommandOptionSubCommandGroup, nil, subcommand("do")),
+			},
+			want: `test admin do "" 0 false false`,
+		},
+		{
+			name:        "test",
+			permissions: discordgo.PermissionAdministrator,
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				option("admin", discordgo.ApplicationCommandOptionSubCommandGroup, nil, subcommand("do")),
+			},
+			want: `test admin do "" 0 false false`,
+		},
+		{
+			name: "test",
+			options: []*discordgo.ApplicationCommandInteractionDataOption{
+				subcommand("unknown"),
+			},
+			err: ErrUnknownCommand,
+		},
+		{
+			name: "test",
+			err:  ErrUnknownCommand,
+		},
+		{
+			name: "unknown",
+			err:  ErrUnknownCommand,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			event := &discordgo.InteractionCreate{
+				Interaction: &discordgo.Interaction{
+					Type: discordgo.InteractionApplicationCommand,
+					Member: &discordgo.Member{
+						User:        &discordgo.User{ID: "user"},
+						Permissions: tt.permissions,
+					},
+					Data: discordgo.ApplicationCommandInteractionData{
+						Name:    tt.name,
+						Options: tt.options,
+					},
+				},
+			}
+
+			res, err := r.Handle(context.Background(), event)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("Router.Handle(): got error %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := res.Data.Content; got != tt.want {
+				t.Errorf("Router.Handle(): got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRouterDeferral(t *testing.T) {
+	handler := func(ctx context.Context, req *Request) *discordgo.InteractionResponse {
+		return &discordgo.InteractionResponse{}
+	}
+	r, err := NewRouter(&Command{
+		Name:        "test",
+		Description: "test",
+		Subcommands: []*Command{
+			{Name: "fast", Description: "fast", Handler: handler},
+			{Name: "slow", Description: "slow", Handler: handler, Deferred: true},
+			{Name: "private", Description: "private", Handler: handler, Deferred: true, Ephemeral: true},
+			{Name: "admin", Description: "admin", Permissions: discordgo.PermissionManageServer, Handler: handler, Deferred: true},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deferred := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	}
+	ephemeral := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	}
+
+	tests := []struct {
+		typ  discordgo.InteractionType
+		name string
+		want *discordgo.InteractionResponse
+	}{
+		{typ: discordgo.InteractionApplicationCommand, name: "fast", want: nil},
+		{typ: discordgo.InteractionApplicationCommand, name: "slow", want: deferred},
+		{typ: discordgo.InteractionApplicationCommand, name: "private", want: ephemeral},
+		{typ: discordgo.InteractionApplicationCommandAutocomplete, name: "slow", want: nil},
+		// the permission error is responded at once
+		{typ: discordgo.InteractionApplicationCommand, name: "admin", want: nil},
+		{typ: discordgo.InteractionApplicationCommand, name: "unknown", want: nil},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			event := &discordgo.InteractionCreate{
+				Interaction: &discordgo.Interaction{
+					Type:   tt.typ,
+					Member: &discordgo.Member{User: &discordgo.User{ID: "user"}},
+					Data: discordgo.ApplicationCommandInteractionData{
+						Name:    "test",
+						Options: []*discordgo.ApplicationCommandInteractionDataOption{subcommand(tt.name)},
+					},
+				},
+			}
+
+			if diff := cmp.Diff(tt.want, r.Deferral(event)); diff != "" {
+				t.Errorf("Router.Deferral() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRouterApplicationCommands(t *testing.T) {
+	r, err := NewRouter(&Command{
+		Name:        "test",
+		Description: "test command",
+		Permissions: discordgo.PermissionManageServer,
+		Subcommands: []*Command{
+			{
+				Name:        "run",
+				Description: "run",
+				Options: []*Option{
+					{
+						Name:        "name",
+						Description: "name",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Choices:     []*discordgo.ApplicationCommandOptionChoice{{Name: "a", Value: "a"}},
+						LoadChoices: func(ctx context.Context) ([]*discordgo.ApplicationCommandOptionChoice, error) {
+							return []*discordgo.ApplicationCommandOptionChoice{{Name: "b", Value: "b"}}, nil
+						},
+						Required: true,
+					},
+				},
+				Handler: func(ctx context.Context, req *Request) *discordgo.InteractionResponse { return nil },
+			},
+			{
+				Name:        "group",
+				Description: "group",
+				Subcommands: []*Command{
+					{
+						Name:        "do",
+						Description: "do",
+						Handler:     func(ctx context.Context, req *Request) *discordgo.InteractionResponse { return nil },
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := r.ApplicationCommands(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	permissions := int64(discordgo.PermissionManageServer)
+	want := []*discordgo.ApplicationCommand{
+		{
+			Name:                     "test",
+			Description:              "test command",
+			Type:                     discordgo.ChatApplicationCommand,
+			DefaultMemberPermissions: &permissions,
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Name:        "run",
+					Description: "run",
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "name",
+							Description: "name",
+							Type:        discordgo.ApplicationCommandOptionString,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "a", Value: "a"},
+								{Name: "b", Value: "b"},
+							},
+							Required: true,
+						},
+					},
+				},
+				{
+					Name:        "group",
+					Description: "group",
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Name:        "do",
+							Description: "do",
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+						},
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Router.ApplicationCommands() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewRouterInvalid(t *testing.T) {
+	handler := func(ctx context.Context, req *Request) *discordgo.InteractionResponse { return nil }
+
+	tests := []*Command{
+		{Name: ""},
+		{Name: "test"},
+		{Name: "test", Handler: handler, Subcommands: []*Command{{Name: "sub", Handler: handler}}},
+		{Name: "test", Subcommands: []*Command{{Name: "sub", Handler: handler}, {Name: "sub", Handler: handler}}},
+		{Name: "test", Subcommands: []*Command{{Name: "group", Subcommands: []*Command{{Name: "sub", Subcommands: []*Command{{Name: "deep", Handler: handler}}}}}}},
+	}
+
+	for i, cmd := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			if _, err := NewRouter(cmd); err == nil {
+				t.Error("NewRouter(): got nil error")
+			}
+		})
+	}
+}
+
+func TestRouterAutocomplete(t *testing.T) {
+	r, err := NewRouter(&Command{
+		Name:        "test",
+		Description: "test",
+		Subcommands: []*Command{
+			{
+				Name:        "run",
+				Description: "run",
+				Options: []*Option{
+					{
+						Name: "name",
+						Type: discordgo.ApplicationCommandOptionString,
+						Autocomplete: func(ctx context.Context, req *Request, value string) []*discordgo.ApplicationCommandOptionChoice {
+							var choices []*discordgo.ApplicationCommandOptionChoice
+							for i := range 30 {
+								name := fmt.Sprintf("%s%s%02d", req.String("prefix"), value, i)
+								choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: name, Value: name})
+							}
+							return choices
+						},
+					},
+					{Name: "prefix", Type: discordgo.ApplicationCommandOptionString},
+				},
+				Handler: func(ctx context.Context, req *Request) *discordgo.InteractionResponse { return nil },
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name := option("name", discordgo.ApplicationCommandOptionString, "a")
+	name.Focused = true
+	event := &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			Type:   discordgo.InteractionApplicationCommandAutocomplete,
+			Member: &discordgo.Member{User: &discordgo.User{ID: "user"}},
+			Data: discordgo.ApplicationCommandInteractionData{
+				Name: "test",
+				Options: []*discordgo.ApplicationCommandInteractionDataOption{
+					subcommand("run", option("prefix", discordgo.ApplicationCommandOptionString, "p"), name),
+				},
+			},
+		},
+	}
+
+	res, err := r.Handle(context.Background(), event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Type != discordgo.InteractionApplicationCommandAutocompleteResult {
+		t.Errorf("Router.Handle(): got type %v, want %v", res.Type, discordgo.InteractionApplicationCommandAutocompleteResult)
+	}
+	if got := len(res.Data.Choices); got != maxChoices {
+		t.Errorf("Router.Handle(): got %d choices, want %d", got, maxChoices)
+	}
+	if got := res.Data.Choices[0].Name; got != "pa00" {
+		t.Errorf("Router.Handle(): got %s, want %s", got, "pa00")
+	}
+}
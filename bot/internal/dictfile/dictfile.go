@@ -0,0 +1,259 @@
+// Package dictfile reads and writes the reading dictionaries in the file
+// formats used by yomiko and other reading bots.
+package dictfile
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+var ErrUnknownFormat = errors.New("unknown format")
+
+type Format string
+
+const (
+	// CSV is the rows of a word and its reading, optionally with a header
+	// row.
+	CSV Format = "csv"
+	// JSON is an array of the objects with "from" and "to".
+	JSON Format = "json"
+	// TOML is the replacements of the config file.
+	TOML Format = "toml"
+)
+
+var Formats = []Format{CSV, JSON, TOML}
+
+// ParseFormat returns the format of the name, like "csv".
+func ParseFormat(name string) (Format, error) {
+	f := Format(strings.ToLower(name))
+	for _, format := range Formats {
+		if f == format {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("dictfile.ParseFormat: %w: %q", ErrUnknownFormat, name)
+}
+
+// FormatFromName returns the format by the extension of the file name.
+func FormatFromName(name string) (Format, error) {
+	ext := strings.TrimPrefix(path.Ext(name), ".")
+	if ext == "" {
+		return "", fmt.Errorf("dictfile.FormatFromName: %w: %q has no extension", ErrUnknownFormat, name)
+	}
+
+	f, err := ParseFormat(ext)
+	if err != nil {
+		return "", fmt.Errorf("dictfile.FormatFromName: %w", err)
+	}
+
+	return f, nil
+}
+
+// Entry is a word and its reading.
+type Entry struct {
+	Word    string `json:"from" toml:"from"`
+	Reading string `json:"to" toml:"to"`
+}
+
+type tomlFile struct {
+	Replacements []Entry `toml:"replacements"`
+}
+
+// Read reads the entries in the format. The words and the readings are
+// trimmed, and the entries without them are an error.
+func Read(r io.Reader, format Format) ([]Entry, error) {
+	var (
+		entries []Entry
+		err     error
+	)
+	switch format {
+	case CSV:
+		entries, err = readCSV(r)
+	case JSON:
+		err = json.NewDecoder(r).Decode(&entries)
+	case TOML:
+		var f tomlFile
+		_, err = toml.NewDecoder(r).Decode(&f)
+		entries = f.Replacements
+	default:
+		err = fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dictfile.Read: %w", err)
+	}
+
+	for i := range entries {
+		e := &entries[i]
+		e.Word = strings.TrimSpace(e.Word)
+		e.Reading = strings.TrimSpace(e.Reading)
+		if e.Word == "" || e.Reading == "" {
+			return nil, fmt.Errorf("dictfile.Read: entry %d has no word or reading", i+1)
+		}
+	}
+
+	return entries, nil
+}
+
+// utf-8 BOM written by some spreadsheets
+var bom = []byte("\xef\xbb\xbf")
+
+func readCSV(r io.Reader) ([]Entry, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	cr := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(b, bom)))
+	cr.FieldsPerRecord = 2
+	cr.TrimLeadingSpace = true
+
+	var entries []Entry
+	for i := 0; ; i++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 && isHeader(record) {
+			continue
+		}
+
+		entries = append(entries, Entry{
+			Word:    record[0],
+			Reading: record[1],
+		})
+	}
+
+	return entries, nil
+}
+
+func isHeader(record []string) bool {
+	switch strings.ToLower(record[0]) + "," + strings.ToLower(record[1]) {
+	case "word,reading", "from,to":
+		return true
+	}
+	return false
+}
+
+// Write writes the entries in the format.
+func Write(w io.Writer, format Format, entries []Entry) error {
+	var err error
+	switch format {
+	case CSV:
+		cw := csv.NewWriter(w)
+		for _, e := range entries {
+			if err = cw.Write([]string{e.Word, e.Reading}); err != nil {
+				break
+			}
+		}
+		cw.Flush()
+		if err == nil {
+			err = cw.Error()
+		}
+	case JSON:
+		if entries == nil {
+			entries = []Entry{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(entries)
+	case TOML:
+		err = toml.NewEncoder(w).Encode(tomlFile{Replacements: entries})
+	default:
+		err = fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+	if err != nil {
+		return fmt.Errorf("dictfile.Write: %w", err)
+	}
+
+	return nil
+}
+
+// Change is an entry whose reading is changed.
+type Change struct {
+	Word       string
+	OldReading string
+	NewReading string
+}
+
+// Diff is the difference made by importing the entries.
+type Diff struct {
+	Added     []Entry
+	Changed   []Change
+	Unchanged int
+	// Duplicates are the words appearing more than once in the imported
+	// entries. The last reading is used.
+	Duplicates []string
+}
+
+// Empty reports whether importing changes nothing.
+func (d *Diff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Changed) == 0
+}
+
+// Compare returns the difference made by importing the entries to the
+// existing ones. The existing entries not imported are kept.
+func Compare(existing, imported []Entry) *Diff {
+	readings := make(map[string]string, len(existing))
+	for _, e := range existing {
+		readings[e.Word] = e.Reading
+	}
+
+	// the last reading of the duplicates
+	last := make(map[string]int, len(imported))
+	for i, e := range imported {
+		last[e.Word] = i
+	}
+
+	d := &Diff{}
+	seen := make(map[string]bool, len(imported))
+	for i, e := range imported {
+		if seen[e.Word] {
+			if last[e.Word] == i {
+				d.Duplicates = append(d.Duplicates, e.Word)
+			}
+			continue
+		}
+		seen[e.Word] = true
+		e = imported[last[e.Word]]
+
+		old, ok := readings[e.Word]
+		switch {
+		case !ok:
+			d.Added = append(d.Added, e)
+		case old != e.Reading:
+			d.Changed = append(d.Changed, Change{
+				Word:       e.Word,
+				OldReading: old,
+				NewReading: e.Reading,
+			})
+		default:
+			d.Unchanged++
+		}
+	}
+
+	return d
+}
+
+// String returns the lines of the changes, like the unified diff.
+func (d *Diff) String() string {
+	var b strings.Builder
+	for _, e := range d.Added {
+		fmt.Fprintf(&b, "+ %s → %s\n", e.Word, e.Reading)
+	}
+	for _, c := range d.Changed {
+		fmt.Fprintf(&b, "- %s → %s\n+ %s → %s\n", c.Word, c.OldReading, c.Word, c.NewReading)
+	}
+	return b.String()
+}
@@ -0,0 +1,155 @@
+package dictfile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var testEntries = []Entry{
+	{Word: "読子", Reading: "よみこ"},
+	{Word: "a,b", Reading: "えーびー"},
+}
+
+var readTests = []struct {
+	format Format
+	in     string
+	want   []Entry
+	err    bool
+}{
+	{
+		format: CSV,
+		in:     "\xef\xbb\xbfword,reading\n読子, よみこ\n\"a,b\",えーびー\n",
+		want:   testEntries,
+	},
+	{
+		format: CSV,
+		in:     "読子,よみこ,よ\n",
+		err:    true,
+	},
+	{
+		format: CSV,
+		in:     "読子,\n",
+		err:    true,
+	},
+	{
+		format: JSON,
+		in:     `[{"from": "読子", "to": "よみこ"}, {"from": "a,b", "to": "えーびー"}]`,
+		want:   testEntries,
+	},
+	{
+		format: TOML,
+		in:     "[[replacements]]\nfrom = \"読子\"\nto = \"よみこ\"\n\n[[replacements]]\nfrom = \"a,b\"\nto = \"えーびー\"\n",
+		want:   testEntries,
+	},
+	{
+		format: Format("xml"),
+		in:     "",
+		err:    true,
+	},
+}
+
+func TestRead(t *testing.T) {
+	for i, tt := range readTests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			got, err := Read(strings.NewReader(tt.in), tt.format)
+			if tt.err {
+				if err == nil {
+					t.Fatal("Read(): no error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Read() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	for i, format := range Formats {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			var b bytes.Buffer
+			if err := Write(&b, format, testEntries); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := Read(&b, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(testEntries, got); diff != "" {
+				t.Errorf("Read() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFormatFromName(t *testing.T) {
+	tests := []struct {
+		name string
+		want Format
+		err  error
+	}{
+		{name: "dict.csv", want: CSV},
+		{name: "dict.JSON", want: JSON},
+		{name: "config.toml", want: TOML},
+		{name: "dict.txt", err: ErrUnknownFormat},
+		{name: "dict", err: ErrUnknownFormat},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			got, err := FormatFromName(tt.name)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("FormatFromName(): got error %v, want %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("FormatFromName(): got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	existing := []Entry{
+		{Word: "読子", Reading: "よみこ"},
+		{Word: "猫", Reading: "ねこ"},
+		{Word: "犬", Reading: "いぬ"},
+	}
+	imported := []Entry{
+		{Word: "猫", Reading: "にゃんこ"},
+		{Word: "読子", Reading: "よみこ"},
+		{Word: "鳥", Reading: "とり"},
+		{Word: "猫", Reading: "ぬこ"},
+		{Word: "鳥", Reading: "とり"},
+	}
+
+	want := &Diff{
+		Added: []Entry{
+			{Word: "鳥", Reading: "とり"},
+		},
+		Changed: []Change{
+			{Word: "猫", OldReading: "ねこ", NewReading: "ぬこ"},
+		},
+		Unchanged:  1,
+		Duplicates: []string{"猫", "鳥"},
+	}
+
+	got := Compare(existing, imported)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Compare() mismatch (-want +got):\n%s", diff)
+	}
+	if s := got.String(); s != "+ 鳥 → とり\n- 猫 → ねこ\n+ 猫 → ぬこ\n" {
+		t.Errorf("Diff.String(): got %q", s)
+	}
+}
@@ -0,0 +1,130 @@
+package replacer
+
+import "unicode/utf8"
+
+type matcherKey struct {
+	state int32
+	r     rune
+}
+
+// matcher finds the words in a text with an Aho–Corasick automaton, in the
+// time linear to the text regardless of the number of the words.
+type matcher struct {
+	// transitions by the state and the rune
+	next map[matcherKey]int32
+	// the state of the longest proper suffix of the state
+	fail []int32
+	// the length in bytes of the text of the state
+	depth []int
+	// the index of the longest word ending at the state, or -1
+	out []int32
+	// the length in bytes of the words
+	lens []int
+}
+
+// newMatcher returns a matcher of the words. The first one is used if a
+// word appears more than once.
+func newMatcher(words []string) *matcher {
+	m := &matcher{
+		next:  make(map[matcherKey]int32),
+		fail:  []int32{0},
+		depth: []int{0},
+		out:   []int32{-1},
+		lens:  make([]int, len(words)),
+	}
+
+	// children of the states to build the failure links
+	var children [][]matcherKey
+	children = append(children, nil)
+
+	for i, word := range words {
+		m.lens[i] = len(word)
+		if word == "" {
+			continue
+		}
+
+		var s int32
+		for pos := 0; pos < len(word); {
+			c, size := utf8.DecodeRuneInString(word[pos:])
+			pos += size
+
+			key := matcherKey{s, c}
+			t, ok := m.next[key]
+			if !ok {
+				t = int32(len(m.fail))
+				m.next[key] = t
+				m.fail = append(m.fail, 0)
+				m.depth = append(m.depth, pos)
+				m.out = append(m.out, -1)
+				children = append(children, nil)
+				children[s] = append(children[s], key)
+			}
+			s = t
+		}
+		if m.out[s] < 0 {
+			m.out[s] = int32(i)
+		}
+	}
+
+	// breadth first, so that the failure link of a state is built before
+	// the state
+	queue := make([]int32, 0, len(m.fail))
+	queue = append(queue, 0)
+	for len(queue) > 0 {
+		s := queue[0]
+		queue = queue[1:]
+
+		for _, key := range children[s] {
+			t := m.next[key]
+			queue = append(queue, t)
+
+			if s != 0 {
+				m.fail[t] = m.step(m.fail[s], key.r)
+			}
+			if m.out[t] < 0 {
+				m.out[t] = m.out[m.fail[t]]
+			}
+		}
+	}
+
+	return m
+}
+
+func (m *matcher) step(s int32, r rune) int32 {
+	for {
+		if t, ok := m.next[matcherKey{s, r}]; ok {
+			return t
+		}
+		if s == 0 {
+			return 0
+		}
+		s = m.fail[s]
+	}
+}
+
+// find returns the index of the leftmost longest word in the text and its
+// position, or -1 if no word is found.
+func (m *matcher) find(text string) (index, start, end int) {
+	index = -1
+
+	var s int32
+	for pos := 0; pos < len(text); {
+		c, size := utf8.DecodeRuneInString(text[pos:])
+		pos += size
+
+		s = m.step(s, c)
+		// the words found later start after the found one
+		if index >= 0 && pos-m.depth[s] > start {
+			break
+		}
+
+		if o := m.out[s]; o >= 0 {
+			st := pos - m.lens[o]
+			if index < 0 || st < start || st == start && pos > end {
+				index, start, end = int(o), st, pos
+			}
+		}
+	}
+
+	return index, start, end
+}
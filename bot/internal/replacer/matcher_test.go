@@ -0,0 +1,72 @@
+package replacer
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMatcherFind(t *testing.T) {
+	tests := []struct {
+		words []string
+		text  string
+		index int
+		start int
+		end   int
+	}{
+		{
+			words: []string{"東京", "東京都", "京都"},
+			text:  "東京都に住む",
+			index: 1,
+			start: 0,
+			end:   9,
+		},
+		{
+			// leftmost first, even if shorter
+			words: []string{"京都府", "東京"},
+			text:  "東京都府",
+			index: 1,
+			start: 0,
+			end:   6,
+		},
+		{
+			// a longer word starting earlier is found later
+			words: []string{"cd", "bcdef"},
+			text:  "abcdefg",
+			index: 1,
+			start: 1,
+			end:   6,
+		},
+		{
+			words: []string{"he", "she", "his", "hers"},
+			text:  "ushers",
+			index: 1,
+			start: 1,
+			end:   4,
+		},
+		{
+			// the first one of the same words
+			words: []string{"猫", "ねこ", "猫"},
+			text:  "黒猫",
+			index: 0,
+			start: 3,
+			end:   6,
+		},
+		{
+			words: []string{"", "犬"},
+			text:  "猫",
+			index: -1,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i+1), func(t *testing.T) {
+			index, start, end := newMatcher(tt.words).find(tt.text)
+			if index != tt.index {
+				t.Fatalf("matcher.find(%q): got index %d, want %d", tt.text, index, tt.index)
+			}
+			if index >= 0 && (start != tt.start || end != tt.end) {
+				t.Errorf("matcher.find(%q): got [%d, %d), want [%d, %d)", tt.text, start, end, tt.start, tt.end)
+			}
+		})
+	}
+}
@@ -15,7 +15,10 @@ type dicEntry struct {
 }
 
 type Replacer struct {
-	dict []*dicEntry
+	dict    []*dicEntry
+	matcher *matcher
+	// replaces the text not matching dict
+	next *Replacer
 }
 
 func New(oldnew ...string) *Replacer {
@@ -26,12 +29,27 @@ func New(oldnew ...string) *Replacer {
 
 func (r *Replacer) build(oldnew []string) {
 	r.dict = make([]*dicEntry, 0, len(oldnew)/2)
+	words := make([]string, 0, len(oldnew)/2)
 	for i := 0; i+1 < len(oldnew); i += 2 {
 		r.dict = append(r.dict, &dicEntry{
 			from: oldnew[i],
 			to:   oldnew[i+1],
 		})
+		words = append(words, oldnew[i])
 	}
+	r.matcher = newMatcher(words)
+}
+
+// With returns a Replacer replacing with the entries of oldnew before the
+// entries of r. r is not changed.
+func (r *Replacer) With(oldnew ...string) *Replacer {
+	if len(oldnew) < 2 {
+		return r
+	}
+
+	w := &Replacer{next: r}
+	w.build(oldnew)
+	return w
 }
 
 var urlRegexp = regexp.MustCompile(`https?://[^\s]{2,}`)
@@ -83,39 +101,44 @@ func (r *Replacer) replaceText(parent ssml.ParentNode, text string) {
 
 	for _, node := range nodes {
 		if t, ok := node.(ssml.Text); ok {
-			r.replaceDict(parent, 0, string(t))
+			r.replaceDict(parent, string(t))
 		} else {
 			parent.AddNode(node)
 		}
 	}
 }
 
-func (r *Replacer) replaceDict(parent ssml.ParentNode, entryIndex int, text string) {
-	if entryIndex >= len(r.dict) {
-		parent.AddNode(ssml.Text(text))
-		return
-	}
-	entry := r.dict[entryIndex]
-
-	for {
-		before, after, found := strings.Cut(text, entry.from)
-		if found {
-			if before != "" {
-				r.replaceDict(parent, entryIndex+1, before)
-			}
-			parent.AddNode(&ssml.Sub{
-				Text:  ssml.Text(entry.from),
-				Alias: entry.to,
-			})
-			text = after
-		} else {
+// replaceDict replaces the leftmost longest words of the dictionary from
+// the start of the text, and the rest with the next Replacer.
+func (r *Replacer) replaceDict(parent ssml.ParentNode, text string) {
+	for text != "" {
+		index, start, end := r.matcher.find(text)
+		if index < 0 {
 			break
 		}
+
+		if start > 0 {
+			r.replaceNext(parent, text[:start])
+		}
+		entry := r.dict[index]
+		parent.AddNode(&ssml.Sub{
+			Text:  ssml.Text(entry.from),
+			Alias: entry.to,
+		})
+		text = text[end:]
 	}
 
 	if text != "" {
-		r.replaceDict(parent, entryIndex+1, text)
+		r.replaceNext(parent, text)
+	}
+}
+
+func (r *Replacer) replaceNext(parent ssml.ParentNode, text string) {
+	if r.next != nil {
+		r.next.replaceDict(parent, text)
+		return
 	}
+	replaceSayAs(parent, text)
 }
 
 var wwwRegexp = regexp.MustCompile(`([^wｗ]|^)([wｗ]+)`)
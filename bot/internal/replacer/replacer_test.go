@@ -2,6 +2,8 @@ package replacer
 
 import (
 	"fmt"
+	"math/rand/v2"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -229,3 +231,109 @@ func TestReplacerReplace(t *testing.T) {
 		})
 	}
 }
+
+func TestReplacerWith(t *testing.T) {
+	r := New("禁書目録", "いんでっくす", "超電磁砲", "れーるがん")
+	w := r.With("超電磁砲", "ちょうでんじほう", "美琴", "みこと")
+
+	in := "美琴の超電磁砲と禁書目録"
+	tests := []struct {
+		r     *Replacer
+		nodes []ssml.Node
+	}{
+		{
+			r: w,
+			nodes: []ssml.Node{
+				&ssml.Sub{Text: "美琴", Alias: "みこと"},
+				ssml.Text("の"),
+				&ssml.Sub{Text: "超電磁砲", Alias: "ちょうでんじほう"},
+				ssml.Text("と"),
+				&ssml.Sub{Text: "禁書目録", Alias: "いんでっくす"},
+			},
+		},
+		{
+			// r is not changed
+			r: r,
+			nodes: []ssml.Node{
+				ssml.Text("美琴の"),
+				&ssml.Sub{Text: "超電磁砲", Alias: "れーるがん"},
+				ssml.Text("と"),
+				&ssml.Sub{Text: "禁書目録", Alias: "いんでっくす"},
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i+1), func(t *testing.T) {
+			var nodes replaceNodes
+
+			tt.r.Replace(&nodes, in)
+			if diff := cmp.Diff(tt.nodes, []ssml.Node(nodes)); diff != "" {
+				t.Errorf("Replacer.Replace(%q) mismatch (-want +got):\n%s", in, diff)
+			}
+		})
+	}
+}
+
+func TestReplacerLongestMatch(t *testing.T) {
+	r := New("東京", "とーきょー", "京都", "きょーと", "東京都", "とーきょーと")
+
+	in := "東京都と京都と東京"
+	want := []ssml.Node{
+		&ssml.Sub{Text: "東京都", Alias: "とーきょーと"},
+		ssml.Text("と"),
+		&ssml.Sub{Text: "京都", Alias: "きょーと"},
+		ssml.Text("と"),
+		&ssml.Sub{Text: "東京", Alias: "とーきょー"},
+	}
+
+	var nodes replaceNodes
+	r.Replace(&nodes, in)
+	if diff := cmp.Diff(want, []ssml.Node(nodes)); diff != "" {
+		t.Errorf("Replacer.Replace(%q) mismatch (-want +got):\n%s", in, diff)
+	}
+}
+
+// benchmarkDict returns the entries of the words of random kana.
+func benchmarkDict(n int) []string {
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	oldnew := make([]string, 0, n*2)
+	for range n {
+		word := make([]rune, 2+rnd.IntN(5))
+		for i := range word {
+			word[i] = 'ぁ' + rune(rnd.IntN(86))
+		}
+		oldnew = append(oldnew, string(word), "よみ")
+	}
+
+	return oldnew
+}
+
+func BenchmarkNew10k(b *testing.B) {
+	oldnew := benchmarkDict(10000)
+
+	b.ResetTimer()
+	for range b.N {
+		New(oldnew...)
+	}
+}
+
+func BenchmarkReplacerReplace10k(b *testing.B) {
+	oldnew := benchmarkDict(10000)
+	r := New(oldnew...)
+
+	// a message with some of the words
+	var sb strings.Builder
+	for i := range 20 {
+		sb.WriteString("今日はいい天気ですね")
+		sb.WriteString(oldnew[i*1000])
+	}
+	text := sb.String()
+
+	b.ResetTimer()
+	for range b.N {
+		var nodes replaceNodes
+		r.Replace(&nodes, text)
+	}
+}
@@ -0,0 +1,268 @@
+package replacer
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/kechako/yomiko/ssml"
+)
+
+// sayAsDetector detects a notation such as dates or amounts of money in
+// texts and converts it to a say-as node.
+type sayAsDetector struct {
+	re *regexp.Regexp
+	// node returns a say-as node for the submatches, or nil if the match
+	// should be ignored.
+	node func(m []string) *ssml.SayAs
+	// letterBoundary requires that the match is not followed by a letter.
+	letterBoundary bool
+}
+
+const numberPattern = `(?:\d{1,3}(?:,\d{3})+|\d+)(?:\.\d+)?`
+
+var telephoneDetector = &sayAsDetector{
+	re: regexp.MustCompile(`0\d{1,4}-\d{1,4}-\d{3,4}`),
+	node: func(m []string) *ssml.SayAs {
+		digits := len(m[0]) - strings.Count(m[0], "-")
+		if digits < 10 || digits > 11 {
+			return nil
+		}
+		return &ssml.SayAs{
+			Text:        ssml.Text(m[0]),
+			InterpretAs: ssml.Telephone,
+		}
+	},
+}
+
+var dateDetector = &sayAsDetector{
+	re: regexp.MustCompile(`(\d{4})([/\-.])(\d{1,2})([/\-.])(\d{1,2})`),
+	node: func(m []string) *ssml.SayAs {
+		if m[2] != m[4] {
+			return nil
+		}
+		month, _ := strconv.Atoi(m[3])
+		day, _ := strconv.Atoi(m[5])
+		if month < 1 || month > 12 || day < 1 || day > 31 {
+			return nil
+		}
+		return &ssml.SayAs{
+			Text:        ssml.Text(m[0]),
+			InterpretAs: ssml.Date,
+			Format:      "yyyymmdd",
+		}
+	},
+}
+
+var timeDetector = &sayAsDetector{
+	re: regexp.MustCompile(`(\d{1,2}):(\d{2})(?::(\d{2}))?`),
+	node: func(m []string) *ssml.SayAs {
+		hour, _ := strconv.Atoi(m[1])
+		minute, _ := strconv.Atoi(m[2])
+		if hour > 23 || minute > 59 {
+			return nil
+		}
+		if m[3] != "" {
+			if second, _ := strconv.Atoi(m[3]); second > 59 {
+				return nil
+			}
+		}
+		return &ssml.SayAs{
+			Text:        ssml.Text(m[0]),
+			InterpretAs: ssml.Time,
+			Format:      "hms24",
+		}
+	},
+}
+
+var currencyDetector = &sayAsDetector{
+	re: regexp.MustCompile(`([¥￥$])` + numberPattern + `|` + numberPattern + `(円|ドル)`),
+	node: func(m []string) *ssml.SayAs {
+		sa := &ssml.SayAs{
+			Text:        ssml.Text(m[0]),
+			InterpretAs: ssml.Currency,
+		}
+		if m[1] == "$" || m[2] == "ドル" {
+			sa.Language = "en-US"
+		}
+		return sa
+	},
+}
+
+var unitDetector = &sayAsDetector{
+	re: regexp.MustCompile(numberPattern + ` ?(?:km/h|km|cm|mm|m|kg|mg|g|TB|GB|MB|KB|kB|GHz|MHz|kHz|Hz|kW|W|V|℃|°C|%)`),
+	node: func(m []string) *ssml.SayAs {
+		return &ssml.SayAs{
+			Text:        ssml.Text(m[0]),
+			InterpretAs: ssml.Unit,
+		}
+	},
+	letterBoundary: true,
+}
+
+var cardinalDetector = &sayAsDetector{
+	re: regexp.MustCompile(`\d{1,3}(?:,\d{3})+(?:\.\d+)?`),
+	node: func(m []string) *ssml.SayAs {
+		return &ssml.SayAs{
+			Text:        ssml.Text(m[0]),
+			InterpretAs: ssml.Cardinal,
+		}
+	},
+}
+
+// sayAsDetectors is ordered by priority. When several detectors match at
+// the same position, the former one wins.
+var sayAsDetectors = []*sayAsDetector{
+	telephoneDetector,
+	dateDetector,
+	timeDetector,
+	currencyDetector,
+	unitDetector,
+	cardinalDetector,
+}
+
+// find returns the first match in text[offset:] that is not a part of a
+// longer number.
+func (d *sayAsDetector) find(text string, offset int) ([]int, *ssml.SayAs) {
+	for offset < len(text) {
+		loc := d.re.FindStringSubmatchIndex(text[offset:])
+		if loc == nil {
+			return nil, nil
+		}
+		for i := range loc {
+			if loc[i] >= 0 {
+				loc[i] += offset
+			}
+		}
+
+		if d.isBoundary(text, loc[0], loc[1]) {
+			m := make([]string, len(loc)/2)
+			for i := range m {
+				if loc[2*i] >= 0 {
+					m[i] = text[loc[2*i]:loc[2*i+1]]
+				}
+			}
+			if node := d.node(m); node != nil {
+				return loc[:2], node
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(text[loc[0]:])
+		offset = loc[0] + size
+	}
+
+	return nil, nil
+}
+
+func (d *sayAsDetector) isBoundary(text string, start, end int) bool {
+	if start > 0 {
+		r, _ := utf8.DecodeLastRuneInString(text[:start])
+		if isDigit(r) || r == ',' || r == '.' || isAlphabet(r) {
+			return false
+		}
+	}
+	if end < len(text) {
+		r, _ := utf8.DecodeRuneInString(text[end:])
+		if isDigit(r) || ((r == ',' || r == '.' || r == ':' || r == '/' || r == '-') && end+1 < len(text) && isDigit(rune(text[end+1]))) {
+			return false
+		}
+		if d.letterBoundary && isAlphabet(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// normalizeDigits converts the full-width digits to ASCII ones, so that the
+// detectors find them. It also returns the offsets in text of the bytes of
+// the result and its end, or nil if nothing is converted.
+func normalizeDigits(text string) (string, []int) {
+	if !strings.ContainsFunc(text, isFullWidthDigit) {
+		return text, nil
+	}
+
+	var b strings.Builder
+	offsets := make([]int, 0, len(text)+1)
+	for i, r := range text {
+		if isFullWidthDigit(r) {
+			r = r - '０' + '0'
+		}
+		n, _ := b.WriteRune(r)
+		for range n {
+			offsets = append(offsets, i)
+		}
+	}
+	offsets = append(offsets, len(text))
+
+	return b.String(), offsets
+}
+
+func isFullWidthDigit(r rune) bool {
+	return r >= '０' && r <= '９'
+}
+
+type sayAsMatch struct {
+	loc  []int
+	node *ssml.SayAs
+	// no more matches in the rest of the text
+	done bool
+}
+
+// replaceSayAs adds the nodes of text with the notations detected. The
+// full-width digits are converted only in the say-as nodes, and the rest of
+// the text is added as it is.
+func replaceSayAs(parent ssml.ParentNode, text string) {
+	original := text
+	text, offsets := normalizeDigits(text)
+	// the offset in the original text
+	orig := func(i int) int {
+		if offsets == nil {
+			return i
+		}
+		return offsets[i]
+	}
+
+	// the next match of each detector, which is searched again only after
+	// the offset passes it
+	next := make([]sayAsMatch, len(sayAsDetectors))
+
+	offset := 0
+	for offset < len(text) {
+		best := -1
+		for i, d := range sayAsDetectors {
+			m := &next[i]
+			if m.done {
+				continue
+			}
+			if m.loc == nil || m.loc[0] < offset {
+				m.loc, m.node = d.find(text, offset)
+				if m.loc == nil {
+					m.done = true
+					continue
+				}
+			}
+			if best < 0 || m.loc[0] < next[best].loc[0] {
+				best = i
+			}
+		}
+		if best < 0 {
+			break
+		}
+
+		loc := next[best].loc
+		if offset < loc[0] {
+			parent.AddNode(ssml.Text(original[orig(offset):orig(loc[0])]))
+		}
+		parent.AddNode(next[best].node)
+		offset = loc[1]
+	}
+
+	if offset < len(text) {
+		parent.AddNode(ssml.Text(original[orig(offset):]))
+	}
+}
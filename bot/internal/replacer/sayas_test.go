@@ -0,0 +1,274 @@
+package replacer
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kechako/yomiko/ssml"
+)
+
+type sayAsTest struct {
+	in    string
+	nodes []ssml.Node
+}
+
+func runSayAsTests(t *testing.T, tests []sayAsTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			var nodes replaceNodes
+
+			replaceSayAs(&nodes, tt.in)
+			if diff := cmp.Diff(tt.nodes, []ssml.Node(nodes)); diff != "" {
+				t.Errorf("replaceSayAs(%q) mismatch (-want +got):\n%s", tt.in, diff)
+			}
+		})
+	}
+}
+
+var telephoneTests = []sayAsTest{
+	{
+		in: "電話は03-1234-5678まで",
+		nodes: []ssml.Node{
+			ssml.Text("電話は"),
+			&ssml.SayAs{Text: "03-1234-5678", InterpretAs: ssml.Telephone},
+			ssml.Text("まで"),
+		},
+	},
+	{
+		in: "090-1234-5678",
+		nodes: []ssml.Node{
+			&ssml.SayAs{Text: "090-1234-5678", InterpretAs: ssml.Telephone},
+		},
+	},
+	{
+		in: "0120-123-456",
+		nodes: []ssml.Node{
+			&ssml.SayAs{Text: "0120-123-456", InterpretAs: ssml.Telephone},
+		},
+	},
+	{
+		in: "01-2-345",
+		nodes: []ssml.Node{
+			ssml.Text("01-2-345"),
+		},
+	},
+}
+
+func TestTelephoneDetector(t *testing.T) {
+	runSayAsTests(t, telephoneTests)
+}
+
+var dateTests = []sayAsTest{
+	{
+		in: "2024/6/1に集合",
+		nodes: []ssml.Node{
+			&ssml.SayAs{Text: "2024/6/1", InterpretAs: ssml.Date, Format: "yyyymmdd"},
+			ssml.Text("に集合"),
+		},
+	},
+	{
+		in: "2024-06-01",
+		nodes: []ssml.Node{
+			&ssml.SayAs{Text: "2024-06-01", InterpretAs: ssml.Date, Format: "yyyymmdd"},
+		},
+	},
+	{
+		in: "2024.12.31",
+		nodes: []ssml.Node{
+			&ssml.SayAs{Text: "2024.12.31", InterpretAs: ssml.Date, Format: "yyyymmdd"},
+		},
+	},
+	{
+		in: "2024/13/1",
+		nodes: []ssml.Node{
+			ssml.Text("2024/13/1"),
+		},
+	},
+	{
+		in: "2024/6-1",
+		nodes: []ssml.Node{
+			ssml.Text("2024/6-1"),
+		},
+	},
+}
+
+func TestDateDetector(t *testing.T) {
+	runSayAsTests(t, dateTests)
+}
+
+var timeTests = []sayAsTest{
+	{
+		in: "12:34に開始",
+		nodes: []ssml.Node{
+			&ssml.SayAs{Text: "12:34", InterpretAs: ssml.Time, Format: "hms24"},
+			ssml.Text("に開始"),
+		},
+	},
+	{
+		in: "9:05:30",
+		nodes: []ssml.Node{
+			&ssml.SayAs{Text: "9:05:30", InterpretAs: ssml.Time, Format: "hms24"},
+		},
+	},
+	{
+		in: "25:00",
+		nodes: []ssml.Node{
+			ssml.Text("25:00"),
+		},
+	},
+}
+
+func TestTimeDetector(t *testing.T) {
+	runSayAsTests(t, timeTests)
+}
+
+var currencyTests = []sayAsTest{
+	{
+		in: "¥1,000です",
+		nodes: []ssml.Node{
+			&ssml.SayAs{Text: "¥1,000", InterpretAs: ssml.Currency},
+			ssml.Text("です"),
+		},
+	},
+	{
+		in: "価格は1,500円",
+		nodes: []ssml.Node{
+			ssml.Text("価格は"),
+			&ssml.SayAs{Text: "1,500円", InterpretAs: ssml.Currency},
+		},
+	},
+	{
+		in: "$12.50",
+		nodes: []ssml.Node{
+			&ssml.SayAs{Text: "$12.50", InterpretAs: ssml.Currency, Language: "en-US"},
+		},
+	},
+	{
+		in: "30ドル",
+		nodes: []ssml.Node{
+			&ssml.SayAs{Text: "30ドル", InterpretAs: ssml.Currency, Language: "en-US"},
+		},
+	},
+}
+
+func TestCurrencyDetector(t *testing.T) {
+	runSayAsTests(t, currencyTests)
+}
+
+var unitTests = []sayAsTest{
+	{
+		in: "あと10kmです",
+		nodes: []ssml.Node{
+			ssml.Text("あと"),
+			&ssml.SayAs{Text: "10km", InterpretAs: ssml.Unit},
+			ssml.Text("です"),
+		},
+	},
+	{
+		in: "16 GB",
+		nodes: []ssml.Node{
+			&ssml.SayAs{Text: "16 GB", InterpretAs: ssml.Unit},
+		},
+	},
+	{
+		in: "今日は25.5℃",
+		nodes: []ssml.Node{
+			ssml.Text("今日は"),
+			&ssml.SayAs{Text: "25.5℃", InterpretAs: ssml.Unit},
+		},
+	},
+	{
+		in: "5min",
+		nodes: []ssml.Node{
+			ssml.Text("5min"),
+		},
+	},
+}
+
+func TestUnitDetector(t *testing.T) {
+	runSayAsTests(t, unitTests)
+}
+
+var cardinalTests = []sayAsTest{
+	{
+		in: "人口は1,234,567人",
+		nodes: []ssml.Node{
+			ssml.Text("人口は"),
+			&ssml.SayAs{Text: "1,234,567", InterpretAs: ssml.Cardinal},
+			ssml.Text("人"),
+		},
+	},
+	{
+		in: "12,345.67",
+		nodes: []ssml.Node{
+			&ssml.SayAs{Text: "12,345.67", InterpretAs: ssml.Cardinal},
+		},
+	},
+	{
+		in: "1234",
+		nodes: []ssml.Node{
+			ssml.Text("1234"),
+		},
+	},
+	{
+		in: "1,23",
+		nodes: []ssml.Node{
+			ssml.Text("1,23"),
+		},
+	},
+}
+
+func TestCardinalDetector(t *testing.T) {
+	runSayAsTests(t, cardinalTests)
+}
+
+func TestReplaceSayAs(t *testing.T) {
+	runSayAsTests(t, []sayAsTest{
+		{
+			in: "２０２４/１/２の１２:３０に１,０００円",
+			nodes: []ssml.Node{
+				&ssml.SayAs{Text: "2024/1/2", InterpretAs: ssml.Date, Format: "yyyymmdd"},
+				ssml.Text("の"),
+				&ssml.SayAs{Text: "12:30", InterpretAs: ssml.Time, Format: "hms24"},
+				ssml.Text("に"),
+				&ssml.SayAs{Text: "1,000円", InterpretAs: ssml.Currency},
+			},
+		},
+		{
+			// the digits not detected are kept
+			in: "第１２３回は２０２４/１/２",
+			nodes: []ssml.Node{
+				ssml.Text("第１２３回は"),
+				&ssml.SayAs{Text: "2024/1/2", InterpretAs: ssml.Date, Format: "yyyymmdd"},
+			},
+		},
+		{
+			in: "１２:３０から５人",
+			nodes: []ssml.Node{
+				&ssml.SayAs{Text: "12:30", InterpretAs: ssml.Time, Format: "hms24"},
+				ssml.Text("から５人"),
+			},
+		},
+		{
+			in: "電話は０３-１２３４-５６７８",
+			nodes: []ssml.Node{
+				ssml.Text("電話は"),
+				&ssml.SayAs{Text: "03-1234-5678", InterpretAs: ssml.Telephone},
+			},
+		},
+		{
+			in: "1,000円と2,000円と10kgと3,000",
+			nodes: []ssml.Node{
+				&ssml.SayAs{Text: "1,000円", InterpretAs: ssml.Currency},
+				ssml.Text("と"),
+				&ssml.SayAs{Text: "2,000円", InterpretAs: ssml.Currency},
+				ssml.Text("と"),
+				&ssml.SayAs{Text: "10kg", InterpretAs: ssml.Unit},
+				ssml.Text("と"),
+				&ssml.SayAs{Text: "3,000", InterpretAs: ssml.Cardinal},
+			},
+		},
+	})
+}
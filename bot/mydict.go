@@ -0,0 +1,221 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/kechako/yomiko/bot/internal/command"
+	"github.com/kechako/yomiko/bot/internal/dictfile"
+	"github.com/kechako/yomiko/bot/internal/replacer"
+	"github.com/kechako/yomiko/ent"
+	"github.com/kechako/yomiko/ent/userdictentry"
+)
+
+const (
+	maxUserDictEntries = 100
+	maxDictWord        = 50
+	maxDictReading     = 100
+
+	maxChoiceNameLength = 100
+)
+
+// userReplacer returns the replacer of the messages of the user in the
+// guild, which replaces with the personal dictionary of the user first, and
+// then the dictionary of the guild.
+func (bot *Bot) userReplacer(ctx context.Context, guildID, userID string) (*replacer.Replacer, error) {
+	r, err := bot.getGuildReplacer(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.userReplacer: %w", err)
+	}
+
+	rows, err := bot.getUserDictEntries(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.userReplacer: %w", err)
+	}
+
+	entries := make([]dictfile.Entry, len(rows))
+	for i, row := range rows {
+		entries[i] = dictfile.Entry{
+			Word:    row.Word,
+			Reading: row.Reading,
+		}
+	}
+
+	return withDictEntries(r, entries), nil
+}
+
+func (bot *Bot) getUserDictEntries(ctx context.Context, userID string) ([]*ent.UserDictEntry, error) {
+	entries, err := bot.ent.UserDictEntry.Query().
+		Where(userdictentry.UserID(userID)).
+		Order(ent.Asc(userdictentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.getUserDictEntries: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (bot *Bot) myDictCommand() *command.Command {
+	return &command.Command{
+		Name:        "mydict",
+		Description: "あなたの投稿だけに使う読み方の辞書を編集します。",
+		Subcommands: []*command.Command{
+			{
+				Name:        "add",
+				Description: "言葉の読み方を登録します。登録済の言葉は読み方を変更します。",
+				Options: []*command.Option{
+					{
+						Name:        "word",
+						Description: fmt.Sprintf("言葉 (%d文字まで)。", maxDictWord),
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    true,
+					},
+					{
+						Name:        "reading",
+						Description: fmt.Sprintf("読み方 (%d文字まで)。", maxDictReading),
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    true,
+					},
+				},
+				Handler: bot.handleMyDictAddCommand,
+			},
+			{
+				Name:        "remove",
+				Description: "言葉を辞書から削除します。",
+				Options: []*command.Option{
+					{
+						Name:         "word",
+						Description:  "削除する言葉。",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Autocomplete: bot.autocompleteMyDictWord,
+						Required:     true,
+					},
+				},
+				Handler: bot.handleMyDictRemoveCommand,
+			},
+			{
+				Name:        "list",
+				Description: "辞書に登録した言葉を表示します。",
+				Handler:     bot.handleMyDictListCommand,
+			},
+		},
+	}
+}
+
+func (bot *Bot) handleMyDictAddCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	word := strings.TrimSpace(req.String("word"))
+	reading := strings.TrimSpace(req.String("reading"))
+	if word == "" || reading == "" ||
+		utf8.RuneCountInString(word) > maxDictWord ||
+		utf8.RuneCountInString(reading) > maxDictReading {
+		return createWarnResponse("辞書に登録できません", fmt.Sprintf("言葉は%d文字以下、読み方は%d文字以下で指定してください。", maxDictWord, maxDictReading))
+	}
+
+	userID := req.UserID()
+	n, err := bot.ent.UserDictEntry.Update().
+		Where(
+			userdictentry.UserID(userID),
+			userdictentry.Word(word),
+		).
+		SetReading(reading).
+		Save(ctx)
+	if err != nil {
+		bot.logger.Error("failed to update user dictionary", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	if n > 0 {
+		return createSuccessResponse("辞書", fmt.Sprintf("「%s」の読み方を「%s」に変更しました。", word, reading))
+	}
+
+	count, err := bot.ent.UserDictEntry.Query().
+		Where(userdictentry.UserID(userID)).
+		Count(ctx)
+	if err != nil {
+		bot.logger.Error("failed to count user dictionary", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	if count >= maxUserDictEntries {
+		return createWarnResponse("辞書に登録できません", fmt.Sprintf("辞書には%d個まで登録できます。", maxUserDictEntries))
+	}
+
+	err = bot.ent.UserDictEntry.Create().
+		SetUserID(userID).
+		SetWord(word).
+		SetReading(reading).
+		Exec(ctx)
+	if err != nil {
+		bot.logger.Error("failed to create user dictionary entry", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	return createSuccessResponse("辞書", fmt.Sprintf("「%s」を「%s」と読むように登録しました。", word, reading))
+}
+
+func (bot *Bot) handleMyDictRemoveCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	word := strings.TrimSpace(req.String("word"))
+
+	n, err := bot.ent.UserDictEntry.Delete().
+		Where(
+			userdictentry.UserID(req.UserID()),
+			userdictentry.Word(word),
+		).
+		Exec(ctx)
+	if err != nil {
+		bot.logger.Error("failed to delete user dictionary entry", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	if n == 0 {
+		return createWarnResponse("登録されていません", fmt.Sprintf("「%s」は辞書に登録されていません。", word))
+	}
+
+	return createSuccessResponse("辞書", fmt.Sprintf("「%s」を辞書から削除しました。", word))
+}
+
+func (bot *Bot) handleMyDictListCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	entries, err := bot.getUserDictEntries(ctx, req.UserID())
+	if err != nil {
+		bot.logger.Error("failed to get user dictionary", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	if len(entries) == 0 {
+		return createInfoResponse("辞書", "辞書に登録された言葉はありません。")
+	}
+
+	lines := make([]string, len(entries))
+	for i, e := range entries {
+		lines[i] = fmt.Sprintf("%s → %s", e.Word, e.Reading)
+	}
+
+	return createInfoResponse("辞書", truncateLines(lines, maxDictListLength))
+}
+
+func (bot *Bot) autocompleteMyDictWord(ctx context.Context, req *command.Request, value string) []*discordgo.ApplicationCommandOptionChoice {
+	entries, err := bot.getUserDictEntries(ctx, req.UserID())
+	if err != nil {
+		bot.logger.Error("failed to get user dictionary", slog.Any("error", err))
+		return nil
+	}
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, e := range entries {
+		if !strings.Contains(e.Word, value) {
+			continue
+		}
+		name := fmt.Sprintf("%s → %s", e.Word, e.Reading)
+		if utf8.RuneCountInString(name) > maxChoiceNameLength {
+			name = e.Word
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  name,
+			Value: e.Word,
+		})
+	}
+
+	return choices
+}
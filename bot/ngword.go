@@ -0,0 +1,388 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/kechako/yomiko/bot/internal/command"
+	"github.com/kechako/yomiko/bot/internal/replacer"
+	"github.com/kechako/yomiko/ent"
+	"github.com/kechako/yomiko/ent/accessrule"
+	"github.com/kechako/yomiko/ent/guildsetting"
+	"github.com/kechako/yomiko/ent/ngword"
+	"github.com/kechako/yomiko/ssml"
+)
+
+var errInvalidNGWord = errors.New("invalid ng word")
+
+const (
+	maxNGWords      = 100
+	maxNGWordLength = 100
+
+	defaultNGWordMode        = guildsetting.NgWordModeBleep
+	defaultNGWordReplacement = "ピー"
+)
+
+var ngWordModes = []struct {
+	mode guildsetting.NgWordMode
+	name string
+}{
+	{guildsetting.NgWordModeBleep, "伏せる"},
+	{guildsetting.NgWordModeReplace, "置き換える"},
+	{guildsetting.NgWordModeDrop, "投稿を読み上げない"},
+}
+
+func ngWordModeName(mode guildsetting.NgWordMode) string {
+	for _, m := range ngWordModes {
+		if m.mode == mode {
+			return m.name
+		}
+	}
+	return string(mode)
+}
+
+// wordFilter finds the NG words of a guild in texts. A nil filter finds
+// nothing.
+type wordFilter struct {
+	re          *regexp.Regexp
+	mode        guildsetting.NgWordMode
+	replacement string
+}
+
+// ngWordPattern returns the regular expression of an NG word. Literals are
+// matched case-insensitively.
+func ngWordPattern(pattern string, regex bool) (string, error) {
+	if !regex {
+		return "(?i:" + regexp.QuoteMeta(pattern) + ")", nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", fmt.Errorf("bot.ngWordPattern: %w: %w", errInvalidNGWord, err)
+	}
+	if re.MatchString("") {
+		return "", fmt.Errorf("bot.ngWordPattern: %w: %q matches an empty text", errInvalidNGWord, pattern)
+	}
+
+	return "(?:" + pattern + ")", nil
+}
+
+// newWordFilter returns the filter of the words, or nil if there is no word.
+func newWordFilter(words []*ent.NGWord, gs *ent.GuildSetting) (*wordFilter, error) {
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	patterns := make([]string, len(words))
+	for i, w := range words {
+		p, err := ngWordPattern(w.Pattern, w.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("bot.newWordFilter: %w", err)
+		}
+		patterns[i] = p
+	}
+
+	re, err := regexp.Compile(strings.Join(patterns, "|"))
+	if err != nil {
+		return nil, fmt.Errorf("bot.newWordFilter: %w", err)
+	}
+
+	f := &wordFilter{
+		re:          re,
+		mode:        defaultNGWordMode,
+		replacement: defaultNGWordReplacement,
+	}
+	if gs != nil {
+		if gs.NgWordMode != nil {
+			f.mode = *gs.NgWordMode
+		}
+		if gs.NgWordReplacement != nil {
+			f.replacement = *gs.NgWordReplacement
+		}
+	}
+
+	return f, nil
+}
+
+// Drops reports whether the message of the text is not read.
+func (f *wordFilter) Drops(text string) bool {
+	return f != nil && f.mode == guildsetting.NgWordModeDrop && f.re.MatchString(text)
+}
+
+// Replace adds the nodes of text to parent like r.Replace, bleeping or
+// replacing the NG words.
+func (f *wordFilter) Replace(parent ssml.ParentNode, r *replacer.Replacer, text string) {
+	if f == nil {
+		r.Replace(parent, text)
+		return
+	}
+
+	start := 0
+	for _, index := range f.re.FindAllStringIndex(text, -1) {
+		if start < index[0] {
+			r.Replace(parent, text[start:index[0]])
+		}
+
+		word := text[index[0]:index[1]]
+		if f.mode == guildsetting.NgWordModeReplace {
+			parent.AddNode(&ssml.Sub{
+				Text:  ssml.Text(word),
+				Alias: f.replacement,
+			})
+		} else {
+			parent.AddNode(&ssml.SayAs{
+				Text:        ssml.Text(word),
+				InterpretAs: ssml.Expletive,
+			})
+		}
+
+		start = index[1]
+	}
+	if start < len(text) {
+		r.Replace(parent, text[start:])
+	}
+}
+
+// getWordFilter returns the filter of the guild, which is cached until the
+// NG words or the mode are changed.
+func (bot *Bot) getWordFilter(ctx context.Context, guildID string) (*wordFilter, error) {
+	bot.filterMu.Lock()
+	defer bot.filterMu.Unlock()
+
+	if f, ok := bot.filters[guildID]; ok {
+		return f, nil
+	}
+
+	words, err := bot.ent.NGWord.Query().
+		Where(ngword.GuildID(guildID)).
+		Order(ent.Asc(ngword.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.getWordFilter: %w", err)
+	}
+
+	gs, err := bot.getGuildSetting(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.getWordFilter: %w", err)
+	}
+
+	f, err := newWordFilter(words, gs)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.getWordFilter: %w", err)
+	}
+	bot.filters[guildID] = f
+
+	return f, nil
+}
+
+func (bot *Bot) invalidateWordFilter(guildID string) {
+	bot.filterMu.Lock()
+	defer bot.filterMu.Unlock()
+	delete(bot.filters, guildID)
+}
+
+func (bot *Bot) ngWordCommand() *command.Command {
+	modeChoices := make([]*discordgo.ApplicationCommandOptionChoice, len(ngWordModes))
+	for i, m := range ngWordModes {
+		modeChoices[i] = &discordgo.ApplicationCommandOptionChoice{
+			Name:  m.name,
+			Value: string(m.mode),
+		}
+	}
+
+	return &command.Command{
+		Name:        "ngword",
+		Description: "読み上げない言葉を設定します。",
+		Subcommands: []*command.Command{
+			{
+				Name:        "add",
+				Description: "NGワードを追加します。",
+				Options: []*command.Option{
+					{
+						Name:        "word",
+						Description: fmt.Sprintf("NGワード (%d文字まで)。", maxNGWordLength),
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    true,
+					},
+					{
+						Name:        "regex",
+						Description: "NGワードを正規表現として扱う場合は True。",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+					},
+				},
+				Handler: bot.withAccess(accessrule.ActionDictionary, bot.handleNGWordAddCommand),
+			},
+			{
+				Name:        "remove",
+				Description: "NGワードを削除します。",
+				Options: []*command.Option{
+					{
+						Name:        "word",
+						Description: "削除するNGワード。",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    true,
+					},
+				},
+				Handler: bot.withAccess(accessrule.ActionDictionary, bot.handleNGWordRemoveCommand),
+			},
+			{
+				Name:        "list",
+				Description: "NGワードを表示します。",
+				Handler:     bot.handleNGWordListCommand,
+			},
+			{
+				Name:        "mode",
+				Description: "NGワードの扱いを設定します。",
+				Options: []*command.Option{
+					{
+						Name:        "mode",
+						Description: "NGワードの扱い。",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Choices:     modeChoices,
+						Required:    true,
+					},
+					{
+						Name:        "replacement",
+						Description: "置き換える言葉。",
+						Type:        discordgo.ApplicationCommandOptionString,
+					},
+				},
+				Handler: bot.withAccess(accessrule.ActionSettings, bot.handleNGWordModeCommand),
+			},
+		},
+	}
+}
+
+func (bot *Bot) handleNGWordAddCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	word := strings.TrimSpace(req.String("word"))
+	regex := req.Bool("regex")
+	if word == "" || utf8.RuneCountInString(word) > maxNGWordLength {
+		return createWarnResponse("NGワードを追加できません", fmt.Sprintf("NGワードは1文字以上%d文字以下で指定してください。", maxNGWordLength))
+	}
+	if _, err := ngWordPattern(word, regex); err != nil {
+		return createWarnResponse("NGワードを追加できません", fmt.Sprintf("「%s」は正しい正規表現ではないか、空の文字列に一致します。", word))
+	}
+
+	n, err := bot.ent.NGWord.Query().
+		Where(ngword.GuildID(req.GuildID())).
+		Count(ctx)
+	if err != nil {
+		bot.logger.Error("failed to count ng words", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	if n >= maxNGWords {
+		return createWarnResponse("NGワードを追加できません", fmt.Sprintf("NGワードは%d個まで登録できます。", maxNGWords))
+	}
+
+	err = bot.ent.NGWord.Create().
+		SetGuildID(req.GuildID()).
+		SetPattern(word).
+		SetRegex(regex).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return createWarnResponse("登録済です", fmt.Sprintf("「%s」は既にNGワードです。", word))
+		}
+		bot.logger.Error("failed to create ng word", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	bot.invalidateWordFilter(req.GuildID())
+
+	return createSuccessResponse("NGワード", fmt.Sprintf("「%s」をNGワードに追加しました。", word))
+}
+
+func (bot *Bot) handleNGWordRemoveCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	word := strings.TrimSpace(req.String("word"))
+
+	n, err := bot.ent.NGWord.Delete().
+		Where(
+			ngword.GuildID(req.GuildID()),
+			ngword.Pattern(word),
+		).
+		Exec(ctx)
+	if err != nil {
+		bot.logger.Error("failed to delete ng word", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	if n == 0 {
+		return createWarnResponse("NGワードではありません", fmt.Sprintf("「%s」はNGワードに登録されていません。", word))
+	}
+	bot.invalidateWordFilter(req.GuildID())
+
+	return createSuccessResponse("NGワード", fmt.Sprintf("「%s」をNGワードから削除しました。", word))
+}
+
+func (bot *Bot) handleNGWordListCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	words, err := bot.ent.NGWord.Query().
+		Where(ngword.GuildID(req.GuildID())).
+		Order(ent.Asc(ngword.FieldID)).
+		All(ctx)
+	if err != nil {
+		bot.logger.Error("failed to get ng words", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	gs, err := bot.getGuildSetting(ctx, req.GuildID())
+	if err != nil {
+		bot.logger.Error("failed to get guild setting", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	mode, replacement := defaultNGWordMode, defaultNGWordReplacement
+	if gs != nil && gs.NgWordMode != nil {
+		mode = *gs.NgWordMode
+	}
+	if gs != nil && gs.NgWordReplacement != nil {
+		replacement = *gs.NgWordReplacement
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "扱い: %s", ngWordModeName(mode))
+	if mode == guildsetting.NgWordModeReplace {
+		fmt.Fprintf(&b, " (「%s」)", replacement)
+	}
+	b.WriteString("\n")
+	if len(words) == 0 {
+		b.WriteString("NGワードはありません。")
+	}
+	for _, w := range words {
+		if w.Regex {
+			fmt.Fprintf(&b, "\n`/%s/`", w.Pattern)
+		} else {
+			fmt.Fprintf(&b, "\n`%s`", w.Pattern)
+		}
+	}
+
+	return createInfoResponse("NGワード", b.String())
+}
+
+func (bot *Bot) handleNGWordModeCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	mode := guildsetting.NgWordMode(req.String("mode"))
+	if err := guildsetting.NgWordModeValidator(mode); err != nil {
+		return createWarnResponse("コマンドの指定が正しくありません", "")
+	}
+	replacement := strings.TrimSpace(req.String("replacement"))
+	if utf8.RuneCountInString(replacement) > maxNGWordLength {
+		return createWarnResponse("コマンドの指定が正しくありません", fmt.Sprintf("置き換える言葉は%d文字以下で指定してください。", maxNGWordLength))
+	}
+
+	_, err := bot.updateGuildSetting(ctx, req.GuildID(), func(m *ent.GuildSettingMutation) {
+		m.SetNgWordMode(mode)
+		if replacement != "" {
+			m.SetNgWordReplacement(replacement)
+		}
+	})
+	if err != nil {
+		bot.logger.Error("failed to update guild setting", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	bot.invalidateWordFilter(req.GuildID())
+
+	return createSuccessResponse("NGワード", fmt.Sprintf("NGワードの扱いを「%s」に設定しました。", ngWordModeName(mode)))
+}
@@ -0,0 +1,322 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/kechako/yomiko/audio/pcm"
+	"github.com/kechako/yomiko/bot/internal/command"
+	"github.com/kechako/yomiko/ent"
+	"github.com/kechako/yomiko/ent/accessrule"
+	"github.com/kechako/yomiko/ent/guildsetting"
+	"gopkg.in/hraban/opus.v2"
+)
+
+var errInvalidOpusOptions = errors.New("invalid opus options")
+
+const (
+	opusApplicationVoIP  opusApplication = "voip"
+	opusApplicationAudio opusApplication = "audio"
+)
+
+const (
+	minOpusBitrate = 6000
+	maxOpusBitrate = 510000
+	// complexity of libopus is from 0 to 10
+	maxOpusComplexity     = 10
+	defaultOpusPacketLoss = 10
+)
+
+type opusApplication string
+
+func (app opusApplication) opus() (opus.Application, error) {
+	switch app {
+	case opusApplicationVoIP:
+		return opus.AppVoIP, nil
+	case opusApplicationAudio:
+		return opus.AppAudio, nil
+	}
+	return 0, fmt.Errorf("%w: unknown application %q", errInvalidOpusOptions, app)
+}
+
+// opusOptions holds the settings of the Opus encoder. Zero bitrate and
+// negative complexity mean the defaults of libopus.
+type opusOptions struct {
+	application opusApplication
+	bitrate     int
+	complexity  int
+	fec         bool
+	packetLoss  int
+	dtx         bool
+}
+
+func defaultOpusOptions() opusOptions {
+	return opusOptions{
+		application: opusApplicationVoIP,
+		complexity:  -1,
+	}
+}
+
+func (o opusOptions) validate() error {
+	if _, err := o.application.opus(); err != nil {
+		return err
+	}
+	if o.bitrate != 0 && (o.bitrate < minOpusBitrate || o.bitrate > maxOpusBitrate) {
+		return fmt.Errorf("%w: bitrate %d is out of range [%d, %d]", errInvalidOpusOptions, o.bitrate, minOpusBitrate, maxOpusBitrate)
+	}
+	if o.complexity > maxOpusComplexity {
+		return fmt.Errorf("%w: complexity %d is out of range [0, %d]", errInvalidOpusOptions, o.complexity, maxOpusComplexity)
+	}
+	if o.packetLoss < 0 || o.packetLoss > 100 {
+		return fmt.Errorf("%w: packet loss %d is out of range [0, 100]", errInvalidOpusOptions, o.packetLoss)
+	}
+	return nil
+}
+
+// withGuildSetting returns the options overridden by the settings of the
+// guild.
+func (o opusOptions) withGuildSetting(gs *ent.GuildSetting) opusOptions {
+	if gs == nil {
+		return o
+	}
+
+	if gs.OpusApplication != nil {
+		o.application = opusApplication(*gs.OpusApplication)
+	}
+	if gs.OpusBitrate != nil {
+		o.bitrate = *gs.OpusBitrate
+	}
+	if gs.OpusComplexity != nil {
+		o.complexity = *gs.OpusComplexity
+	}
+	if gs.OpusFec != nil {
+		o.fec = *gs.OpusFec
+		if !o.fec {
+			// the expected loss is only for FEC
+			o.packetLoss = 0
+		} else if o.packetLoss == 0 {
+			o.packetLoss = defaultOpusPacketLoss
+		}
+	}
+	if gs.OpusDtx != nil {
+		o.dtx = *gs.OpusDtx
+	}
+
+	return o
+}
+
+func newOpusEncoder(format pcm.Format, o opusOptions) (*opus.Encoder, error) {
+	app, err := o.application.opus()
+	if err != nil {
+		return nil, fmt.Errorf("bot.newOpusEncoder: %w", err)
+	}
+
+	enc, err := opus.NewEncoder(format.SampleRate, format.Channels, app)
+	if err != nil {
+		return nil, fmt.Errorf("bot.newOpusEncoder: %w", err)
+	}
+
+	if o.bitrate > 0 {
+		if err := enc.SetBitrate(o.bitrate); err != nil {
+			return nil, fmt.Errorf("bot.newOpusEncoder: bitrate: %w", err)
+		}
+	}
+	if o.complexity >= 0 {
+		if err := enc.SetComplexity(o.complexity); err != nil {
+			return nil, fmt.Errorf("bot.newOpusEncoder: complexity: %w", err)
+		}
+	}
+	if o.fec {
+		if err := enc.SetInBandFEC(true); err != nil {
+			return nil, fmt.Errorf("bot.newOpusEncoder: fec: %w", err)
+		}
+		if err := enc.SetPacketLossPerc(o.packetLoss); err != nil {
+			return nil, fmt.Errorf("bot.newOpusEncoder: packet loss: %w", err)
+		}
+	}
+	if o.dtx {
+		if err := enc.SetDTX(true); err != nil {
+			return nil, fmt.Errorf("bot.newOpusEncoder: dtx: %w", err)
+		}
+	}
+
+	return enc, nil
+}
+
+func (bot *Bot) opusCommand() *command.Command {
+	var (
+		minBitrate    = float64(minOpusBitrate)
+		minComplexity = float64(0)
+	)
+
+	return &command.Command{
+		Name:        "opus",
+		Description: "サーバーでの読子さんの音声の品質を設定します。",
+		Subcommands: []*command.Command{
+			{
+				Name:        "show",
+				Description: "音声の品質の設定を表示します。",
+				Handler:     bot.handleOpusShowCommand,
+			},
+			{
+				Name:        "set",
+				Description: "音声の品質を変更します。",
+				Options: []*command.Option{
+					{
+						Name:        "application",
+						Description: "エンコードのモード。",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Choices: []*discordgo.ApplicationCommandOptionChoice{
+							{Name: "音声 (voip)", Value: string(opusApplicationVoIP)},
+							{Name: "音楽 (audio)", Value: string(opusApplicationAudio)},
+						},
+					},
+					{
+						Name:        "bitrate",
+						Description: "ビットレート (bps)。",
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						MinValue:    &minBitrate,
+						MaxValue:    maxOpusBitrate,
+					},
+					{
+						Name:        "complexity",
+						Description: "エンコードの計算量。",
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						MinValue:    &minComplexity,
+						MaxValue:    maxOpusComplexity,
+					},
+					{
+						Name:        "fec",
+						Description: "パケットロスに備えて誤り訂正の情報を送ります。",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+					},
+					{
+						Name:        "dtx",
+						Description: "無音の間の送信を止めます。",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+					},
+				},
+				Handler: bot.withAccess(accessrule.ActionSettings, bot.handleOpusSetCommand),
+			},
+			{
+				Name:        "reset",
+				Description: "音声の品質の設定を初期値に設定します。",
+				Handler:     bot.withAccess(accessrule.ActionSettings, bot.handleOpusResetCommand),
+			},
+		},
+	}
+}
+
+func (bot *Bot) handleOpusShowCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	gs, err := bot.getGuildSetting(ctx, req.GuildID())
+	if err != nil {
+		bot.logger.Error("failed to get guild setting", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	return createSuccessResponse("音声の品質", bot.opus.withGuildSetting(gs).describe())
+}
+
+func (bot *Bot) handleOpusSetCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	names := []string{"application", "bitrate", "complexity", "fec", "dtx"}
+	if !slices.ContainsFunc(names, req.Has) {
+		return createWarnResponse("設定する項目を指定してください", "")
+	}
+
+	return bot.updateOpusSetting(ctx, req.GuildID(), func(m *ent.GuildSettingMutation) {
+		if req.Has("application") {
+			m.SetOpusApplication(guildsetting.OpusApplication(req.String("application")))
+		}
+		if req.Has("bitrate") {
+			m.SetOpusBitrate(int(req.Int("bitrate")))
+		}
+		if req.Has("complexity") {
+			m.SetOpusComplexity(int(req.Int("complexity")))
+		}
+		if req.Has("fec") {
+			m.SetOpusFec(req.Bool("fec"))
+		}
+		if req.Has("dtx") {
+			m.SetOpusDtx(req.Bool("dtx"))
+		}
+	})
+}
+
+func (bot *Bot) handleOpusResetCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	return bot.updateOpusSetting(ctx, req.GuildID(), func(m *ent.GuildSettingMutation) {
+		m.ClearOpusApplication()
+		m.ClearOpusBitrate()
+		m.ClearOpusComplexity()
+		m.ClearOpusFec()
+		m.ClearOpusDtx()
+	})
+}
+
+// updateOpusSetting updates the setting of the guild and reconfigures the
+// encoder of the session.
+func (bot *Bot) updateOpusSetting(ctx context.Context, guildID string, f func(m *ent.GuildSettingMutation)) *discordgo.InteractionResponse {
+	gs, err := bot.updateGuildSetting(ctx, guildID, f)
+	if err != nil {
+		bot.logger.Error("failed to update guild setting", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	opts := bot.opus.withGuildSetting(gs)
+	if err := bot.setSessionOpusOptions(guildID, opts); err != nil {
+		bot.logger.Error("failed to reconfigure opus encoder", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	return createSuccessResponse("音声の品質", opts.describe())
+}
+
+// setSessionOpusOptions reconfigures the encoder of the session in the
+// guild, if any.
+func (bot *Bot) setSessionOpusOptions(guildID string, opts opusOptions) error {
+	ys, ok := bot.getSession(guildID)
+	if !ok {
+		return nil
+	}
+
+	return ys.SetOpusOptions(opts)
+}
+
+func (o opusOptions) describe() string {
+	var b strings.Builder
+
+	switch o.application {
+	case opusApplicationAudio:
+		b.WriteString("モード: 音楽 (audio)\n")
+	default:
+		b.WriteString("モード: 音声 (voip)\n")
+	}
+
+	if o.bitrate > 0 {
+		fmt.Fprintf(&b, "ビットレート: %d bps\n", o.bitrate)
+	} else {
+		b.WriteString("ビットレート: 自動\n")
+	}
+
+	if o.complexity >= 0 {
+		fmt.Fprintf(&b, "計算量: %d\n", o.complexity)
+	} else {
+		b.WriteString("計算量: 既定\n")
+	}
+
+	fmt.Fprintf(&b, "FEC: %s\n", onOff(o.fec))
+	fmt.Fprintf(&b, "DTX: %s", onOff(o.dtx))
+
+	return b.String()
+}
+
+func onOff(b bool) string {
+	if b {
+		return "有効"
+	}
+	return "無効"
+}
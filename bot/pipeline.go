@@ -0,0 +1,140 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kechako/yomiko/audio/pcm"
+)
+
+const (
+	// duration of the audio read from the TTS stream at a time
+	pipelineChunkMs = 100
+	// duration of the audio measured before the loudness normalization
+	// starts; it is the latency added to the first audio
+	loudnessWindow = 400 * time.Millisecond
+	// number of frames buffered between the pipeline and the encoder
+	pipelineBufferFrames = 50
+)
+
+// audioPipeline converts a stream of audio from the TTS engine into frames
+// to be encoded, processing the audio as it arrives.
+type audioPipeline struct {
+	from pcm.Format
+	to   pcm.Format
+
+	conv       *pcm.Converter[int16]
+	trimmer    *pcm.SilenceTrimmer[int16]
+	normalizer *pcm.LoudnessNormalizer[int16]
+
+	// samples not yet sent as a frame
+	pending []int16
+}
+
+func newAudioPipeline(from, to pcm.Format, loudness *pcm.LoudnessOptions, silence silenceOptions) (*audioPipeline, error) {
+	if err := from.Validate(); err != nil {
+		return nil, fmt.Errorf("bot.newAudioPipeline: %w", err)
+	}
+
+	conv, err := pcm.NewConverter[int16](from, to)
+	if err != nil {
+		return nil, fmt.Errorf("bot.newAudioPipeline: %w", err)
+	}
+
+	p := &audioPipeline{
+		from: from,
+		to:   to,
+		conv: conv,
+	}
+
+	if silence.trim {
+		p.trimmer = pcm.NewSilenceTrimmer[int16](to, silence.threshold, silence.padding)
+	}
+	if loudness != nil {
+		p.normalizer, err = pcm.NewLoudnessNormalizer[int16](to, *loudness, loudnessWindow)
+		if err != nil {
+			return nil, fmt.Errorf("bot.newAudioPipeline: %w", err)
+		}
+	}
+
+	return p, nil
+}
+
+// run reads the audio from r and sends frames of frameSize to frames until
+// the end of r. frames is closed when run returns.
+func (p *audioPipeline) run(ctx context.Context, r io.Reader, frames chan<- []int16) error {
+	defer close(frames)
+
+	frameBytes := p.from.FrameSize()
+	buf := make([]byte, p.from.FramesToBytes(p.from.SampleRate*pipelineChunkMs/1000))
+	// bytes of an incomplete frame at the head of buf
+	var rest int
+
+	for {
+		n, err := r.Read(buf[rest:])
+		n += rest
+		complete := n - n%frameBytes
+
+		samples := pcm.DecodeAs[int16](nil, buf[:complete], p.from.SampleType, pcm.LittleEndian)
+		converted, convErr := p.conv.Convert(nil, samples)
+		if convErr != nil {
+			return fmt.Errorf("bot.audioPipeline.run: %w", convErr)
+		}
+		if sendErr := p.send(ctx, p.process(converted, false), frames, false); sendErr != nil {
+			return sendErr
+		}
+		rest = copy(buf, buf[complete:n])
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("bot.audioPipeline.run: %w", err)
+		}
+	}
+
+	return p.send(ctx, p.process(p.conv.Flush(nil), true), frames, true)
+}
+
+func (p *audioPipeline) process(samples []int16, flush bool) []int16 {
+	if p.trimmer != nil {
+		samples = p.trimmer.Trim(nil, samples)
+		if flush {
+			samples = p.trimmer.Flush(samples)
+		}
+	}
+
+	if p.normalizer != nil {
+		samples = p.normalizer.Normalize(nil, samples)
+		if flush {
+			samples = p.normalizer.Flush(samples)
+		}
+	}
+
+	return samples
+}
+
+// send splits samples into frames and sends them. The last incomplete frame
+// is held back until flush, when it is padded with silence.
+func (p *audioPipeline) send(ctx context.Context, samples []int16, frames chan<- []int16, flush bool) error {
+	p.pending = append(p.pending, samples...)
+
+	size := frameSize * p.to.Channels
+	var i int
+	for ; i+size <= len(p.pending) || (flush && i < len(p.pending)); i += size {
+		frame := make([]int16, size)
+		copy(frame, p.pending[i:])
+
+		select {
+		case frames <- frame:
+		case <-ctx.Done():
+			return fmt.Errorf("bot.audioPipeline.send: %w", ctx.Err())
+		}
+	}
+	p.pending = p.pending[:copy(p.pending, p.pending[min(i, len(p.pending)):])]
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"unicode/utf8"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/kechako/yomiko/audio/pcm"
+	"github.com/kechako/yomiko/bot/internal/command"
+	"github.com/kechako/yomiko/bot/internal/replacer"
+	"github.com/kechako/yomiko/ssml"
+	"github.com/kechako/yomiko/tts"
+)
+
+const (
+	defaultPreviewText = "こんにちは、読子です。この声で読み上げます。"
+	// the audio is synthesized before responding to the interaction
+	maxPreviewLength = 100
+	previewFileName  = "preview.wav"
+)
+
+func (bot *Bot) previewCommand() *command.Command {
+	return &command.Command{
+		Name:        "preview",
+		Description: "読子さんの声を試聴します。",
+		Options: []*command.Option{
+			{
+				Name:         "voice",
+				Description:  "試聴する声。",
+				Type:         discordgo.ApplicationCommandOptionString,
+				Autocomplete: bot.autocompleteVoice,
+				Required:     true,
+			},
+			{
+				Name:        "text",
+				Description: fmt.Sprintf("読み上げる文章 (%d文字まで)。", maxPreviewLength),
+				Type:        discordgo.ApplicationCommandOptionString,
+			},
+		},
+		Handler: bot.handlePreviewCommand,
+		// the synthesis can take longer than Discord waits, and the file is
+		// replied only to the user
+		Deferred:  true,
+		Ephemeral: true,
+	}
+}
+
+// handlePreviewCommand reads the text in the voice channel if joined, or
+// replies the audio file only to the user.
+func (bot *Bot) handlePreviewCommand(ctx context.Context, req *command.Request) *discordgo.InteractionResponse {
+	voice, err := bot.voices.Find(ctx, req.String("voice"))
+	if err != nil {
+		bot.logger.Error("failed to list voices", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	if voice == nil {
+		return createWarnResponse("声が見つかりません", fmt.Sprintf("「%s」という声はありません。候補から選んでください。", req.String("voice")))
+	}
+
+	text := req.String("text")
+	if text == "" {
+		text = defaultPreviewText
+	}
+	if utf8.RuneCountInString(text) > maxPreviewLength {
+		return createWarnResponse("文章が長すぎます", fmt.Sprintf("試聴できる文章は%d文字までです。", maxPreviewLength))
+	}
+
+	// the speaking rate and the pitch of the user
+	opts, err := bot.userSpeechOptions(ctx, req.UserID())
+	if err != nil {
+		bot.logger.Error("failed to get user voice setting", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	opts = append(opts, tts.WithVoiceName(voice.GetName()))
+
+	r, err := bot.userReplacer(ctx, req.GuildID(), req.UserID())
+	if err != nil {
+		bot.logger.Error("failed to get user dictionary", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+	doc := makePreviewSSML(r, text)
+
+	if ys, ok := bot.getSession(req.GuildID()); ok {
+		go func() {
+			if err := ys.Read(context.Background(), doc, opts...); err != nil {
+				bot.logger.Error("yomiko failed to read preview", slog.Any("error", err))
+			}
+		}()
+		return createSuccessResponse("試聴", fmt.Sprintf("「%s」で読み上げます。", voice.GetName()))
+	}
+
+	wav, err := bot.synthesizeWAV(ctx, doc, opts...)
+	if err != nil {
+		bot.logger.Error("failed to synthesize preview", slog.Any("error", err))
+		return createErrorResponse("エラーが発生しました！", "")
+	}
+
+	res := createSuccessResponse("試聴", fmt.Sprintf("「%s」の声です。", voice.GetName()))
+	res.Data.Files = []*discordgo.File{
+		{
+			Name:        previewFileName,
+			ContentType: "audio/wav",
+			Reader:      wav,
+		},
+	}
+
+	return res
+}
+
+func makePreviewSSML(r *replacer.Replacer, text string) *ssml.SSML {
+	root := ssml.New()
+
+	sentence := &ssml.Sentence{}
+	r.Replace(sentence, text)
+	root.AddNode(&ssml.Paragraph{
+		Nodes: []ssml.Node{
+			sentence,
+		},
+	})
+
+	return root
+}
+
+// synthesizeWAV synthesizes doc into a WAV file in memory.
+func (bot *Bot) synthesizeWAV(ctx context.Context, doc *ssml.SSML, opts ...tts.SynthesizeSpeechOption) (*bytes.Buffer, error) {
+	stream, err := bot.tts.SynthesizeSpeechStream(ctx, doc, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.synthesizeWAV: %w", err)
+	}
+
+	audio, err := tts.ReadAudio(stream)
+	if err != nil {
+		return nil, fmt.Errorf("bot.Bot.synthesizeWAV: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := pcm.WriteWAV(&buf, audio.Format.WAVInfo(), audio.Data); err != nil {
+		return nil, fmt.Errorf("bot.Bot.synthesizeWAV: %w", err)
+	}
+
+	return &buf, nil
+}
@@ -0,0 +1,260 @@
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kechako/yomiko/audio/ogg"
+	"github.com/kechako/yomiko/audio/pcm"
+)
+
+const (
+	recordingExt = ".opus"
+	// interval to remove the recordings older than the retention
+	recordingPruneInterval = time.Hour
+	// maximum number of the files started in the same second
+	maxRecordingSuffix = 100
+	// packets waiting to be written, about 10 seconds of 20ms frames
+	recordingBufferPackets = 500
+)
+
+type recordingOptions struct {
+	dir string
+	// maximum duration of a file
+	rotate time.Duration
+	// zero keeps the recordings forever
+	retention time.Duration
+}
+
+// sessionRecorder saves the Opus packets sent in a session to Ogg Opus files
+// in a directory per guild. A file is rotated after the rotation period.
+// Silence between utterances is not recorded, because no packets are sent.
+// The files are written in a goroutine, so that the disk never delays
+// sending the packets.
+type sessionRecorder struct {
+	opts   recordingOptions
+	format pcm.Format
+	logger *slog.Logger
+
+	guildID  string
+	comments []string
+
+	packets chan []byte
+	done    chan struct{}
+	// packets dropped since the last log
+	dropped int
+
+	file    *os.File
+	w       *ogg.OpusWriter
+	started time.Time
+}
+
+func newSessionRecorder(opts recordingOptions, format pcm.Format, logger *slog.Logger, guildID, textChannelID, voiceChannelID string) *sessionRecorder {
+	r := &sessionRecorder{
+		opts:    opts,
+		format:  format,
+		logger:  logger.With(slog.String("guild_id", guildID)),
+		guildID: guildID,
+		comments: []string{
+			"GUILD_ID=" + guildID,
+			"TEXT_CHANNEL_ID=" + textChannelID,
+			"VOICE_CHANNEL_ID=" + voiceChannelID,
+		},
+		packets: make(chan []byte, recordingBufferPackets),
+		done:    make(chan struct{}),
+	}
+	go r.run()
+
+	return r
+}
+
+// WritePacket queues a packet sent to Discord without blocking. The packet
+// is dropped if the queue is full, and p must not be modified after the
+// call. WritePacket and Close must not be called concurrently.
+func (r *sessionRecorder) WritePacket(p []byte) {
+	select {
+	case r.packets <- p:
+		if r.dropped > 0 {
+			r.logger.Warn("dropped recording packets", slog.Int("count", r.dropped))
+			r.dropped = 0
+		}
+	default:
+		r.dropped++
+	}
+}
+
+func (r *sessionRecorder) run() {
+	defer close(r.done)
+
+	for p := range r.packets {
+		r.writePacket(p)
+	}
+}
+
+// writePacket saves a packet. Errors are logged instead of being returned,
+// so that a failure of the recording never stops reading.
+func (r *sessionRecorder) writePacket(p []byte) {
+	now := time.Now()
+
+	if r.w != nil && now.Sub(r.started) >= r.opts.rotate {
+		if err := r.closeFile(); err != nil {
+			r.logger.Error("failed to close recording", slog.Any("error", err))
+		}
+	}
+
+	if r.w == nil {
+		if err := r.openFile(now); err != nil {
+			r.logger.Error("failed to open recording", slog.Any("error", err))
+			return
+		}
+	}
+
+	if err := r.w.WritePacket(p); err != nil {
+		r.logger.Error("failed to write recording", slog.Any("error", err))
+		// start a new file with the next packet
+		if err := r.closeFile(); err != nil {
+			r.logger.Error("failed to close recording", slog.Any("error", err))
+		}
+	}
+}
+
+// Close writes the queued packets and closes the file.
+func (r *sessionRecorder) Close() error {
+	close(r.packets)
+	<-r.done
+
+	if r.dropped > 0 {
+		r.logger.Warn("dropped recording packets", slog.Int("count", r.dropped))
+	}
+
+	if r.w == nil {
+		return nil
+	}
+
+	if err := r.closeFile(); err != nil {
+		return fmt.Errorf("bot.sessionRecorder.Close: %w", err)
+	}
+
+	return nil
+}
+
+func (r *sessionRecorder) openFile(now time.Time) error {
+	dir := filepath.Join(r.opts.dir, r.guildID)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("bot.sessionRecorder.openFile: %w", err)
+	}
+
+	file, err := createRecordingFile(dir, now)
+	if err != nil {
+		return fmt.Errorf("bot.sessionRecorder.openFile: %w", err)
+	}
+
+	head := &ogg.OpusHead{
+		Channels:        r.format.Channels,
+		PreSkip:         ogg.DefaultOpusPreSkip,
+		InputSampleRate: r.format.SampleRate,
+	}
+	tags := &ogg.OpusTags{
+		Vendor:   "yomiko",
+		Comments: slices.Concat(r.comments, []string{"DATE=" + now.Format(time.RFC3339)}),
+	}
+	w, err := ogg.NewOpusWriter(file, head, tags)
+	if err != nil {
+		return errors.Join(fmt.Errorf("bot.sessionRecorder.openFile: %w", err), file.Close())
+	}
+
+	r.file = file
+	r.w = w
+	r.started = now
+
+	return nil
+}
+
+func (r *sessionRecorder) closeFile() error {
+	err := errors.Join(r.w.Close(), r.file.Close())
+	r.file = nil
+	r.w = nil
+
+	if err != nil {
+		return fmt.Errorf("bot.sessionRecorder.closeFile: %w", err)
+	}
+
+	return nil
+}
+
+// createRecordingFile creates a new file named after the time. A suffix is
+// added if a file started in the same second exists, so that a recording is
+// never overwritten.
+func createRecordingFile(dir string, now time.Time) (*os.File, error) {
+	base := now.Format("20060102-150405")
+
+	for i := range maxRecordingSuffix {
+		name := base
+		if i > 0 {
+			name += fmt.Sprintf("-%d", i)
+		}
+
+		file, err := os.OpenFile(filepath.Join(dir, name+recordingExt), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o640)
+		if errors.Is(err, fs.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("bot.createRecordingFile: %w", err)
+		}
+
+		return file, nil
+	}
+
+	return nil, fmt.Errorf("bot.createRecordingFile: too many recordings started at %s", base)
+}
+
+// pruneRecordings removes the recordings modified before the time.
+func pruneRecordings(dir string, before time.Time) error {
+	var errs []error
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), recordingExt) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !info.ModTime().Before(before) {
+			return nil
+		}
+
+		// another session may remove it at the same time
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			errs = append(errs, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("bot.pruneRecordings: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// SyncCommands overwrites the slash commands registered to Discord with the
+// commands of cfg, without starting the bot.
+func SyncCommands(ctx context.Context, cfg *Config) error {
+	bot, err := newCommandBot(cfg)
+	if err != nil {
+		return fmt.Errorf("bot.SyncCommands: %w", err)
+	}
+
+	if err := bot.syncCommands(ctx); err != nil {
+		return fmt.Errorf("bot.SyncCommands: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteCommands deletes all the slash commands registered to the guilds.
+// An empty ID means the global commands. The guilds of cfg are used if no
+// guild is specified.
+func DeleteCommands(ctx context.Context, cfg *Config, guildIDs ...string) error {
+	bot, err := newCommandBot(cfg)
+	if err != nil {
+		return fmt.Errorf("bot.DeleteCommands: %w", err)
+	}
+
+	if len(guildIDs) == 0 {
+		guildIDs = cfg.commandGuildIDs()
+	}
+
+	if err := bot.deleteCommands(ctx, guildIDs); err != nil {
+		return fmt.Errorf("bot.DeleteCommands: %w", err)
+	}
+
+	return nil
+}
+
+// newCommandBot returns a Bot only for building the commands. The TTS engine
+// and the database are not available.
+func newCommandBot(cfg *Config) (*Bot, error) {
+	s, err := discordgo.New("Bot " + cfg.Token)
+	if err != nil {
+		return nil, fmt.Errorf("bot.newCommandBot: %w", err)
+	}
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	bot, err := newBot(cfg, discordgoSession{s}, nil, nil, logger)
+	if err != nil {
+		return nil, fmt.Errorf("bot.newCommandBot: %w", err)
+	}
+
+	return bot, nil
+}
+
+// syncCommands overwrites the commands in the guilds of the config, so
+// that the commands removed from the bot are also removed from Discord.
+func (bot *Bot) syncCommands(ctx context.Context) error {
+	commands, err := bot.router.ApplicationCommands(ctx)
+	if err != nil {
+		return fmt.Errorf("bot.Bot.syncCommands: %w", err)
+	}
+
+	if err := bot.overwriteCommands(ctx, bot.cfg.commandGuildIDs(), commands); err != nil {
+		return fmt.Errorf("bot.Bot.syncCommands: %w", err)
+	}
+
+	return nil
+}
+
+func (bot *Bot) deleteCommands(ctx context.Context, guildIDs []string) error {
+	// nil is sent as null, which Discord rejects
+	if err := bot.overwriteCommands(ctx, guildIDs, []*discordgo.ApplicationCommand{}); err != nil {
+		return fmt.Errorf("bot.Bot.deleteCommands: %w", err)
+	}
+
+	return nil
+}
+
+func (bot *Bot) overwriteCommands(ctx context.Context, guildIDs []string, commands []*discordgo.ApplicationCommand) error {
+	appID, err := bot.s.ApplicationID(ctx)
+	if err != nil {
+		return fmt.Errorf("bot.Bot.overwriteCommands: %w", err)
+	}
+
+	var errs []error
+	for _, guildID := range guildIDs {
+		if _, err := bot.s.ApplicationCommandBulkOverwrite(appID, guildID, commands, discordgo.WithContext(ctx)); err != nil {
+			errs = append(errs, fmt.Errorf("guild %q: %w", guildID, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("bot.Bot.overwriteCommands: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/texttospeech/apiv1/texttospeechpb"
+	"github.com/bwmarrin/discordgo"
+	"github.com/kechako/yomiko/bot/internal/command"
+	"github.com/kechako/yomiko/tts"
+)
+
+// voices are listed again after the TTL
+const voiceCatalogTTL = time.Hour
+
+// voiceCatalog caches the voices of the TTS engine, so that autocomplete
+// responds within the time limit of Discord.
+type voiceCatalog struct {
+	engine tts.Engine
+
+	mu      sync.Mutex
+	voices  []*tts.Voice
+	fetched time.Time
+}
+
+func newVoiceCatalog(engine tts.Engine) *voiceCatalog {
+	return &voiceCatalog{
+		engine: engine,
+	}
+}
+
+// Voices returns the voices sorted by name. The cached voices are returned
+// if listing fails after they are expired.
+func (c *voiceCatalog) Voices(ctx context.Context) ([]*tts.Voice, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.voices != nil && time.Since(c.fetched) < voiceCatalogTTL {
+		return c.voices, nil
+	}
+
+	voices, err := c.engine.ListVoices(ctx)
+	if err != nil {
+		if c.voices != nil {
+			return c.voices, nil
+		}
+		return nil, fmt.Errorf("bot.voiceCatalog.Voices: %w", err)
+	}
+
+	voices = slices.Clone(voices)
+	slices.SortFunc(voices, func(a, b *tts.Voice) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+	c.voices = voices
+	c.fetched = time.Now()
+
+	return voices, nil
+}
+
+// Find returns the voice of the name, or nil if not found.
+func (c *voiceCatalog) Find(ctx context.Context, name string) (*tts.Voice, error) {
+	voices, err := c.Voices(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("bot.voiceCatalog.Find: %w", err)
+	}
+
+	i := slices.IndexFunc(voices, func(v *tts.Voice) bool {
+		return v.GetName() == name
+	})
+	if i < 0 {
+		return nil, nil
+	}
+
+	return voices[i], nil
+}
+
+// voiceFilter selects voices. Every word of the query matches a part of the
+// name, the gender or the family of a voice.
+type voiceFilter struct {
+	query string
+	// lower case name of the gender like "female"
+	gender string
+	family tts.VoiceFamily
+}
+
+func (f *voiceFilter) match(v *tts.Voice) bool {
+	gender := genderName(v.GetSsmlGender())
+	family := tts.ParseVoiceFamily(v.GetName())
+
+	if f.gender != "" && f.gender != strings.ToLower(v.GetSsmlGender().String()) {
+		return false
+	}
+	if f.family != "" && f.family != family {
+		return false
+	}
+
+	name := strings.ToLower(v.GetName())
+	for _, word := range strings.Fields(strings.ToLower(f.query)) {
+		if strings.Contains(name, word) ||
+			word == gender ||
+			word == strings.ToLower(v.GetSsmlGender().String()) ||
+			family != tts.FamilyUnknown && word == strings.ToLower(string(family)) {
+			continue
+		}
+		return false
+	}
+
+	return true
+}
+
+func genderName(gender texttospeechpb.SsmlVoiceGender) string {
+	switch gender {
+	case tts.GenderMale:
+		return "男性"
+	case tts.GenderFemale:
+		return "女性"
+	case tts.GenderNeutral:
+		return "中性"
+	}
+	return ""
+}
+
+func voiceChoiceName(v *tts.Voice) string {
+	attrs := []string{genderName(v.GetSsmlGender())}
+	if family := tts.ParseVoiceFamily(v.GetName()); family != tts.FamilyUnknown {
+		attrs = append(attrs, string(family))
+	}
+	attrs = slices.DeleteFunc(attrs, func(s string) bool { return s == "" })
+
+	if len(attrs) == 0 {
+		return v.GetName()
+	}
+	return fmt.Sprintf("%s (%s)", v.GetName(), strings.Join(attrs, ", "))
+}
+
+func (bot *Bot) autocompleteVoice(ctx context.Context, req *command.Request, value string) []*discordgo.ApplicationCommandOptionChoice {
+	voices, err := bot.voices.Voices(ctx)
+	if err != nil {
+		bot.logger.Error("failed to list voices", slog.Any("error", err))
+		return nil
+	}
+
+	f := &voiceFilter{
+		query:  value,
+		gender: req.String("gender"),
+		family: tts.VoiceFamily(req.String("engine")),
+	}
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, v := range voices {
+		if !f.match(v) {
+			continue
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  voiceChoiceName(v),
+			Value: v.GetName(),
+		})
+	}
+
+	return choices
+}
+
+func genderChoices() []*discordgo.ApplicationCommandOptionChoice {
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, g := range []texttospeechpb.SsmlVoiceGender{tts.GenderFemale, tts.GenderMale, tts.GenderNeutral} {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  genderName(g),
+			Value: strings.ToLower(g.String()),
+		})
+	}
+	return choices
+}
+
+func familyChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, len(tts.VoiceFamilies))
+	for i, family := range tts.VoiceFamilies {
+		choices[i] = &discordgo.ApplicationCommandOptionChoice{
+			Name:  string(family),
+			Value: string(family),
+		}
+	}
+	return choices
+}
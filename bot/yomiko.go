@@ -2,11 +2,14 @@ package bot
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
-	"github.com/bwmarrin/discordgo"
 	"github.com/kechako/yomiko/audio/pcm"
+	"github.com/kechako/yomiko/ssml"
 	"github.com/kechako/yomiko/tts"
 	"gopkg.in/hraban/opus.v2"
 )
@@ -14,21 +17,49 @@ import (
 const frameSizeMs = 20
 const frameSize = SampleRate * frameSizeMs / 1000
 
+// outputFormat is the format of the audio encoded and sent to Discord.
+var outputFormat = pcm.Format{
+	SampleRate: SampleRate,
+	Channels:   1,
+	SampleType: pcm.SampleTypeInt16,
+}
+
 type yomikoSession struct {
-	s    *discordgo.Session
-	conn *discordgo.VoiceConnection
+	s    discordSession
+	conn voiceConnection
 	mu   sync.Mutex
 
-	tts *tts.Client
+	tts tts.Engine
 	enc *opus.Encoder
+	// encoder replacing enc from the next utterance
+	nextEnc  atomic.Pointer[opus.Encoder]
+	format   pcm.Format
+	loudness *pcm.LoudnessOptions
+	silence  silenceOptions
+	// nil if the recording is disabled
+	recorder *sessionRecorder
+
+	// time when the last utterance was sent
+	lastSent time.Time
+	speaking bool
+
+	skipMu sync.Mutex
+	// cancels the utterance being sent
+	skip context.CancelCauseFunc
+
+	bgmMu sync.Mutex
+	bgm   *bgmPlayer
+	// volume of the BGM in percent
+	bgmVolume atomic.Int32
+	mixed     []int16
 
 	guildID        string
 	textChannelID  string
 	voiceChannelID string
 }
 
-func newYomikoSession(s *discordgo.Session, ttsClient *tts.Client, guildID, textChannelID, voiceChannelID string) (*yomikoSession, error) {
-	enc, err := opus.NewEncoder(SampleRate, 1, opus.AppVoIP)
+func newYomikoSession(s discordSession, ttsEngine tts.Engine, cfg *Config, opusOpts opusOptions, recorder *sessionRecorder, guildID, textChannelID, voiceChannelID string) (*yomikoSession, error) {
+	enc, err := newOpusEncoder(outputFormat, opusOpts)
 	if err != nil {
 		return nil, fmt.Errorf("bot.newYomikoSession: %w", err)
 	}
@@ -41,8 +72,12 @@ func newYomikoSession(s *discordgo.Session, ttsClient *tts.Client, guildID, text
 	return &yomikoSession{
 		s:              s,
 		conn:           conn,
-		tts:            ttsClient,
+		tts:            ttsEngine,
 		enc:            enc,
+		format:         outputFormat,
+		loudness:       cfg.loudnessOptions(),
+		silence:        cfg.silenceOptions(),
+		recorder:       recorder,
 		guildID:        guildID,
 		textChannelID:  textChannelID,
 		voiceChannelID: voiceChannelID,
@@ -50,6 +85,9 @@ func newYomikoSession(s *discordgo.Session, ttsClient *tts.Client, guildID, text
 }
 
 func (s *yomikoSession) Close() error {
+	// the BGM holds s.mu while sending a frame
+	s.StopBGM()
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.conn == nil {
@@ -62,6 +100,25 @@ func (s *yomikoSession) Close() error {
 	}
 	s.conn = nil
 
+	if s.recorder != nil {
+		if err := s.recorder.Close(); err != nil {
+			return fmt.Errorf("bot.yomikoSession.Close: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// SetOpusOptions replaces the encoder with the one of the options from the
+// next utterance, without waiting for the current utterance.
+func (s *yomikoSession) SetOpusOptions(opts opusOptions) error {
+	enc, err := newOpusEncoder(s.format, opts)
+	if err != nil {
+		return fmt.Errorf("bot.yomikoSession.SetOpusOptions: %w", err)
+	}
+
+	s.nextEnc.Store(enc)
+
 	return nil
 }
 
@@ -77,11 +134,39 @@ func (s *yomikoSession) VoiceChannelID() string {
 	return s.voiceChannelID
 }
 
-func (s *yomikoSession) Read(ctx context.Context, ssml string, opts ...tts.SynthesizeSpeechOption) error {
-	p, err := s.tts.SynthesizeSpeech(ctx, ssml, opts...)
+// Read synthesizes doc and plays it. The playback starts as soon as the
+// first frame is ready, while the rest is still being synthesized.
+func (s *yomikoSession) Read(ctx context.Context, doc *ssml.SSML, opts ...tts.SynthesizeSpeechOption) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	stream, err := s.tts.SynthesizeSpeechStream(ctx, doc, opts...)
 	if err != nil {
 		return fmt.Errorf("bot.yomikoSession.Read: %w", err)
 	}
+	defer stream.Close()
+
+	pipeline, err := newAudioPipeline(stream.Format, s.format, s.loudness, s.silence)
+	if err != nil {
+		return fmt.Errorf("bot.yomikoSession.Read: %w", err)
+	}
+
+	frames := make(chan []int16, pipelineBufferFrames)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- pipeline.run(ctx, stream, frames)
+	}()
+
+	// wait for the first frame without blocking the other utterances and
+	// the BGM
+	first, ok := <-frames
+	if !ok {
+		if err := <-errc; err != nil {
+			return fmt.Errorf("bot.yomikoSession.Read: %w", err)
+		}
+		// no sound
+		return nil
+	}
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -89,45 +174,140 @@ func (s *yomikoSession) Read(ctx context.Context, ssml string, opts ...tts.Synth
 		return nil
 	}
 
-	s.conn.Speaking(true)
+	s.setSkip(cancel)
+	defer s.setSkip(nil)
 
-	err = s.splitFrames(p, func(data []int16) error {
-		var buf [1276]byte
-		n, err := s.enc.Encode(data, buf[:])
+	s.swapEncoder()
+	s.setSpeaking(true)
+	// also after an error, so that the bot is not left speaking
+	defer func() {
+		// the BGM keeps speaking
+		if s.currentBGM() == nil {
+			s.setSpeaking(false)
+		}
+		s.lastSent = time.Now()
+	}()
+
+	// keep the gap after the previous utterance
+	if gap := s.gapFrames(); gap > 0 {
+		err = splitFrames(make([]int16, gap*s.format.Channels), s.format.Channels, func(data []int16) error {
+			return s.sendFrame(data, s.currentBGM(), nil)
+		})
 		if err != nil {
 			return fmt.Errorf("bot.yomikoSession.Read: %w", err)
 		}
+	}
+
+	if err := s.sendFrame(first, s.currentBGM(), nil); err != nil {
+		return fmt.Errorf("bot.yomikoSession.Read: %w", err)
+	}
+	for frame := range frames {
+		if ctx.Err() != nil {
+			// the pipeline stops by ctx
+			break
+		}
+		if err := s.sendFrame(frame, s.currentBGM(), nil); err != nil {
+			return fmt.Errorf("bot.yomikoSession.Read: %w", err)
+		}
+	}
+	if err := <-errc; err != nil && !errors.Is(context.Cause(ctx), errYomikoSkipped) {
+		return fmt.Errorf("bot.yomikoSession.Read: %w", err)
+	}
 
-		s.conn.OpusSend <- buf[:n]
+	return nil
+}
 
-		return nil
-	})
+// Skip stops the utterance being read, and reports whether there is one.
+func (s *yomikoSession) Skip() bool {
+	s.skipMu.Lock()
+	defer s.skipMu.Unlock()
+
+	if s.skip == nil {
+		return false
+	}
+	s.skip(errYomikoSkipped)
+	s.skip = nil
+
+	return true
+}
+
+func (s *yomikoSession) setSkip(skip context.CancelCauseFunc) {
+	s.skipMu.Lock()
+	defer s.skipMu.Unlock()
+	s.skip = skip
+}
+
+// swapEncoder replaces the encoder set by SetOpusOptions. It must be called
+// with s.mu held.
+func (s *yomikoSession) swapEncoder() {
+	if enc := s.nextEnc.Swap(nil); enc != nil {
+		s.enc = enc
+	}
+}
+
+// setSpeaking updates the speaking state if changed. It must be called with
+// s.mu held.
+func (s *yomikoSession) setSpeaking(speaking bool) {
+	if s.speaking == speaking {
+		return
+	}
+	s.conn.Speaking(speaking)
+	s.speaking = speaking
+}
+
+// sendFrame encodes and sends a frame mixed with the BGM if playing. A nil
+// frame sends the BGM alone. Sending is canceled when stop is closed. It
+// must be called with s.mu held.
+func (s *yomikoSession) sendFrame(frame []int16, bgm *bgmPlayer, stop <-chan struct{}) error {
+	if bgm != nil {
+		s.mixed = bgm.mix(s.mixed[:0], frame, int(s.bgmVolume.Load()))
+		frame = s.mixed
+	}
+
+	var buf [1276]byte
+	n, err := s.enc.Encode(frame, buf[:])
 	if err != nil {
-		return err
+		return fmt.Errorf("bot.yomikoSession.sendFrame: %w", err)
 	}
 
-	s.conn.Speaking(false)
+	select {
+	case s.conn.OpusSend() <- buf[:n]:
+	case <-stop:
+		return nil
+	}
+
+	if s.recorder != nil {
+		s.recorder.WritePacket(buf[:n])
+	}
 
 	return nil
 }
 
-func (s *yomikoSession) splitFrames(p []byte, f func(data []int16) error) error {
-	var data [frameSize]int16
+// gapFrames returns the number of silent frames to be sent before the next
+// utterance.
+func (s *yomikoSession) gapFrames() int {
+	if s.lastSent.IsZero() || s.silence.gap <= 0 {
+		return 0
+	}
 
-	n := pcm.SamplesToBytes[int16](frameSize)
+	remaining := s.silence.gap - time.Since(s.lastSent)
+	if remaining <= 0 {
+		return 0
+	}
 
-	for i := 0; i < len(p); i += n {
-		tail := min(len(p), i+n)
-		n, err := pcm.Decode(data[:], p[i:tail], pcm.LittleEndian)
-		if err != nil {
-			return err
-		}
-		copied := pcm.BytesToSamples[int16](n)
+	return int(int64(s.format.SampleRate) * int64(remaining) / int64(time.Second))
+}
+
+func splitFrames(samples []int16, channels int, f func(data []int16) error) error {
+	data := make([]int16, frameSize*channels)
+
+	for i := 0; i < len(samples); i += len(data) {
+		copied := copy(data, samples[i:])
 		if copied < len(data) {
 			fillZero(data[copied:])
 		}
 
-		err = f(data[:])
+		err := f(data)
 		if err != nil {
 			return err
 		}
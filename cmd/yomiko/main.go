@@ -4,27 +4,40 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strings"
 	"syscall"
 
 	"github.com/kechako/yomiko/bot"
+	"github.com/kechako/yomiko/ssml"
 	"github.com/urfave/cli/v2"
 )
 
-func runCommand(c *cli.Context) error {
+func readConfig(c *cli.Context) (*bot.Config, error) {
 	cfgName := c.String("config")
 	if cfgName == "" {
-		return errors.New("config file is not specified")
+		return nil, errors.New("config file is not specified")
 	}
 
 	cfg, err := bot.ReadConfigFile(cfgName)
 	if err != nil {
 		if errors.Is(err, fs.ErrNotExist) {
-			return errors.New("config file is not found")
+			return nil, errors.New("config file is not found")
 		}
-		return fmt.Errorf("failed to read config file: %w", err)
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	return cfg, nil
+}
+
+func runCommand(c *cli.Context) error {
+	cfg, err := readConfig(c)
+	if err != nil {
+		return err
 	}
 
 	ctx := c.Context
@@ -38,7 +51,180 @@ func runCommand(c *cli.Context) error {
 	return b.Start(ctx)
 }
 
+func ssmlCommand(c *cli.Context) error {
+	cfg := &bot.Config{}
+	if cfgName := c.String("config"); cfgName != "" {
+		var err error
+		cfg, err = bot.ReadConfigFile(cfgName)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return errors.New("config file is not found")
+			}
+			return fmt.Errorf("failed to read config file: %w", err)
+		}
+	}
+
+	var content string
+	if c.NArg() > 0 {
+		content = strings.Join(c.Args().Slice(), " ")
+	} else {
+		b, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("failed to read message: %w", err)
+		}
+		content = string(b)
+	}
+
+	root := bot.BuildSSML(cfg, c.String("author"), content)
+
+	w := c.App.Writer
+	switch format := c.String("format"); format {
+	case "ssml":
+		root.WriteSSML(w)
+		fmt.Fprintln(w)
+	case "text":
+		if err := ssml.WriteText(w, root); err != nil {
+			return err
+		}
+		fmt.Fprintln(w)
+	case "tree":
+		if err := ssml.WriteTree(w, root); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+
+	return nil
+}
+
+func commandsSyncCommand(c *cli.Context) error {
+	cfg, err := readConfig(c)
+	if err != nil {
+		return err
+	}
+
+	if err := bot.SyncCommands(c.Context, cfg); err != nil {
+		return fmt.Errorf("failed to sync commands: %w", err)
+	}
+
+	return nil
+}
+
+func commandsDeleteCommand(c *cli.Context) error {
+	cfg, err := readConfig(c)
+	if err != nil {
+		return err
+	}
+
+	guildIDs := c.StringSlice("guild")
+	if c.Bool("global") {
+		guildIDs = append(guildIDs, "")
+	}
+
+	if err := bot.DeleteCommands(c.Context, cfg, guildIDs...); err != nil {
+		return fmt.Errorf("failed to delete commands: %w", err)
+	}
+
+	return nil
+}
+
+// dictFormat returns the format flag, or the extension of the file name.
+func dictFormat(c *cli.Context, name string) string {
+	if format := c.String("format"); format != "" {
+		return format
+	}
+	return strings.TrimPrefix(filepath.Ext(name), ".")
+}
+
+func dictImportCommand(c *cli.Context) error {
+	cfg, err := readConfig(c)
+	if err != nil {
+		return err
+	}
+
+	if c.NArg() != 1 {
+		return errors.New("dictionary file is not specified")
+	}
+	name := c.Args().First()
+
+	f, err := os.Open(name)
+	if err != nil {
+		return fmt.Errorf("failed to open dictionary file: %w", err)
+	}
+	defer f.Close()
+
+	dryRun := c.Bool("dry-run")
+	diff, err := bot.ImportDictionary(c.Context, cfg, c.String("guild"), f, dictFormat(c, name), dryRun)
+	if err != nil {
+		return fmt.Errorf("failed to import dictionary: %w", err)
+	}
+
+	w := c.App.Writer
+	fmt.Fprint(w, diff)
+	for _, word := range diff.Duplicates {
+		fmt.Fprintf(w, "duplicate: %s\n", word)
+	}
+	fmt.Fprintf(w, "%d added, %d changed, %d unchanged", len(diff.Added), len(diff.Changed), diff.Unchanged)
+	if dryRun {
+		fmt.Fprint(w, " (dry run)")
+	}
+	fmt.Fprintln(w)
+	// a running bot caches the dictionary of the guild
+	if !dryRun && len(diff.Added)+len(diff.Changed) > 0 {
+		fmt.Fprintln(w, "restart the running bot to read the changes")
+	}
+
+	return nil
+}
+
+func dictExportCommand(c *cli.Context) error {
+	cfg, err := readConfig(c)
+	if err != nil {
+		return err
+	}
+
+	w := c.App.Writer
+	name := c.Args().First()
+	if name != "" {
+		f, err := os.Create(name)
+		if err != nil {
+			return fmt.Errorf("failed to create dictionary file: %w", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	format := dictFormat(c, name)
+	if format == "" {
+		format = "csv"
+	}
+
+	if err := bot.ExportDictionary(c.Context, cfg, c.String("guild"), w, format); err != nil {
+		return fmt.Errorf("failed to export dictionary: %w", err)
+	}
+
+	return nil
+}
+
 func main() {
+	configFlag := &cli.StringFlag{
+		Name:    "config",
+		Aliases: []string{"c"},
+		Value:   "config.toml",
+	}
+	guildFlag := &cli.StringFlag{
+		Name:     "guild",
+		Aliases:  []string{"g"},
+		Usage:    "guild ID of the dictionary",
+		Required: true,
+	}
+	formatFlag := &cli.StringFlag{
+		Name:    "format",
+		Aliases: []string{"f"},
+		Usage:   "file format (csv, json or toml), by the file extension if omitted",
+	}
+
 	app := &cli.App{
 		Name: "yomiko",
 		Commands: []*cli.Command{
@@ -53,6 +239,87 @@ func main() {
 				},
 				Action: runCommand,
 			},
+			{
+				Name:      "ssml",
+				Usage:     "render a message as yomiko would read it",
+				ArgsUsage: "[message]",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:    "config",
+						Aliases: []string{"c"},
+					},
+					&cli.StringFlag{
+						Name:    "author",
+						Aliases: []string{"a"},
+						Value:   "読子",
+					},
+					&cli.StringFlag{
+						Name:    "format",
+						Aliases: []string{"f"},
+						Usage:   "output format (ssml, text or tree)",
+						Value:   "ssml",
+					},
+				},
+				Action: ssmlCommand,
+			},
+			{
+				Name:  "commands",
+				Usage: "manage the slash commands registered to Discord",
+				Subcommands: []*cli.Command{
+					{
+						Name:   "sync",
+						Usage:  "register the slash commands to the guilds in the config, or globally",
+						Flags:  []cli.Flag{configFlag},
+						Action: commandsSyncCommand,
+					},
+					{
+						Name:  "delete",
+						Usage: "delete all the slash commands of the guilds in the config, or the specified ones",
+						Flags: []cli.Flag{
+							configFlag,
+							&cli.StringSliceFlag{
+								Name:    "guild",
+								Aliases: []string{"g"},
+								Usage:   "guild ID to delete the commands from",
+							},
+							&cli.BoolFlag{
+								Name:  "global",
+								Usage: "delete the global commands",
+							},
+						},
+						Action: commandsDeleteCommand,
+					},
+				},
+			},
+			{
+				Name:  "dict",
+				Usage: "import or export the dictionary of a guild",
+				Subcommands: []*cli.Command{
+					{
+						Name:      "import",
+						Usage:     "add the entries of the file to the dictionary, which a running bot reads after restart",
+						ArgsUsage: "file",
+						Flags: []cli.Flag{
+							configFlag,
+							guildFlag,
+							formatFlag,
+							&cli.BoolFlag{
+								Name:    "dry-run",
+								Aliases: []string{"n"},
+								Usage:   "show the changes without importing",
+							},
+						},
+						Action: dictImportCommand,
+					},
+					{
+						Name:      "export",
+						Usage:     "write the dictionary to the file, or the standard output in csv if omitted",
+						ArgsUsage: "[file]",
+						Flags:     []cli.Flag{configFlag, guildFlag, formatFlag},
+						Action:    dictExportCommand,
+					},
+				},
+			},
 		},
 	}
 
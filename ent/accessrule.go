@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/accessrule"
+)
+
+// AccessRule is the model entity for the AccessRule schema.
+type AccessRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID string `json:"guild_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action accessrule.Action `json:"action,omitempty"`
+	// RoleID holds the value of the "role_id" field.
+	RoleID       string `json:"role_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccessRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accessrule.FieldID:
+			values[i] = new(sql.NullInt64)
+		case accessrule.FieldGuildID, accessrule.FieldAction, accessrule.FieldRoleID:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccessRule fields.
+func (ar *AccessRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accessrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ar.ID = int(value.Int64)
+		case accessrule.FieldGuildID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				ar.GuildID = value.String
+			}
+		case accessrule.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ar.Action = accessrule.Action(value.String)
+			}
+		case accessrule.FieldRoleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role_id", values[i])
+			} else if value.Valid {
+				ar.RoleID = value.String
+			}
+		default:
+			ar.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccessRule.
+// This includes values selected through modifiers, order, etc.
+func (ar *AccessRule) Value(name string) (ent.Value, error) {
+	return ar.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AccessRule.
+// Note that you need to call AccessRule.Unwrap() before calling this method if this AccessRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ar *AccessRule) Update() *AccessRuleUpdateOne {
+	return NewAccessRuleClient(ar.config).UpdateOne(ar)
+}
+
+// Unwrap unwraps the AccessRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ar *AccessRule) Unwrap() *AccessRule {
+	_tx, ok := ar.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccessRule is not a transactional entity")
+	}
+	ar.config.driver = _tx.drv
+	return ar
+}
+
+// String implements the fmt.Stringer.
+func (ar *AccessRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccessRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ar.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(ar.GuildID)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Action))
+	builder.WriteString(", ")
+	builder.WriteString("role_id=")
+	builder.WriteString(ar.RoleID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccessRules is a parsable slice of AccessRule.
+type AccessRules []*AccessRule
@@ -0,0 +1,98 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accessrule
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the accessrule type in the database.
+	Label = "access_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGuildID holds the string denoting the guild_id field in the database.
+	FieldGuildID = "guild_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldRoleID holds the string denoting the role_id field in the database.
+	FieldRoleID = "role_id"
+	// Table holds the table name of the accessrule in the database.
+	Table = "access_rules"
+)
+
+// Columns holds all SQL columns for accessrule fields.
+var Columns = []string{
+	FieldID,
+	FieldGuildID,
+	FieldAction,
+	FieldRoleID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	GuildIDValidator func(string) error
+	// RoleIDValidator is a validator for the "role_id" field. It is called by the builders before save.
+	RoleIDValidator func(string) error
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionJoin       Action = "join"
+	ActionLeave      Action = "leave"
+	ActionSkip       Action = "skip"
+	ActionDictionary Action = "dictionary"
+	ActionSettings   Action = "settings"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionJoin, ActionLeave, ActionSkip, ActionDictionary, ActionSettings:
+		return nil
+	default:
+		return fmt.Errorf("accessrule: invalid enum value for action field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the AccessRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGuildID orders the results by the guild_id field.
+func ByGuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuildID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByRoleID orders the results by the role_id field.
+func ByRoleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoleID, opts...).ToFunc()
+}
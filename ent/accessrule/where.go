@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package accessrule
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldLTE(FieldID, id))
+}
+
+// GuildID applies equality check predicate on the "guild_id" field. It's identical to GuildIDEQ.
+func GuildID(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldEQ(FieldGuildID, v))
+}
+
+// RoleID applies equality check predicate on the "role_id" field. It's identical to RoleIDEQ.
+func RoleID(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldEQ(FieldRoleID, v))
+}
+
+// GuildIDEQ applies the EQ predicate on the "guild_id" field.
+func GuildIDEQ(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldEQ(FieldGuildID, v))
+}
+
+// GuildIDNEQ applies the NEQ predicate on the "guild_id" field.
+func GuildIDNEQ(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldNEQ(FieldGuildID, v))
+}
+
+// GuildIDIn applies the In predicate on the "guild_id" field.
+func GuildIDIn(vs ...string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldIn(FieldGuildID, vs...))
+}
+
+// GuildIDNotIn applies the NotIn predicate on the "guild_id" field.
+func GuildIDNotIn(vs ...string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldNotIn(FieldGuildID, vs...))
+}
+
+// GuildIDGT applies the GT predicate on the "guild_id" field.
+func GuildIDGT(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldGT(FieldGuildID, v))
+}
+
+// GuildIDGTE applies the GTE predicate on the "guild_id" field.
+func GuildIDGTE(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldGTE(FieldGuildID, v))
+}
+
+// GuildIDLT applies the LT predicate on the "guild_id" field.
+func GuildIDLT(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldLT(FieldGuildID, v))
+}
+
+// GuildIDLTE applies the LTE predicate on the "guild_id" field.
+func GuildIDLTE(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldLTE(FieldGuildID, v))
+}
+
+// GuildIDContains applies the Contains predicate on the "guild_id" field.
+func GuildIDContains(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldContains(FieldGuildID, v))
+}
+
+// GuildIDHasPrefix applies the HasPrefix predicate on the "guild_id" field.
+func GuildIDHasPrefix(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldHasPrefix(FieldGuildID, v))
+}
+
+// GuildIDHasSuffix applies the HasSuffix predicate on the "guild_id" field.
+func GuildIDHasSuffix(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldHasSuffix(FieldGuildID, v))
+}
+
+// GuildIDEqualFold applies the EqualFold predicate on the "guild_id" field.
+func GuildIDEqualFold(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldEqualFold(FieldGuildID, v))
+}
+
+// GuildIDContainsFold applies the ContainsFold predicate on the "guild_id" field.
+func GuildIDContainsFold(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldContainsFold(FieldGuildID, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// RoleIDEQ applies the EQ predicate on the "role_id" field.
+func RoleIDEQ(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldEQ(FieldRoleID, v))
+}
+
+// RoleIDNEQ applies the NEQ predicate on the "role_id" field.
+func RoleIDNEQ(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldNEQ(FieldRoleID, v))
+}
+
+// RoleIDIn applies the In predicate on the "role_id" field.
+func RoleIDIn(vs ...string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldIn(FieldRoleID, vs...))
+}
+
+// RoleIDNotIn applies the NotIn predicate on the "role_id" field.
+func RoleIDNotIn(vs ...string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldNotIn(FieldRoleID, vs...))
+}
+
+// RoleIDGT applies the GT predicate on the "role_id" field.
+func RoleIDGT(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldGT(FieldRoleID, v))
+}
+
+// RoleIDGTE applies the GTE predicate on the "role_id" field.
+func RoleIDGTE(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldGTE(FieldRoleID, v))
+}
+
+// RoleIDLT applies the LT predicate on the "role_id" field.
+func RoleIDLT(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldLT(FieldRoleID, v))
+}
+
+// RoleIDLTE applies the LTE predicate on the "role_id" field.
+func RoleIDLTE(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldLTE(FieldRoleID, v))
+}
+
+// RoleIDContains applies the Contains predicate on the "role_id" field.
+func RoleIDContains(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldContains(FieldRoleID, v))
+}
+
+// RoleIDHasPrefix applies the HasPrefix predicate on the "role_id" field.
+func RoleIDHasPrefix(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldHasPrefix(FieldRoleID, v))
+}
+
+// RoleIDHasSuffix applies the HasSuffix predicate on the "role_id" field.
+func RoleIDHasSuffix(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldHasSuffix(FieldRoleID, v))
+}
+
+// RoleIDEqualFold applies the EqualFold predicate on the "role_id" field.
+func RoleIDEqualFold(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldEqualFold(FieldRoleID, v))
+}
+
+// RoleIDContainsFold applies the ContainsFold predicate on the "role_id" field.
+func RoleIDContainsFold(v string) predicate.AccessRule {
+	return predicate.AccessRule(sql.FieldContainsFold(FieldRoleID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AccessRule) predicate.AccessRule {
+	return predicate.AccessRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AccessRule) predicate.AccessRule {
+	return predicate.AccessRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AccessRule) predicate.AccessRule {
+	return predicate.AccessRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/accessrule"
+)
+
+// AccessRuleCreate is the builder for creating a AccessRule entity.
+type AccessRuleCreate struct {
+	config
+	mutation *AccessRuleMutation
+	hooks    []Hook
+}
+
+// SetGuildID sets the "guild_id" field.
+func (arc *AccessRuleCreate) SetGuildID(s string) *AccessRuleCreate {
+	arc.mutation.SetGuildID(s)
+	return arc
+}
+
+// SetAction sets the "action" field.
+func (arc *AccessRuleCreate) SetAction(a accessrule.Action) *AccessRuleCreate {
+	arc.mutation.SetAction(a)
+	return arc
+}
+
+// SetRoleID sets the "role_id" field.
+func (arc *AccessRuleCreate) SetRoleID(s string) *AccessRuleCreate {
+	arc.mutation.SetRoleID(s)
+	return arc
+}
+
+// Mutation returns the AccessRuleMutation object of the builder.
+func (arc *AccessRuleCreate) Mutation() *AccessRuleMutation {
+	return arc.mutation
+}
+
+// Save creates the AccessRule in the database.
+func (arc *AccessRuleCreate) Save(ctx context.Context) (*AccessRule, error) {
+	return withHooks(ctx, arc.sqlSave, arc.mutation, arc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (arc *AccessRuleCreate) SaveX(ctx context.Context) *AccessRule {
+	v, err := arc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arc *AccessRuleCreate) Exec(ctx context.Context) error {
+	_, err := arc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arc *AccessRuleCreate) ExecX(ctx context.Context) {
+	if err := arc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (arc *AccessRuleCreate) check() error {
+	if _, ok := arc.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "AccessRule.guild_id"`)}
+	}
+	if v, ok := arc.mutation.GuildID(); ok {
+		if err := accessrule.GuildIDValidator(v); err != nil {
+			return &ValidationError{Name: "guild_id", err: fmt.Errorf(`ent: validator failed for field "AccessRule.guild_id": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "AccessRule.action"`)}
+	}
+	if v, ok := arc.mutation.Action(); ok {
+		if err := accessrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "AccessRule.action": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.RoleID(); !ok {
+		return &ValidationError{Name: "role_id", err: errors.New(`ent: missing required field "AccessRule.role_id"`)}
+	}
+	if v, ok := arc.mutation.RoleID(); ok {
+		if err := accessrule.RoleIDValidator(v); err != nil {
+			return &ValidationError{Name: "role_id", err: fmt.Errorf(`ent: validator failed for field "AccessRule.role_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (arc *AccessRuleCreate) sqlSave(ctx context.Context) (*AccessRule, error) {
+	if err := arc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := arc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, arc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	arc.mutation.id = &_node.ID
+	arc.mutation.done = true
+	return _node, nil
+}
+
+func (arc *AccessRuleCreate) createSpec() (*AccessRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccessRule{config: arc.config}
+		_spec = sqlgraph.NewCreateSpec(accessrule.Table, sqlgraph.NewFieldSpec(accessrule.FieldID, field.TypeInt))
+	)
+	if value, ok := arc.mutation.GuildID(); ok {
+		_spec.SetField(accessrule.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := arc.mutation.Action(); ok {
+		_spec.SetField(accessrule.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := arc.mutation.RoleID(); ok {
+		_spec.SetField(accessrule.FieldRoleID, field.TypeString, value)
+		_node.RoleID = value
+	}
+	return _node, _spec
+}
+
+// AccessRuleCreateBulk is the builder for creating many AccessRule entities in bulk.
+type AccessRuleCreateBulk struct {
+	config
+	err      error
+	builders []*AccessRuleCreate
+}
+
+// Save creates the AccessRule entities in the database.
+func (arcb *AccessRuleCreateBulk) Save(ctx context.Context) ([]*AccessRule, error) {
+	if arcb.err != nil {
+		return nil, arcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(arcb.builders))
+	nodes := make([]*AccessRule, len(arcb.builders))
+	mutators := make([]Mutator, len(arcb.builders))
+	for i := range arcb.builders {
+		func(i int, root context.Context) {
+			builder := arcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccessRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, arcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, arcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, arcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (arcb *AccessRuleCreateBulk) SaveX(ctx context.Context) []*AccessRule {
+	v, err := arcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arcb *AccessRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := arcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arcb *AccessRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := arcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -14,6 +14,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/accessrule"
+	"github.com/kechako/yomiko/ent/guilddictentry"
+	"github.com/kechako/yomiko/ent/guildsetting"
+	"github.com/kechako/yomiko/ent/ignoreentry"
+	"github.com/kechako/yomiko/ent/ngword"
+	"github.com/kechako/yomiko/ent/spokenname"
+	"github.com/kechako/yomiko/ent/userdictentry"
 	"github.com/kechako/yomiko/ent/voicesetting"
 )
 
@@ -22,6 +29,20 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AccessRule is the client for interacting with the AccessRule builders.
+	AccessRule *AccessRuleClient
+	// GuildDictEntry is the client for interacting with the GuildDictEntry builders.
+	GuildDictEntry *GuildDictEntryClient
+	// GuildSetting is the client for interacting with the GuildSetting builders.
+	GuildSetting *GuildSettingClient
+	// IgnoreEntry is the client for interacting with the IgnoreEntry builders.
+	IgnoreEntry *IgnoreEntryClient
+	// NGWord is the client for interacting with the NGWord builders.
+	NGWord *NGWordClient
+	// SpokenName is the client for interacting with the SpokenName builders.
+	SpokenName *SpokenNameClient
+	// UserDictEntry is the client for interacting with the UserDictEntry builders.
+	UserDictEntry *UserDictEntryClient
 	// VoiceSetting is the client for interacting with the VoiceSetting builders.
 	VoiceSetting *VoiceSettingClient
 }
@@ -35,6 +56,13 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AccessRule = NewAccessRuleClient(c.config)
+	c.GuildDictEntry = NewGuildDictEntryClient(c.config)
+	c.GuildSetting = NewGuildSettingClient(c.config)
+	c.IgnoreEntry = NewIgnoreEntryClient(c.config)
+	c.NGWord = NewNGWordClient(c.config)
+	c.SpokenName = NewSpokenNameClient(c.config)
+	c.UserDictEntry = NewUserDictEntryClient(c.config)
 	c.VoiceSetting = NewVoiceSettingClient(c.config)
 }
 
@@ -126,9 +154,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		VoiceSetting: NewVoiceSettingClient(cfg),
+		ctx:            ctx,
+		config:         cfg,
+		AccessRule:     NewAccessRuleClient(cfg),
+		GuildDictEntry: NewGuildDictEntryClient(cfg),
+		GuildSetting:   NewGuildSettingClient(cfg),
+		IgnoreEntry:    NewIgnoreEntryClient(cfg),
+		NGWord:         NewNGWordClient(cfg),
+		SpokenName:     NewSpokenNameClient(cfg),
+		UserDictEntry:  NewUserDictEntryClient(cfg),
+		VoiceSetting:   NewVoiceSettingClient(cfg),
 	}, nil
 }
 
@@ -146,16 +181,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		VoiceSetting: NewVoiceSettingClient(cfg),
+		ctx:            ctx,
+		config:         cfg,
+		AccessRule:     NewAccessRuleClient(cfg),
+		GuildDictEntry: NewGuildDictEntryClient(cfg),
+		GuildSetting:   NewGuildSettingClient(cfg),
+		IgnoreEntry:    NewIgnoreEntryClient(cfg),
+		NGWord:         NewNGWordClient(cfg),
+		SpokenName:     NewSpokenNameClient(cfg),
+		UserDictEntry:  NewUserDictEntryClient(cfg),
+		VoiceSetting:   NewVoiceSettingClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		VoiceSetting.
+//		AccessRule.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -177,18 +219,42 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.VoiceSetting.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AccessRule, c.GuildDictEntry, c.GuildSetting, c.IgnoreEntry, c.NGWord,
+		c.SpokenName, c.UserDictEntry, c.VoiceSetting,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.VoiceSetting.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AccessRule, c.GuildDictEntry, c.GuildSetting, c.IgnoreEntry, c.NGWord,
+		c.SpokenName, c.UserDictEntry, c.VoiceSetting,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AccessRuleMutation:
+		return c.AccessRule.mutate(ctx, m)
+	case *GuildDictEntryMutation:
+		return c.GuildDictEntry.mutate(ctx, m)
+	case *GuildSettingMutation:
+		return c.GuildSetting.mutate(ctx, m)
+	case *IgnoreEntryMutation:
+		return c.IgnoreEntry.mutate(ctx, m)
+	case *NGWordMutation:
+		return c.NGWord.mutate(ctx, m)
+	case *SpokenNameMutation:
+		return c.SpokenName.mutate(ctx, m)
+	case *UserDictEntryMutation:
+		return c.UserDictEntry.mutate(ctx, m)
 	case *VoiceSettingMutation:
 		return c.VoiceSetting.mutate(ctx, m)
 	default:
@@ -196,6 +262,937 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AccessRuleClient is a client for the AccessRule schema.
+type AccessRuleClient struct {
+	config
+}
+
+// NewAccessRuleClient returns a client for the AccessRule from the given config.
+func NewAccessRuleClient(c config) *AccessRuleClient {
+	return &AccessRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accessrule.Hooks(f(g(h())))`.
+func (c *AccessRuleClient) Use(hooks ...Hook) {
+	c.hooks.AccessRule = append(c.hooks.AccessRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accessrule.Intercept(f(g(h())))`.
+func (c *AccessRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccessRule = append(c.inters.AccessRule, interceptors...)
+}
+
+// Create returns a builder for creating a AccessRule entity.
+func (c *AccessRuleClient) Create() *AccessRuleCreate {
+	mutation := newAccessRuleMutation(c.config, OpCreate)
+	return &AccessRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccessRule entities.
+func (c *AccessRuleClient) CreateBulk(builders ...*AccessRuleCreate) *AccessRuleCreateBulk {
+	return &AccessRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccessRuleClient) MapCreateBulk(slice any, setFunc func(*AccessRuleCreate, int)) *AccessRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccessRuleCreateBulk{err: fmt.Errorf("calling to AccessRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccessRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccessRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccessRule.
+func (c *AccessRuleClient) Update() *AccessRuleUpdate {
+	mutation := newAccessRuleMutation(c.config, OpUpdate)
+	return &AccessRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccessRuleClient) UpdateOne(ar *AccessRule) *AccessRuleUpdateOne {
+	mutation := newAccessRuleMutation(c.config, OpUpdateOne, withAccessRule(ar))
+	return &AccessRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccessRuleClient) UpdateOneID(id int) *AccessRuleUpdateOne {
+	mutation := newAccessRuleMutation(c.config, OpUpdateOne, withAccessRuleID(id))
+	return &AccessRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccessRule.
+func (c *AccessRuleClient) Delete() *AccessRuleDelete {
+	mutation := newAccessRuleMutation(c.config, OpDelete)
+	return &AccessRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccessRuleClient) DeleteOne(ar *AccessRule) *AccessRuleDeleteOne {
+	return c.DeleteOneID(ar.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccessRuleClient) DeleteOneID(id int) *AccessRuleDeleteOne {
+	builder := c.Delete().Where(accessrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccessRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for AccessRule.
+func (c *AccessRuleClient) Query() *AccessRuleQuery {
+	return &AccessRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccessRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccessRule entity by its id.
+func (c *AccessRuleClient) Get(ctx context.Context, id int) (*AccessRule, error) {
+	return c.Query().Where(accessrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccessRuleClient) GetX(ctx context.Context, id int) *AccessRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AccessRuleClient) Hooks() []Hook {
+	return c.hooks.AccessRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccessRuleClient) Interceptors() []Interceptor {
+	return c.inters.AccessRule
+}
+
+func (c *AccessRuleClient) mutate(ctx context.Context, m *AccessRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccessRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccessRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccessRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccessRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccessRule mutation op: %q", m.Op())
+	}
+}
+
+// GuildDictEntryClient is a client for the GuildDictEntry schema.
+type GuildDictEntryClient struct {
+	config
+}
+
+// NewGuildDictEntryClient returns a client for the GuildDictEntry from the given config.
+func NewGuildDictEntryClient(c config) *GuildDictEntryClient {
+	return &GuildDictEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `guilddictentry.Hooks(f(g(h())))`.
+func (c *GuildDictEntryClient) Use(hooks ...Hook) {
+	c.hooks.GuildDictEntry = append(c.hooks.GuildDictEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `guilddictentry.Intercept(f(g(h())))`.
+func (c *GuildDictEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GuildDictEntry = append(c.inters.GuildDictEntry, interceptors...)
+}
+
+// Create returns a builder for creating a GuildDictEntry entity.
+func (c *GuildDictEntryClient) Create() *GuildDictEntryCreate {
+	mutation := newGuildDictEntryMutation(c.config, OpCreate)
+	return &GuildDictEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GuildDictEntry entities.
+func (c *GuildDictEntryClient) CreateBulk(builders ...*GuildDictEntryCreate) *GuildDictEntryCreateBulk {
+	return &GuildDictEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GuildDictEntryClient) MapCreateBulk(slice any, setFunc func(*GuildDictEntryCreate, int)) *GuildDictEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GuildDictEntryCreateBulk{err: fmt.Errorf("calling to GuildDictEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GuildDictEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GuildDictEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GuildDictEntry.
+func (c *GuildDictEntryClient) Update() *GuildDictEntryUpdate {
+	mutation := newGuildDictEntryMutation(c.config, OpUpdate)
+	return &GuildDictEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GuildDictEntryClient) UpdateOne(gde *GuildDictEntry) *GuildDictEntryUpdateOne {
+	mutation := newGuildDictEntryMutation(c.config, OpUpdateOne, withGuildDictEntry(gde))
+	return &GuildDictEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GuildDictEntryClient) UpdateOneID(id int) *GuildDictEntryUpdateOne {
+	mutation := newGuildDictEntryMutation(c.config, OpUpdateOne, withGuildDictEntryID(id))
+	return &GuildDictEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GuildDictEntry.
+func (c *GuildDictEntryClient) Delete() *GuildDictEntryDelete {
+	mutation := newGuildDictEntryMutation(c.config, OpDelete)
+	return &GuildDictEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GuildDictEntryClient) DeleteOne(gde *GuildDictEntry) *GuildDictEntryDeleteOne {
+	return c.DeleteOneID(gde.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GuildDictEntryClient) DeleteOneID(id int) *GuildDictEntryDeleteOne {
+	builder := c.Delete().Where(guilddictentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GuildDictEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for GuildDictEntry.
+func (c *GuildDictEntryClient) Query() *GuildDictEntryQuery {
+	return &GuildDictEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGuildDictEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GuildDictEntry entity by its id.
+func (c *GuildDictEntryClient) Get(ctx context.Context, id int) (*GuildDictEntry, error) {
+	return c.Query().Where(guilddictentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GuildDictEntryClient) GetX(ctx context.Context, id int) *GuildDictEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GuildDictEntryClient) Hooks() []Hook {
+	return c.hooks.GuildDictEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *GuildDictEntryClient) Interceptors() []Interceptor {
+	return c.inters.GuildDictEntry
+}
+
+func (c *GuildDictEntryClient) mutate(ctx context.Context, m *GuildDictEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GuildDictEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GuildDictEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GuildDictEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GuildDictEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GuildDictEntry mutation op: %q", m.Op())
+	}
+}
+
+// GuildSettingClient is a client for the GuildSetting schema.
+type GuildSettingClient struct {
+	config
+}
+
+// NewGuildSettingClient returns a client for the GuildSetting from the given config.
+func NewGuildSettingClient(c config) *GuildSettingClient {
+	return &GuildSettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `guildsetting.Hooks(f(g(h())))`.
+func (c *GuildSettingClient) Use(hooks ...Hook) {
+	c.hooks.GuildSetting = append(c.hooks.GuildSetting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `guildsetting.Intercept(f(g(h())))`.
+func (c *GuildSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GuildSetting = append(c.inters.GuildSetting, interceptors...)
+}
+
+// Create returns a builder for creating a GuildSetting entity.
+func (c *GuildSettingClient) Create() *GuildSettingCreate {
+	mutation := newGuildSettingMutation(c.config, OpCreate)
+	return &GuildSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GuildSetting entities.
+func (c *GuildSettingClient) CreateBulk(builders ...*GuildSettingCreate) *GuildSettingCreateBulk {
+	return &GuildSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GuildSettingClient) MapCreateBulk(slice any, setFunc func(*GuildSettingCreate, int)) *GuildSettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GuildSettingCreateBulk{err: fmt.Errorf("calling to GuildSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GuildSettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GuildSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GuildSetting.
+func (c *GuildSettingClient) Update() *GuildSettingUpdate {
+	mutation := newGuildSettingMutation(c.config, OpUpdate)
+	return &GuildSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GuildSettingClient) UpdateOne(gs *GuildSetting) *GuildSettingUpdateOne {
+	mutation := newGuildSettingMutation(c.config, OpUpdateOne, withGuildSetting(gs))
+	return &GuildSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GuildSettingClient) UpdateOneID(id int) *GuildSettingUpdateOne {
+	mutation := newGuildSettingMutation(c.config, OpUpdateOne, withGuildSettingID(id))
+	return &GuildSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GuildSetting.
+func (c *GuildSettingClient) Delete() *GuildSettingDelete {
+	mutation := newGuildSettingMutation(c.config, OpDelete)
+	return &GuildSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GuildSettingClient) DeleteOne(gs *GuildSetting) *GuildSettingDeleteOne {
+	return c.DeleteOneID(gs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GuildSettingClient) DeleteOneID(id int) *GuildSettingDeleteOne {
+	builder := c.Delete().Where(guildsetting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GuildSettingDeleteOne{builder}
+}
+
+// Query returns a query builder for GuildSetting.
+func (c *GuildSettingClient) Query() *GuildSettingQuery {
+	return &GuildSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGuildSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GuildSetting entity by its id.
+func (c *GuildSettingClient) Get(ctx context.Context, id int) (*GuildSetting, error) {
+	return c.Query().Where(guildsetting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GuildSettingClient) GetX(ctx context.Context, id int) *GuildSetting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GuildSettingClient) Hooks() []Hook {
+	return c.hooks.GuildSetting
+}
+
+// Interceptors returns the client interceptors.
+func (c *GuildSettingClient) Interceptors() []Interceptor {
+	return c.inters.GuildSetting
+}
+
+func (c *GuildSettingClient) mutate(ctx context.Context, m *GuildSettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GuildSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GuildSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GuildSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GuildSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GuildSetting mutation op: %q", m.Op())
+	}
+}
+
+// IgnoreEntryClient is a client for the IgnoreEntry schema.
+type IgnoreEntryClient struct {
+	config
+}
+
+// NewIgnoreEntryClient returns a client for the IgnoreEntry from the given config.
+func NewIgnoreEntryClient(c config) *IgnoreEntryClient {
+	return &IgnoreEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ignoreentry.Hooks(f(g(h())))`.
+func (c *IgnoreEntryClient) Use(hooks ...Hook) {
+	c.hooks.IgnoreEntry = append(c.hooks.IgnoreEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ignoreentry.Intercept(f(g(h())))`.
+func (c *IgnoreEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IgnoreEntry = append(c.inters.IgnoreEntry, interceptors...)
+}
+
+// Create returns a builder for creating a IgnoreEntry entity.
+func (c *IgnoreEntryClient) Create() *IgnoreEntryCreate {
+	mutation := newIgnoreEntryMutation(c.config, OpCreate)
+	return &IgnoreEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IgnoreEntry entities.
+func (c *IgnoreEntryClient) CreateBulk(builders ...*IgnoreEntryCreate) *IgnoreEntryCreateBulk {
+	return &IgnoreEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IgnoreEntryClient) MapCreateBulk(slice any, setFunc func(*IgnoreEntryCreate, int)) *IgnoreEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IgnoreEntryCreateBulk{err: fmt.Errorf("calling to IgnoreEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IgnoreEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IgnoreEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IgnoreEntry.
+func (c *IgnoreEntryClient) Update() *IgnoreEntryUpdate {
+	mutation := newIgnoreEntryMutation(c.config, OpUpdate)
+	return &IgnoreEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IgnoreEntryClient) UpdateOne(ie *IgnoreEntry) *IgnoreEntryUpdateOne {
+	mutation := newIgnoreEntryMutation(c.config, OpUpdateOne, withIgnoreEntry(ie))
+	return &IgnoreEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IgnoreEntryClient) UpdateOneID(id int) *IgnoreEntryUpdateOne {
+	mutation := newIgnoreEntryMutation(c.config, OpUpdateOne, withIgnoreEntryID(id))
+	return &IgnoreEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IgnoreEntry.
+func (c *IgnoreEntryClient) Delete() *IgnoreEntryDelete {
+	mutation := newIgnoreEntryMutation(c.config, OpDelete)
+	return &IgnoreEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IgnoreEntryClient) DeleteOne(ie *IgnoreEntry) *IgnoreEntryDeleteOne {
+	return c.DeleteOneID(ie.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IgnoreEntryClient) DeleteOneID(id int) *IgnoreEntryDeleteOne {
+	builder := c.Delete().Where(ignoreentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IgnoreEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for IgnoreEntry.
+func (c *IgnoreEntryClient) Query() *IgnoreEntryQuery {
+	return &IgnoreEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIgnoreEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IgnoreEntry entity by its id.
+func (c *IgnoreEntryClient) Get(ctx context.Context, id int) (*IgnoreEntry, error) {
+	return c.Query().Where(ignoreentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IgnoreEntryClient) GetX(ctx context.Context, id int) *IgnoreEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *IgnoreEntryClient) Hooks() []Hook {
+	return c.hooks.IgnoreEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *IgnoreEntryClient) Interceptors() []Interceptor {
+	return c.inters.IgnoreEntry
+}
+
+func (c *IgnoreEntryClient) mutate(ctx context.Context, m *IgnoreEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IgnoreEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IgnoreEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IgnoreEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IgnoreEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IgnoreEntry mutation op: %q", m.Op())
+	}
+}
+
+// NGWordClient is a client for the NGWord schema.
+type NGWordClient struct {
+	config
+}
+
+// NewNGWordClient returns a client for the NGWord from the given config.
+func NewNGWordClient(c config) *NGWordClient {
+	return &NGWordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ngword.Hooks(f(g(h())))`.
+func (c *NGWordClient) Use(hooks ...Hook) {
+	c.hooks.NGWord = append(c.hooks.NGWord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ngword.Intercept(f(g(h())))`.
+func (c *NGWordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.NGWord = append(c.inters.NGWord, interceptors...)
+}
+
+// Create returns a builder for creating a NGWord entity.
+func (c *NGWordClient) Create() *NGWordCreate {
+	mutation := newNGWordMutation(c.config, OpCreate)
+	return &NGWordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of NGWord entities.
+func (c *NGWordClient) CreateBulk(builders ...*NGWordCreate) *NGWordCreateBulk {
+	return &NGWordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *NGWordClient) MapCreateBulk(slice any, setFunc func(*NGWordCreate, int)) *NGWordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &NGWordCreateBulk{err: fmt.Errorf("calling to NGWordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*NGWordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &NGWordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for NGWord.
+func (c *NGWordClient) Update() *NGWordUpdate {
+	mutation := newNGWordMutation(c.config, OpUpdate)
+	return &NGWordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *NGWordClient) UpdateOne(nw *NGWord) *NGWordUpdateOne {
+	mutation := newNGWordMutation(c.config, OpUpdateOne, withNGWord(nw))
+	return &NGWordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *NGWordClient) UpdateOneID(id int) *NGWordUpdateOne {
+	mutation := newNGWordMutation(c.config, OpUpdateOne, withNGWordID(id))
+	return &NGWordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for NGWord.
+func (c *NGWordClient) Delete() *NGWordDelete {
+	mutation := newNGWordMutation(c.config, OpDelete)
+	return &NGWordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *NGWordClient) DeleteOne(nw *NGWord) *NGWordDeleteOne {
+	return c.DeleteOneID(nw.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *NGWordClient) DeleteOneID(id int) *NGWordDeleteOne {
+	builder := c.Delete().Where(ngword.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &NGWordDeleteOne{builder}
+}
+
+// Query returns a query builder for NGWord.
+func (c *NGWordClient) Query() *NGWordQuery {
+	return &NGWordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeNGWord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a NGWord entity by its id.
+func (c *NGWordClient) Get(ctx context.Context, id int) (*NGWord, error) {
+	return c.Query().Where(ngword.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *NGWordClient) GetX(ctx context.Context, id int) *NGWord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *NGWordClient) Hooks() []Hook {
+	return c.hooks.NGWord
+}
+
+// Interceptors returns the client interceptors.
+func (c *NGWordClient) Interceptors() []Interceptor {
+	return c.inters.NGWord
+}
+
+func (c *NGWordClient) mutate(ctx context.Context, m *NGWordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&NGWordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&NGWordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&NGWordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&NGWordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown NGWord mutation op: %q", m.Op())
+	}
+}
+
+// SpokenNameClient is a client for the SpokenName schema.
+type SpokenNameClient struct {
+	config
+}
+
+// NewSpokenNameClient returns a client for the SpokenName from the given config.
+func NewSpokenNameClient(c config) *SpokenNameClient {
+	return &SpokenNameClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `spokenname.Hooks(f(g(h())))`.
+func (c *SpokenNameClient) Use(hooks ...Hook) {
+	c.hooks.SpokenName = append(c.hooks.SpokenName, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `spokenname.Intercept(f(g(h())))`.
+func (c *SpokenNameClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SpokenName = append(c.inters.SpokenName, interceptors...)
+}
+
+// Create returns a builder for creating a SpokenName entity.
+func (c *SpokenNameClient) Create() *SpokenNameCreate {
+	mutation := newSpokenNameMutation(c.config, OpCreate)
+	return &SpokenNameCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SpokenName entities.
+func (c *SpokenNameClient) CreateBulk(builders ...*SpokenNameCreate) *SpokenNameCreateBulk {
+	return &SpokenNameCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SpokenNameClient) MapCreateBulk(slice any, setFunc func(*SpokenNameCreate, int)) *SpokenNameCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SpokenNameCreateBulk{err: fmt.Errorf("calling to SpokenNameClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SpokenNameCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SpokenNameCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SpokenName.
+func (c *SpokenNameClient) Update() *SpokenNameUpdate {
+	mutation := newSpokenNameMutation(c.config, OpUpdate)
+	return &SpokenNameUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SpokenNameClient) UpdateOne(sn *SpokenName) *SpokenNameUpdateOne {
+	mutation := newSpokenNameMutation(c.config, OpUpdateOne, withSpokenName(sn))
+	return &SpokenNameUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SpokenNameClient) UpdateOneID(id int) *SpokenNameUpdateOne {
+	mutation := newSpokenNameMutation(c.config, OpUpdateOne, withSpokenNameID(id))
+	return &SpokenNameUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SpokenName.
+func (c *SpokenNameClient) Delete() *SpokenNameDelete {
+	mutation := newSpokenNameMutation(c.config, OpDelete)
+	return &SpokenNameDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SpokenNameClient) DeleteOne(sn *SpokenName) *SpokenNameDeleteOne {
+	return c.DeleteOneID(sn.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SpokenNameClient) DeleteOneID(id int) *SpokenNameDeleteOne {
+	builder := c.Delete().Where(spokenname.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SpokenNameDeleteOne{builder}
+}
+
+// Query returns a query builder for SpokenName.
+func (c *SpokenNameClient) Query() *SpokenNameQuery {
+	return &SpokenNameQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSpokenName},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SpokenName entity by its id.
+func (c *SpokenNameClient) Get(ctx context.Context, id int) (*SpokenName, error) {
+	return c.Query().Where(spokenname.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SpokenNameClient) GetX(ctx context.Context, id int) *SpokenName {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SpokenNameClient) Hooks() []Hook {
+	return c.hooks.SpokenName
+}
+
+// Interceptors returns the client interceptors.
+func (c *SpokenNameClient) Interceptors() []Interceptor {
+	return c.inters.SpokenName
+}
+
+func (c *SpokenNameClient) mutate(ctx context.Context, m *SpokenNameMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SpokenNameCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SpokenNameUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SpokenNameUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SpokenNameDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SpokenName mutation op: %q", m.Op())
+	}
+}
+
+// UserDictEntryClient is a client for the UserDictEntry schema.
+type UserDictEntryClient struct {
+	config
+}
+
+// NewUserDictEntryClient returns a client for the UserDictEntry from the given config.
+func NewUserDictEntryClient(c config) *UserDictEntryClient {
+	return &UserDictEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userdictentry.Hooks(f(g(h())))`.
+func (c *UserDictEntryClient) Use(hooks ...Hook) {
+	c.hooks.UserDictEntry = append(c.hooks.UserDictEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userdictentry.Intercept(f(g(h())))`.
+func (c *UserDictEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserDictEntry = append(c.inters.UserDictEntry, interceptors...)
+}
+
+// Create returns a builder for creating a UserDictEntry entity.
+func (c *UserDictEntryClient) Create() *UserDictEntryCreate {
+	mutation := newUserDictEntryMutation(c.config, OpCreate)
+	return &UserDictEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserDictEntry entities.
+func (c *UserDictEntryClient) CreateBulk(builders ...*UserDictEntryCreate) *UserDictEntryCreateBulk {
+	return &UserDictEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserDictEntryClient) MapCreateBulk(slice any, setFunc func(*UserDictEntryCreate, int)) *UserDictEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserDictEntryCreateBulk{err: fmt.Errorf("calling to UserDictEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserDictEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserDictEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserDictEntry.
+func (c *UserDictEntryClient) Update() *UserDictEntryUpdate {
+	mutation := newUserDictEntryMutation(c.config, OpUpdate)
+	return &UserDictEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserDictEntryClient) UpdateOne(ude *UserDictEntry) *UserDictEntryUpdateOne {
+	mutation := newUserDictEntryMutation(c.config, OpUpdateOne, withUserDictEntry(ude))
+	return &UserDictEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserDictEntryClient) UpdateOneID(id int) *UserDictEntryUpdateOne {
+	mutation := newUserDictEntryMutation(c.config, OpUpdateOne, withUserDictEntryID(id))
+	return &UserDictEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserDictEntry.
+func (c *UserDictEntryClient) Delete() *UserDictEntryDelete {
+	mutation := newUserDictEntryMutation(c.config, OpDelete)
+	return &UserDictEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserDictEntryClient) DeleteOne(ude *UserDictEntry) *UserDictEntryDeleteOne {
+	return c.DeleteOneID(ude.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserDictEntryClient) DeleteOneID(id int) *UserDictEntryDeleteOne {
+	builder := c.Delete().Where(userdictentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDictEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for UserDictEntry.
+func (c *UserDictEntryClient) Query() *UserDictEntryQuery {
+	return &UserDictEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserDictEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserDictEntry entity by its id.
+func (c *UserDictEntryClient) Get(ctx context.Context, id int) (*UserDictEntry, error) {
+	return c.Query().Where(userdictentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserDictEntryClient) GetX(ctx context.Context, id int) *UserDictEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserDictEntryClient) Hooks() []Hook {
+	return c.hooks.UserDictEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserDictEntryClient) Interceptors() []Interceptor {
+	return c.inters.UserDictEntry
+}
+
+func (c *UserDictEntryClient) mutate(ctx context.Context, m *UserDictEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserDictEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserDictEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserDictEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDictEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserDictEntry mutation op: %q", m.Op())
+	}
+}
+
 // VoiceSettingClient is a client for the VoiceSetting schema.
 type VoiceSettingClient struct {
 	config
@@ -332,9 +1329,11 @@ func (c *VoiceSettingClient) mutate(ctx context.Context, m *VoiceSettingMutation
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		VoiceSetting []ent.Hook
+		AccessRule, GuildDictEntry, GuildSetting, IgnoreEntry, NGWord, SpokenName,
+		UserDictEntry, VoiceSetting []ent.Hook
 	}
 	inters struct {
-		VoiceSetting []ent.Interceptor
+		AccessRule, GuildDictEntry, GuildSetting, IgnoreEntry, NGWord, SpokenName,
+		UserDictEntry, VoiceSetting []ent.Interceptor
 	}
 )
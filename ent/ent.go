@@ -12,6 +12,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/kechako/yomiko/ent/accessrule"
+	"github.com/kechako/yomiko/ent/guilddictentry"
+	"github.com/kechako/yomiko/ent/guildsetting"
+	"github.com/kechako/yomiko/ent/ignoreentry"
+	"github.com/kechako/yomiko/ent/ngword"
+	"github.com/kechako/yomiko/ent/spokenname"
+	"github.com/kechako/yomiko/ent/userdictentry"
 	"github.com/kechako/yomiko/ent/voicesetting"
 )
 
@@ -73,7 +80,14 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			voicesetting.Table: voicesetting.ValidColumn,
+			accessrule.Table:     accessrule.ValidColumn,
+			guilddictentry.Table: guilddictentry.ValidColumn,
+			guildsetting.Table:   guildsetting.ValidColumn,
+			ignoreentry.Table:    ignoreentry.ValidColumn,
+			ngword.Table:         ngword.ValidColumn,
+			spokenname.Table:     spokenname.ValidColumn,
+			userdictentry.Table:  userdictentry.ValidColumn,
+			voicesetting.Table:   voicesetting.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
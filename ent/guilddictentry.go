@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/guilddictentry"
+)
+
+// GuildDictEntry is the model entity for the GuildDictEntry schema.
+type GuildDictEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID string `json:"guild_id,omitempty"`
+	// Word holds the value of the "word" field.
+	Word string `json:"word,omitempty"`
+	// Reading holds the value of the "reading" field.
+	Reading      string `json:"reading,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GuildDictEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case guilddictentry.FieldID:
+			values[i] = new(sql.NullInt64)
+		case guilddictentry.FieldGuildID, guilddictentry.FieldWord, guilddictentry.FieldReading:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GuildDictEntry fields.
+func (gde *GuildDictEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case guilddictentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gde.ID = int(value.Int64)
+		case guilddictentry.FieldGuildID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				gde.GuildID = value.String
+			}
+		case guilddictentry.FieldWord:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field word", values[i])
+			} else if value.Valid {
+				gde.Word = value.String
+			}
+		case guilddictentry.FieldReading:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reading", values[i])
+			} else if value.Valid {
+				gde.Reading = value.String
+			}
+		default:
+			gde.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GuildDictEntry.
+// This includes values selected through modifiers, order, etc.
+func (gde *GuildDictEntry) Value(name string) (ent.Value, error) {
+	return gde.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GuildDictEntry.
+// Note that you need to call GuildDictEntry.Unwrap() before calling this method if this GuildDictEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gde *GuildDictEntry) Update() *GuildDictEntryUpdateOne {
+	return NewGuildDictEntryClient(gde.config).UpdateOne(gde)
+}
+
+// Unwrap unwraps the GuildDictEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gde *GuildDictEntry) Unwrap() *GuildDictEntry {
+	_tx, ok := gde.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GuildDictEntry is not a transactional entity")
+	}
+	gde.config.driver = _tx.drv
+	return gde
+}
+
+// String implements the fmt.Stringer.
+func (gde *GuildDictEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("GuildDictEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gde.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(gde.GuildID)
+	builder.WriteString(", ")
+	builder.WriteString("word=")
+	builder.WriteString(gde.Word)
+	builder.WriteString(", ")
+	builder.WriteString("reading=")
+	builder.WriteString(gde.Reading)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GuildDictEntries is a parsable slice of GuildDictEntry.
+type GuildDictEntries []*GuildDictEntry
@@ -0,0 +1,72 @@
+// Code generated by ent, DO NOT EDIT.
+
+package guilddictentry
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the guilddictentry type in the database.
+	Label = "guild_dict_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGuildID holds the string denoting the guild_id field in the database.
+	FieldGuildID = "guild_id"
+	// FieldWord holds the string denoting the word field in the database.
+	FieldWord = "word"
+	// FieldReading holds the string denoting the reading field in the database.
+	FieldReading = "reading"
+	// Table holds the table name of the guilddictentry in the database.
+	Table = "guild_dict_entries"
+)
+
+// Columns holds all SQL columns for guilddictentry fields.
+var Columns = []string{
+	FieldID,
+	FieldGuildID,
+	FieldWord,
+	FieldReading,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	GuildIDValidator func(string) error
+	// WordValidator is a validator for the "word" field. It is called by the builders before save.
+	WordValidator func(string) error
+	// ReadingValidator is a validator for the "reading" field. It is called by the builders before save.
+	ReadingValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the GuildDictEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGuildID orders the results by the guild_id field.
+func ByGuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuildID, opts...).ToFunc()
+}
+
+// ByWord orders the results by the word field.
+func ByWord(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWord, opts...).ToFunc()
+}
+
+// ByReading orders the results by the reading field.
+func ByReading(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReading, opts...).ToFunc()
+}
@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package guilddictentry
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldLTE(FieldID, id))
+}
+
+// GuildID applies equality check predicate on the "guild_id" field. It's identical to GuildIDEQ.
+func GuildID(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldEQ(FieldGuildID, v))
+}
+
+// Word applies equality check predicate on the "word" field. It's identical to WordEQ.
+func Word(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldEQ(FieldWord, v))
+}
+
+// Reading applies equality check predicate on the "reading" field. It's identical to ReadingEQ.
+func Reading(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldEQ(FieldReading, v))
+}
+
+// GuildIDEQ applies the EQ predicate on the "guild_id" field.
+func GuildIDEQ(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldEQ(FieldGuildID, v))
+}
+
+// GuildIDNEQ applies the NEQ predicate on the "guild_id" field.
+func GuildIDNEQ(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldNEQ(FieldGuildID, v))
+}
+
+// GuildIDIn applies the In predicate on the "guild_id" field.
+func GuildIDIn(vs ...string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldIn(FieldGuildID, vs...))
+}
+
+// GuildIDNotIn applies the NotIn predicate on the "guild_id" field.
+func GuildIDNotIn(vs ...string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldNotIn(FieldGuildID, vs...))
+}
+
+// GuildIDGT applies the GT predicate on the "guild_id" field.
+func GuildIDGT(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldGT(FieldGuildID, v))
+}
+
+// GuildIDGTE applies the GTE predicate on the "guild_id" field.
+func GuildIDGTE(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldGTE(FieldGuildID, v))
+}
+
+// GuildIDLT applies the LT predicate on the "guild_id" field.
+func GuildIDLT(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldLT(FieldGuildID, v))
+}
+
+// GuildIDLTE applies the LTE predicate on the "guild_id" field.
+func GuildIDLTE(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldLTE(FieldGuildID, v))
+}
+
+// GuildIDContains applies the Contains predicate on the "guild_id" field.
+func GuildIDContains(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldContains(FieldGuildID, v))
+}
+
+// GuildIDHasPrefix applies the HasPrefix predicate on the "guild_id" field.
+func GuildIDHasPrefix(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldHasPrefix(FieldGuildID, v))
+}
+
+// GuildIDHasSuffix applies the HasSuffix predicate on the "guild_id" field.
+func GuildIDHasSuffix(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldHasSuffix(FieldGuildID, v))
+}
+
+// GuildIDEqualFold applies the EqualFold predicate on the "guild_id" field.
+func GuildIDEqualFold(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldEqualFold(FieldGuildID, v))
+}
+
+// GuildIDContainsFold applies the ContainsFold predicate on the "guild_id" field.
+func GuildIDContainsFold(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldContainsFold(FieldGuildID, v))
+}
+
+// WordEQ applies the EQ predicate on the "word" field.
+func WordEQ(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldEQ(FieldWord, v))
+}
+
+// WordNEQ applies the NEQ predicate on the "word" field.
+func WordNEQ(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldNEQ(FieldWord, v))
+}
+
+// WordIn applies the In predicate on the "word" field.
+func WordIn(vs ...string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldIn(FieldWord, vs...))
+}
+
+// WordNotIn applies the NotIn predicate on the "word" field.
+func WordNotIn(vs ...string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldNotIn(FieldWord, vs...))
+}
+
+// WordGT applies the GT predicate on the "word" field.
+func WordGT(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldGT(FieldWord, v))
+}
+
+// WordGTE applies the GTE predicate on the "word" field.
+func WordGTE(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldGTE(FieldWord, v))
+}
+
+// WordLT applies the LT predicate on the "word" field.
+func WordLT(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldLT(FieldWord, v))
+}
+
+// WordLTE applies the LTE predicate on the "word" field.
+func WordLTE(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldLTE(FieldWord, v))
+}
+
+// WordContains applies the Contains predicate on the "word" field.
+func WordContains(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldContains(FieldWord, v))
+}
+
+// WordHasPrefix applies the HasPrefix predicate on the "word" field.
+func WordHasPrefix(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldHasPrefix(FieldWord, v))
+}
+
+// WordHasSuffix applies the HasSuffix predicate on the "word" field.
+func WordHasSuffix(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldHasSuffix(FieldWord, v))
+}
+
+// WordEqualFold applies the EqualFold predicate on the "word" field.
+func WordEqualFold(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldEqualFold(FieldWord, v))
+}
+
+// WordContainsFold applies the ContainsFold predicate on the "word" field.
+func WordContainsFold(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldContainsFold(FieldWord, v))
+}
+
+// ReadingEQ applies the EQ predicate on the "reading" field.
+func ReadingEQ(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldEQ(FieldReading, v))
+}
+
+// ReadingNEQ applies the NEQ predicate on the "reading" field.
+func ReadingNEQ(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldNEQ(FieldReading, v))
+}
+
+// ReadingIn applies the In predicate on the "reading" field.
+func ReadingIn(vs ...string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldIn(FieldReading, vs...))
+}
+
+// ReadingNotIn applies the NotIn predicate on the "reading" field.
+func ReadingNotIn(vs ...string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldNotIn(FieldReading, vs...))
+}
+
+// ReadingGT applies the GT predicate on the "reading" field.
+func ReadingGT(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldGT(FieldReading, v))
+}
+
+// ReadingGTE applies the GTE predicate on the "reading" field.
+func ReadingGTE(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldGTE(FieldReading, v))
+}
+
+// ReadingLT applies the LT predicate on the "reading" field.
+func ReadingLT(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldLT(FieldReading, v))
+}
+
+// ReadingLTE applies the LTE predicate on the "reading" field.
+func ReadingLTE(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldLTE(FieldReading, v))
+}
+
+// ReadingContains applies the Contains predicate on the "reading" field.
+func ReadingContains(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldContains(FieldReading, v))
+}
+
+// ReadingHasPrefix applies the HasPrefix predicate on the "reading" field.
+func ReadingHasPrefix(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldHasPrefix(FieldReading, v))
+}
+
+// ReadingHasSuffix applies the HasSuffix predicate on the "reading" field.
+func ReadingHasSuffix(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldHasSuffix(FieldReading, v))
+}
+
+// ReadingEqualFold applies the EqualFold predicate on the "reading" field.
+func ReadingEqualFold(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldEqualFold(FieldReading, v))
+}
+
+// ReadingContainsFold applies the ContainsFold predicate on the "reading" field.
+func ReadingContainsFold(v string) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.FieldContainsFold(FieldReading, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GuildDictEntry) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GuildDictEntry) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GuildDictEntry) predicate.GuildDictEntry {
+	return predicate.GuildDictEntry(sql.NotPredicates(p))
+}
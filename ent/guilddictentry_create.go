@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/guilddictentry"
+)
+
+// GuildDictEntryCreate is the builder for creating a GuildDictEntry entity.
+type GuildDictEntryCreate struct {
+	config
+	mutation *GuildDictEntryMutation
+	hooks    []Hook
+}
+
+// SetGuildID sets the "guild_id" field.
+func (gdec *GuildDictEntryCreate) SetGuildID(s string) *GuildDictEntryCreate {
+	gdec.mutation.SetGuildID(s)
+	return gdec
+}
+
+// SetWord sets the "word" field.
+func (gdec *GuildDictEntryCreate) SetWord(s string) *GuildDictEntryCreate {
+	gdec.mutation.SetWord(s)
+	return gdec
+}
+
+// SetReading sets the "reading" field.
+func (gdec *GuildDictEntryCreate) SetReading(s string) *GuildDictEntryCreate {
+	gdec.mutation.SetReading(s)
+	return gdec
+}
+
+// Mutation returns the GuildDictEntryMutation object of the builder.
+func (gdec *GuildDictEntryCreate) Mutation() *GuildDictEntryMutation {
+	return gdec.mutation
+}
+
+// Save creates the GuildDictEntry in the database.
+func (gdec *GuildDictEntryCreate) Save(ctx context.Context) (*GuildDictEntry, error) {
+	return withHooks(ctx, gdec.sqlSave, gdec.mutation, gdec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gdec *GuildDictEntryCreate) SaveX(ctx context.Context) *GuildDictEntry {
+	v, err := gdec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gdec *GuildDictEntryCreate) Exec(ctx context.Context) error {
+	_, err := gdec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gdec *GuildDictEntryCreate) ExecX(ctx context.Context) {
+	if err := gdec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gdec *GuildDictEntryCreate) check() error {
+	if _, ok := gdec.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "GuildDictEntry.guild_id"`)}
+	}
+	if v, ok := gdec.mutation.GuildID(); ok {
+		if err := guilddictentry.GuildIDValidator(v); err != nil {
+			return &ValidationError{Name: "guild_id", err: fmt.Errorf(`ent: validator failed for field "GuildDictEntry.guild_id": %w`, err)}
+		}
+	}
+	if _, ok := gdec.mutation.Word(); !ok {
+		return &ValidationError{Name: "word", err: errors.New(`ent: missing required field "GuildDictEntry.word"`)}
+	}
+	if v, ok := gdec.mutation.Word(); ok {
+		if err := guilddictentry.WordValidator(v); err != nil {
+			return &ValidationError{Name: "word", err: fmt.Errorf(`ent: validator failed for field "GuildDictEntry.word": %w`, err)}
+		}
+	}
+	if _, ok := gdec.mutation.Reading(); !ok {
+		return &ValidationError{Name: "reading", err: errors.New(`ent: missing required field "GuildDictEntry.reading"`)}
+	}
+	if v, ok := gdec.mutation.Reading(); ok {
+		if err := guilddictentry.ReadingValidator(v); err != nil {
+			return &ValidationError{Name: "reading", err: fmt.Errorf(`ent: validator failed for field "GuildDictEntry.reading": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gdec *GuildDictEntryCreate) sqlSave(ctx context.Context) (*GuildDictEntry, error) {
+	if err := gdec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gdec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gdec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	gdec.mutation.id = &_node.ID
+	gdec.mutation.done = true
+	return _node, nil
+}
+
+func (gdec *GuildDictEntryCreate) createSpec() (*GuildDictEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GuildDictEntry{config: gdec.config}
+		_spec = sqlgraph.NewCreateSpec(guilddictentry.Table, sqlgraph.NewFieldSpec(guilddictentry.FieldID, field.TypeInt))
+	)
+	if value, ok := gdec.mutation.GuildID(); ok {
+		_spec.SetField(guilddictentry.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := gdec.mutation.Word(); ok {
+		_spec.SetField(guilddictentry.FieldWord, field.TypeString, value)
+		_node.Word = value
+	}
+	if value, ok := gdec.mutation.Reading(); ok {
+		_spec.SetField(guilddictentry.FieldReading, field.TypeString, value)
+		_node.Reading = value
+	}
+	return _node, _spec
+}
+
+// GuildDictEntryCreateBulk is the builder for creating many GuildDictEntry entities in bulk.
+type GuildDictEntryCreateBulk struct {
+	config
+	err      error
+	builders []*GuildDictEntryCreate
+}
+
+// Save creates the GuildDictEntry entities in the database.
+func (gdecb *GuildDictEntryCreateBulk) Save(ctx context.Context) ([]*GuildDictEntry, error) {
+	if gdecb.err != nil {
+		return nil, gdecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gdecb.builders))
+	nodes := make([]*GuildDictEntry, len(gdecb.builders))
+	mutators := make([]Mutator, len(gdecb.builders))
+	for i := range gdecb.builders {
+		func(i int, root context.Context) {
+			builder := gdecb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GuildDictEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gdecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gdecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gdecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gdecb *GuildDictEntryCreateBulk) SaveX(ctx context.Context) []*GuildDictEntry {
+	v, err := gdecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gdecb *GuildDictEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := gdecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gdecb *GuildDictEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := gdecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
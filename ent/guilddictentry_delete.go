@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/guilddictentry"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// GuildDictEntryDelete is the builder for deleting a GuildDictEntry entity.
+type GuildDictEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *GuildDictEntryMutation
+}
+
+// Where appends a list predicates to the GuildDictEntryDelete builder.
+func (gded *GuildDictEntryDelete) Where(ps ...predicate.GuildDictEntry) *GuildDictEntryDelete {
+	gded.mutation.Where(ps...)
+	return gded
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gded *GuildDictEntryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gded.sqlExec, gded.mutation, gded.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gded *GuildDictEntryDelete) ExecX(ctx context.Context) int {
+	n, err := gded.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gded *GuildDictEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(guilddictentry.Table, sqlgraph.NewFieldSpec(guilddictentry.FieldID, field.TypeInt))
+	if ps := gded.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gded.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gded.mutation.done = true
+	return affected, err
+}
+
+// GuildDictEntryDeleteOne is the builder for deleting a single GuildDictEntry entity.
+type GuildDictEntryDeleteOne struct {
+	gded *GuildDictEntryDelete
+}
+
+// Where appends a list predicates to the GuildDictEntryDelete builder.
+func (gdedo *GuildDictEntryDeleteOne) Where(ps ...predicate.GuildDictEntry) *GuildDictEntryDeleteOne {
+	gdedo.gded.mutation.Where(ps...)
+	return gdedo
+}
+
+// Exec executes the deletion query.
+func (gdedo *GuildDictEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := gdedo.gded.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{guilddictentry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gdedo *GuildDictEntryDeleteOne) ExecX(ctx context.Context) {
+	if err := gdedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/guilddictentry"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// GuildDictEntryQuery is the builder for querying GuildDictEntry entities.
+type GuildDictEntryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []guilddictentry.OrderOption
+	inters     []Interceptor
+	predicates []predicate.GuildDictEntry
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the GuildDictEntryQuery builder.
+func (gdeq *GuildDictEntryQuery) Where(ps ...predicate.GuildDictEntry) *GuildDictEntryQuery {
+	gdeq.predicates = append(gdeq.predicates, ps...)
+	return gdeq
+}
+
+// Limit the number of records to be returned by this query.
+func (gdeq *GuildDictEntryQuery) Limit(limit int) *GuildDictEntryQuery {
+	gdeq.ctx.Limit = &limit
+	return gdeq
+}
+
+// Offset to start from.
+func (gdeq *GuildDictEntryQuery) Offset(offset int) *GuildDictEntryQuery {
+	gdeq.ctx.Offset = &offset
+	return gdeq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (gdeq *GuildDictEntryQuery) Unique(unique bool) *GuildDictEntryQuery {
+	gdeq.ctx.Unique = &unique
+	return gdeq
+}
+
+// Order specifies how the records should be ordered.
+func (gdeq *GuildDictEntryQuery) Order(o ...guilddictentry.OrderOption) *GuildDictEntryQuery {
+	gdeq.order = append(gdeq.order, o...)
+	return gdeq
+}
+
+// First returns the first GuildDictEntry entity from the query.
+// Returns a *NotFoundError when no GuildDictEntry was found.
+func (gdeq *GuildDictEntryQuery) First(ctx context.Context) (*GuildDictEntry, error) {
+	nodes, err := gdeq.Limit(1).All(setContextOp(ctx, gdeq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{guilddictentry.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (gdeq *GuildDictEntryQuery) FirstX(ctx context.Context) *GuildDictEntry {
+	node, err := gdeq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first GuildDictEntry ID from the query.
+// Returns a *NotFoundError when no GuildDictEntry ID was found.
+func (gdeq *GuildDictEntryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gdeq.Limit(1).IDs(setContextOp(ctx, gdeq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{guilddictentry.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (gdeq *GuildDictEntryQuery) FirstIDX(ctx context.Context) int {
+	id, err := gdeq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single GuildDictEntry entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one GuildDictEntry entity is found.
+// Returns a *NotFoundError when no GuildDictEntry entities are found.
+func (gdeq *GuildDictEntryQuery) Only(ctx context.Context) (*GuildDictEntry, error) {
+	nodes, err := gdeq.Limit(2).All(setContextOp(ctx, gdeq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{guilddictentry.Label}
+	default:
+		return nil, &NotSingularError{guilddictentry.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (gdeq *GuildDictEntryQuery) OnlyX(ctx context.Context) *GuildDictEntry {
+	node, err := gdeq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only GuildDictEntry ID in the query.
+// Returns a *NotSingularError when more than one GuildDictEntry ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (gdeq *GuildDictEntryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = gdeq.Limit(2).IDs(setContextOp(ctx, gdeq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{guilddictentry.Label}
+	default:
+		err = &NotSingularError{guilddictentry.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (gdeq *GuildDictEntryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := gdeq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of GuildDictEntries.
+func (gdeq *GuildDictEntryQuery) All(ctx context.Context) ([]*GuildDictEntry, error) {
+	ctx = setContextOp(ctx, gdeq.ctx, "All")
+	if err := gdeq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*GuildDictEntry, *GuildDictEntryQuery]()
+	return withInterceptors[[]*GuildDictEntry](ctx, gdeq, qr, gdeq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gdeq *GuildDictEntryQuery) AllX(ctx context.Context) []*GuildDictEntry {
+	nodes, err := gdeq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of GuildDictEntry IDs.
+func (gdeq *GuildDictEntryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if gdeq.ctx.Unique == nil && gdeq.path != nil {
+		gdeq.Unique(true)
+	}
+	ctx = setContextOp(ctx, gdeq.ctx, "IDs")
+	if err = gdeq.Select(guilddictentry.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (gdeq *GuildDictEntryQuery) IDsX(ctx context.Context) []int {
+	ids, err := gdeq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (gdeq *GuildDictEntryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, gdeq.ctx, "Count")
+	if err := gdeq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, gdeq, querierCount[*GuildDictEntryQuery](), gdeq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (gdeq *GuildDictEntryQuery) CountX(ctx context.Context) int {
+	count, err := gdeq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (gdeq *GuildDictEntryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, gdeq.ctx, "Exist")
+	switch _, err := gdeq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (gdeq *GuildDictEntryQuery) ExistX(ctx context.Context) bool {
+	exist, err := gdeq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the GuildDictEntryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (gdeq *GuildDictEntryQuery) Clone() *GuildDictEntryQuery {
+	if gdeq == nil {
+		return nil
+	}
+	return &GuildDictEntryQuery{
+		config:     gdeq.config,
+		ctx:        gdeq.ctx.Clone(),
+		order:      append([]guilddictentry.OrderOption{}, gdeq.order...),
+		inters:     append([]Interceptor{}, gdeq.inters...),
+		predicates: append([]predicate.GuildDictEntry{}, gdeq.predicates...),
+		// clone intermediate query.
+		sql:  gdeq.sql.Clone(),
+		path: gdeq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID string `json:"guild_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.GuildDictEntry.Query().
+//		GroupBy(guilddictentry.FieldGuildID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (gdeq *GuildDictEntryQuery) GroupBy(field string, fields ...string) *GuildDictEntryGroupBy {
+	gdeq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &GuildDictEntryGroupBy{build: gdeq}
+	grbuild.flds = &gdeq.ctx.Fields
+	grbuild.label = guilddictentry.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID string `json:"guild_id,omitempty"`
+//	}
+//
+//	client.GuildDictEntry.Query().
+//		Select(guilddictentry.FieldGuildID).
+//		Scan(ctx, &v)
+func (gdeq *GuildDictEntryQuery) Select(fields ...string) *GuildDictEntrySelect {
+	gdeq.ctx.Fields = append(gdeq.ctx.Fields, fields...)
+	sbuild := &GuildDictEntrySelect{GuildDictEntryQuery: gdeq}
+	sbuild.label = guilddictentry.Label
+	sbuild.flds, sbuild.scan = &gdeq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a GuildDictEntrySelect configured with the given aggregations.
+func (gdeq *GuildDictEntryQuery) Aggregate(fns ...AggregateFunc) *GuildDictEntrySelect {
+	return gdeq.Select().Aggregate(fns...)
+}
+
+func (gdeq *GuildDictEntryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range gdeq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, gdeq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range gdeq.ctx.Fields {
+		if !guilddictentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if gdeq.path != nil {
+		prev, err := gdeq.path(ctx)
+		if err != nil {
+			return err
+		}
+		gdeq.sql = prev
+	}
+	return nil
+}
+
+func (gdeq *GuildDictEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*GuildDictEntry, error) {
+	var (
+		nodes = []*GuildDictEntry{}
+		_spec = gdeq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*GuildDictEntry).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &GuildDictEntry{config: gdeq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, gdeq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (gdeq *GuildDictEntryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := gdeq.querySpec()
+	_spec.Node.Columns = gdeq.ctx.Fields
+	if len(gdeq.ctx.Fields) > 0 {
+		_spec.Unique = gdeq.ctx.Unique != nil && *gdeq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, gdeq.driver, _spec)
+}
+
+func (gdeq *GuildDictEntryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(guilddictentry.Table, guilddictentry.Columns, sqlgraph.NewFieldSpec(guilddictentry.FieldID, field.TypeInt))
+	_spec.From = gdeq.sql
+	if unique := gdeq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if gdeq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := gdeq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, guilddictentry.FieldID)
+		for i := range fields {
+			if fields[i] != guilddictentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := gdeq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := gdeq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := gdeq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := gdeq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (gdeq *GuildDictEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(gdeq.driver.Dialect())
+	t1 := builder.Table(guilddictentry.Table)
+	columns := gdeq.ctx.Fields
+	if len(columns) == 0 {
+		columns = guilddictentry.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if gdeq.sql != nil {
+		selector = gdeq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if gdeq.ctx.Unique != nil && *gdeq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range gdeq.predicates {
+		p(selector)
+	}
+	for _, p := range gdeq.order {
+		p(selector)
+	}
+	if offset := gdeq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := gdeq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// GuildDictEntryGroupBy is the group-by builder for GuildDictEntry entities.
+type GuildDictEntryGroupBy struct {
+	selector
+	build *GuildDictEntryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (gdegb *GuildDictEntryGroupBy) Aggregate(fns ...AggregateFunc) *GuildDictEntryGroupBy {
+	gdegb.fns = append(gdegb.fns, fns...)
+	return gdegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gdegb *GuildDictEntryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gdegb.build.ctx, "GroupBy")
+	if err := gdegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GuildDictEntryQuery, *GuildDictEntryGroupBy](ctx, gdegb.build, gdegb, gdegb.build.inters, v)
+}
+
+func (gdegb *GuildDictEntryGroupBy) sqlScan(ctx context.Context, root *GuildDictEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(gdegb.fns))
+	for _, fn := range gdegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*gdegb.flds)+len(gdegb.fns))
+		for _, f := range *gdegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*gdegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gdegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// GuildDictEntrySelect is the builder for selecting fields of GuildDictEntry entities.
+type GuildDictEntrySelect struct {
+	*GuildDictEntryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (gdes *GuildDictEntrySelect) Aggregate(fns ...AggregateFunc) *GuildDictEntrySelect {
+	gdes.fns = append(gdes.fns, fns...)
+	return gdes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (gdes *GuildDictEntrySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, gdes.ctx, "Select")
+	if err := gdes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*GuildDictEntryQuery, *GuildDictEntrySelect](ctx, gdes.GuildDictEntryQuery, gdes, gdes.inters, v)
+}
+
+func (gdes *GuildDictEntrySelect) sqlScan(ctx context.Context, root *GuildDictEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(gdes.fns))
+	for _, fn := range gdes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*gdes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := gdes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/guilddictentry"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// GuildDictEntryUpdate is the builder for updating GuildDictEntry entities.
+type GuildDictEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GuildDictEntryMutation
+}
+
+// Where appends a list predicates to the GuildDictEntryUpdate builder.
+func (gdeu *GuildDictEntryUpdate) Where(ps ...predicate.GuildDictEntry) *GuildDictEntryUpdate {
+	gdeu.mutation.Where(ps...)
+	return gdeu
+}
+
+// SetReading sets the "reading" field.
+func (gdeu *GuildDictEntryUpdate) SetReading(s string) *GuildDictEntryUpdate {
+	gdeu.mutation.SetReading(s)
+	return gdeu
+}
+
+// SetNillableReading sets the "reading" field if the given value is not nil.
+func (gdeu *GuildDictEntryUpdate) SetNillableReading(s *string) *GuildDictEntryUpdate {
+	if s != nil {
+		gdeu.SetReading(*s)
+	}
+	return gdeu
+}
+
+// Mutation returns the GuildDictEntryMutation object of the builder.
+func (gdeu *GuildDictEntryUpdate) Mutation() *GuildDictEntryMutation {
+	return gdeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gdeu *GuildDictEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, gdeu.sqlSave, gdeu.mutation, gdeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gdeu *GuildDictEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := gdeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gdeu *GuildDictEntryUpdate) Exec(ctx context.Context) error {
+	_, err := gdeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gdeu *GuildDictEntryUpdate) ExecX(ctx context.Context) {
+	if err := gdeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gdeu *GuildDictEntryUpdate) check() error {
+	if v, ok := gdeu.mutation.Reading(); ok {
+		if err := guilddictentry.ReadingValidator(v); err != nil {
+			return &ValidationError{Name: "reading", err: fmt.Errorf(`ent: validator failed for field "GuildDictEntry.reading": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gdeu *GuildDictEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gdeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guilddictentry.Table, guilddictentry.Columns, sqlgraph.NewFieldSpec(guilddictentry.FieldID, field.TypeInt))
+	if ps := gdeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gdeu.mutation.Reading(); ok {
+		_spec.SetField(guilddictentry.FieldReading, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gdeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guilddictentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gdeu.mutation.done = true
+	return n, nil
+}
+
+// GuildDictEntryUpdateOne is the builder for updating a single GuildDictEntry entity.
+type GuildDictEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GuildDictEntryMutation
+}
+
+// SetReading sets the "reading" field.
+func (gdeuo *GuildDictEntryUpdateOne) SetReading(s string) *GuildDictEntryUpdateOne {
+	gdeuo.mutation.SetReading(s)
+	return gdeuo
+}
+
+// SetNillableReading sets the "reading" field if the given value is not nil.
+func (gdeuo *GuildDictEntryUpdateOne) SetNillableReading(s *string) *GuildDictEntryUpdateOne {
+	if s != nil {
+		gdeuo.SetReading(*s)
+	}
+	return gdeuo
+}
+
+// Mutation returns the GuildDictEntryMutation object of the builder.
+func (gdeuo *GuildDictEntryUpdateOne) Mutation() *GuildDictEntryMutation {
+	return gdeuo.mutation
+}
+
+// Where appends a list predicates to the GuildDictEntryUpdate builder.
+func (gdeuo *GuildDictEntryUpdateOne) Where(ps ...predicate.GuildDictEntry) *GuildDictEntryUpdateOne {
+	gdeuo.mutation.Where(ps...)
+	return gdeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gdeuo *GuildDictEntryUpdateOne) Select(field string, fields ...string) *GuildDictEntryUpdateOne {
+	gdeuo.fields = append([]string{field}, fields...)
+	return gdeuo
+}
+
+// Save executes the query and returns the updated GuildDictEntry entity.
+func (gdeuo *GuildDictEntryUpdateOne) Save(ctx context.Context) (*GuildDictEntry, error) {
+	return withHooks(ctx, gdeuo.sqlSave, gdeuo.mutation, gdeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gdeuo *GuildDictEntryUpdateOne) SaveX(ctx context.Context) *GuildDictEntry {
+	node, err := gdeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gdeuo *GuildDictEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := gdeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gdeuo *GuildDictEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := gdeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gdeuo *GuildDictEntryUpdateOne) check() error {
+	if v, ok := gdeuo.mutation.Reading(); ok {
+		if err := guilddictentry.ReadingValidator(v); err != nil {
+			return &ValidationError{Name: "reading", err: fmt.Errorf(`ent: validator failed for field "GuildDictEntry.reading": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gdeuo *GuildDictEntryUpdateOne) sqlSave(ctx context.Context) (_node *GuildDictEntry, err error) {
+	if err := gdeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guilddictentry.Table, guilddictentry.Columns, sqlgraph.NewFieldSpec(guilddictentry.FieldID, field.TypeInt))
+	id, ok := gdeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GuildDictEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gdeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, guilddictentry.FieldID)
+		for _, f := range fields {
+			if !guilddictentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != guilddictentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gdeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gdeuo.mutation.Reading(); ok {
+		_spec.SetField(guilddictentry.FieldReading, field.TypeString, value)
+	}
+	_node = &GuildDictEntry{config: gdeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gdeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guilddictentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gdeuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/guildsetting"
+)
+
+// GuildSetting is the model entity for the GuildSetting schema.
+type GuildSetting struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID string `json:"guild_id,omitempty"`
+	// OpusApplication holds the value of the "opus_application" field.
+	OpusApplication *guildsetting.OpusApplication `json:"opus_application,omitempty"`
+	// OpusBitrate holds the value of the "opus_bitrate" field.
+	OpusBitrate *int `json:"opus_bitrate,omitempty"`
+	// OpusComplexity holds the value of the "opus_complexity" field.
+	OpusComplexity *int `json:"opus_complexity,omitempty"`
+	// OpusFec holds the value of the "opus_fec" field.
+	OpusFec *bool `json:"opus_fec,omitempty"`
+	// OpusDtx holds the value of the "opus_dtx" field.
+	OpusDtx *bool `json:"opus_dtx,omitempty"`
+	// ReadBots holds the value of the "read_bots" field.
+	ReadBots *bool `json:"read_bots,omitempty"`
+	// ReadWebhooks holds the value of the "read_webhooks" field.
+	ReadWebhooks *bool `json:"read_webhooks,omitempty"`
+	// NgWordMode holds the value of the "ng_word_mode" field.
+	NgWordMode *guildsetting.NgWordMode `json:"ng_word_mode,omitempty"`
+	// NgWordReplacement holds the value of the "ng_word_replacement" field.
+	NgWordReplacement *string `json:"ng_word_replacement,omitempty"`
+	selectValues      sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GuildSetting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case guildsetting.FieldOpusFec, guildsetting.FieldOpusDtx, guildsetting.FieldReadBots, guildsetting.FieldReadWebhooks:
+			values[i] = new(sql.NullBool)
+		case guildsetting.FieldID, guildsetting.FieldOpusBitrate, guildsetting.FieldOpusComplexity:
+			values[i] = new(sql.NullInt64)
+		case guildsetting.FieldGuildID, guildsetting.FieldOpusApplication, guildsetting.FieldNgWordMode, guildsetting.FieldNgWordReplacement:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GuildSetting fields.
+func (gs *GuildSetting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case guildsetting.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gs.ID = int(value.Int64)
+		case guildsetting.FieldGuildID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				gs.GuildID = value.String
+			}
+		case guildsetting.FieldOpusApplication:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field opus_application", values[i])
+			} else if value.Valid {
+				gs.OpusApplication = new(guildsetting.OpusApplication)
+				*gs.OpusApplication = guildsetting.OpusApplication(value.String)
+			}
+		case guildsetting.FieldOpusBitrate:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field opus_bitrate", values[i])
+			} else if value.Valid {
+				gs.OpusBitrate = new(int)
+				*gs.OpusBitrate = int(value.Int64)
+			}
+		case guildsetting.FieldOpusComplexity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field opus_complexity", values[i])
+			} else if value.Valid {
+				gs.OpusComplexity = new(int)
+				*gs.OpusComplexity = int(value.Int64)
+			}
+		case guildsetting.FieldOpusFec:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field opus_fec", values[i])
+			} else if value.Valid {
+				gs.OpusFec = new(bool)
+				*gs.OpusFec = value.Bool
+			}
+		case guildsetting.FieldOpusDtx:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field opus_dtx", values[i])
+			} else if value.Valid {
+				gs.OpusDtx = new(bool)
+				*gs.OpusDtx = value.Bool
+			}
+		case guildsetting.FieldReadBots:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field read_bots", values[i])
+			} else if value.Valid {
+				gs.ReadBots = new(bool)
+				*gs.ReadBots = value.Bool
+			}
+		case guildsetting.FieldReadWebhooks:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field read_webhooks", values[i])
+			} else if value.Valid {
+				gs.ReadWebhooks = new(bool)
+				*gs.ReadWebhooks = value.Bool
+			}
+		case guildsetting.FieldNgWordMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ng_word_mode", values[i])
+			} else if value.Valid {
+				gs.NgWordMode = new(guildsetting.NgWordMode)
+				*gs.NgWordMode = guildsetting.NgWordMode(value.String)
+			}
+		case guildsetting.FieldNgWordReplacement:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ng_word_replacement", values[i])
+			} else if value.Valid {
+				gs.NgWordReplacement = new(string)
+				*gs.NgWordReplacement = value.String
+			}
+		default:
+			gs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GuildSetting.
+// This includes values selected through modifiers, order, etc.
+func (gs *GuildSetting) Value(name string) (ent.Value, error) {
+	return gs.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GuildSetting.
+// Note that you need to call GuildSetting.Unwrap() before calling this method if this GuildSetting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (gs *GuildSetting) Update() *GuildSettingUpdateOne {
+	return NewGuildSettingClient(gs.config).UpdateOne(gs)
+}
+
+// Unwrap unwraps the GuildSetting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (gs *GuildSetting) Unwrap() *GuildSetting {
+	_tx, ok := gs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GuildSetting is not a transactional entity")
+	}
+	gs.config.driver = _tx.drv
+	return gs
+}
+
+// String implements the fmt.Stringer.
+func (gs *GuildSetting) String() string {
+	var builder strings.Builder
+	builder.WriteString("GuildSetting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", gs.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(gs.GuildID)
+	builder.WriteString(", ")
+	if v := gs.OpusApplication; v != nil {
+		builder.WriteString("opus_application=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gs.OpusBitrate; v != nil {
+		builder.WriteString("opus_bitrate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gs.OpusComplexity; v != nil {
+		builder.WriteString("opus_complexity=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gs.OpusFec; v != nil {
+		builder.WriteString("opus_fec=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gs.OpusDtx; v != nil {
+		builder.WriteString("opus_dtx=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gs.ReadBots; v != nil {
+		builder.WriteString("read_bots=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gs.ReadWebhooks; v != nil {
+		builder.WriteString("read_webhooks=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gs.NgWordMode; v != nil {
+		builder.WriteString("ng_word_mode=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := gs.NgWordReplacement; v != nil {
+		builder.WriteString("ng_word_replacement=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GuildSettings is a parsable slice of GuildSetting.
+type GuildSettings []*GuildSetting
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package guildsetting
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the guildsetting type in the database.
+	Label = "guild_setting"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGuildID holds the string denoting the guild_id field in the database.
+	FieldGuildID = "guild_id"
+	// FieldOpusApplication holds the string denoting the opus_application field in the database.
+	FieldOpusApplication = "opus_application"
+	// FieldOpusBitrate holds the string denoting the opus_bitrate field in the database.
+	FieldOpusBitrate = "opus_bitrate"
+	// FieldOpusComplexity holds the string denoting the opus_complexity field in the database.
+	FieldOpusComplexity = "opus_complexity"
+	// FieldOpusFec holds the string denoting the opus_fec field in the database.
+	FieldOpusFec = "opus_fec"
+	// FieldOpusDtx holds the string denoting the opus_dtx field in the database.
+	FieldOpusDtx = "opus_dtx"
+	// FieldReadBots holds the string denoting the read_bots field in the database.
+	FieldReadBots = "read_bots"
+	// FieldReadWebhooks holds the string denoting the read_webhooks field in the database.
+	FieldReadWebhooks = "read_webhooks"
+	// FieldNgWordMode holds the string denoting the ng_word_mode field in the database.
+	FieldNgWordMode = "ng_word_mode"
+	// FieldNgWordReplacement holds the string denoting the ng_word_replacement field in the database.
+	FieldNgWordReplacement = "ng_word_replacement"
+	// Table holds the table name of the guildsetting in the database.
+	Table = "guild_settings"
+)
+
+// Columns holds all SQL columns for guildsetting fields.
+var Columns = []string{
+	FieldID,
+	FieldGuildID,
+	FieldOpusApplication,
+	FieldOpusBitrate,
+	FieldOpusComplexity,
+	FieldOpusFec,
+	FieldOpusDtx,
+	FieldReadBots,
+	FieldReadWebhooks,
+	FieldNgWordMode,
+	FieldNgWordReplacement,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	GuildIDValidator func(string) error
+)
+
+// OpusApplication defines the type for the "opus_application" enum field.
+type OpusApplication string
+
+// OpusApplication values.
+const (
+	OpusApplicationVoip  OpusApplication = "voip"
+	OpusApplicationAudio OpusApplication = "audio"
+)
+
+func (oa OpusApplication) String() string {
+	return string(oa)
+}
+
+// OpusApplicationValidator is a validator for the "opus_application" field enum values. It is called by the builders before save.
+func OpusApplicationValidator(oa OpusApplication) error {
+	switch oa {
+	case OpusApplicationVoip, OpusApplicationAudio:
+		return nil
+	default:
+		return fmt.Errorf("guildsetting: invalid enum value for opus_application field: %q", oa)
+	}
+}
+
+// NgWordMode defines the type for the "ng_word_mode" enum field.
+type NgWordMode string
+
+// NgWordMode values.
+const (
+	NgWordModeBleep   NgWordMode = "bleep"
+	NgWordModeReplace NgWordMode = "replace"
+	NgWordModeDrop    NgWordMode = "drop"
+)
+
+func (nwm NgWordMode) String() string {
+	return string(nwm)
+}
+
+// NgWordModeValidator is a validator for the "ng_word_mode" field enum values. It is called by the builders before save.
+func NgWordModeValidator(nwm NgWordMode) error {
+	switch nwm {
+	case NgWordModeBleep, NgWordModeReplace, NgWordModeDrop:
+		return nil
+	default:
+		return fmt.Errorf("guildsetting: invalid enum value for ng_word_mode field: %q", nwm)
+	}
+}
+
+// OrderOption defines the ordering options for the GuildSetting queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGuildID orders the results by the guild_id field.
+func ByGuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuildID, opts...).ToFunc()
+}
+
+// ByOpusApplication orders the results by the opus_application field.
+func ByOpusApplication(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpusApplication, opts...).ToFunc()
+}
+
+// ByOpusBitrate orders the results by the opus_bitrate field.
+func ByOpusBitrate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpusBitrate, opts...).ToFunc()
+}
+
+// ByOpusComplexity orders the results by the opus_complexity field.
+func ByOpusComplexity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpusComplexity, opts...).ToFunc()
+}
+
+// ByOpusFec orders the results by the opus_fec field.
+func ByOpusFec(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpusFec, opts...).ToFunc()
+}
+
+// ByOpusDtx orders the results by the opus_dtx field.
+func ByOpusDtx(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpusDtx, opts...).ToFunc()
+}
+
+// ByReadBots orders the results by the read_bots field.
+func ByReadBots(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadBots, opts...).ToFunc()
+}
+
+// ByReadWebhooks orders the results by the read_webhooks field.
+func ByReadWebhooks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadWebhooks, opts...).ToFunc()
+}
+
+// ByNgWordMode orders the results by the ng_word_mode field.
+func ByNgWordMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNgWordMode, opts...).ToFunc()
+}
+
+// ByNgWordReplacement orders the results by the ng_word_replacement field.
+func ByNgWordReplacement(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNgWordReplacement, opts...).ToFunc()
+}
@@ -0,0 +1,488 @@
+// Code generated by ent, DO NOT EDIT.
+
+package guildsetting
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldLTE(FieldID, id))
+}
+
+// GuildID applies equality check predicate on the "guild_id" field. It's identical to GuildIDEQ.
+func GuildID(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldGuildID, v))
+}
+
+// OpusBitrate applies equality check predicate on the "opus_bitrate" field. It's identical to OpusBitrateEQ.
+func OpusBitrate(v int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldOpusBitrate, v))
+}
+
+// OpusComplexity applies equality check predicate on the "opus_complexity" field. It's identical to OpusComplexityEQ.
+func OpusComplexity(v int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldOpusComplexity, v))
+}
+
+// OpusFec applies equality check predicate on the "opus_fec" field. It's identical to OpusFecEQ.
+func OpusFec(v bool) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldOpusFec, v))
+}
+
+// OpusDtx applies equality check predicate on the "opus_dtx" field. It's identical to OpusDtxEQ.
+func OpusDtx(v bool) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldOpusDtx, v))
+}
+
+// ReadBots applies equality check predicate on the "read_bots" field. It's identical to ReadBotsEQ.
+func ReadBots(v bool) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldReadBots, v))
+}
+
+// ReadWebhooks applies equality check predicate on the "read_webhooks" field. It's identical to ReadWebhooksEQ.
+func ReadWebhooks(v bool) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldReadWebhooks, v))
+}
+
+// NgWordReplacement applies equality check predicate on the "ng_word_replacement" field. It's identical to NgWordReplacementEQ.
+func NgWordReplacement(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldNgWordReplacement, v))
+}
+
+// GuildIDEQ applies the EQ predicate on the "guild_id" field.
+func GuildIDEQ(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldGuildID, v))
+}
+
+// GuildIDNEQ applies the NEQ predicate on the "guild_id" field.
+func GuildIDNEQ(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNEQ(FieldGuildID, v))
+}
+
+// GuildIDIn applies the In predicate on the "guild_id" field.
+func GuildIDIn(vs ...string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIn(FieldGuildID, vs...))
+}
+
+// GuildIDNotIn applies the NotIn predicate on the "guild_id" field.
+func GuildIDNotIn(vs ...string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotIn(FieldGuildID, vs...))
+}
+
+// GuildIDGT applies the GT predicate on the "guild_id" field.
+func GuildIDGT(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldGT(FieldGuildID, v))
+}
+
+// GuildIDGTE applies the GTE predicate on the "guild_id" field.
+func GuildIDGTE(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldGTE(FieldGuildID, v))
+}
+
+// GuildIDLT applies the LT predicate on the "guild_id" field.
+func GuildIDLT(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldLT(FieldGuildID, v))
+}
+
+// GuildIDLTE applies the LTE predicate on the "guild_id" field.
+func GuildIDLTE(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldLTE(FieldGuildID, v))
+}
+
+// GuildIDContains applies the Contains predicate on the "guild_id" field.
+func GuildIDContains(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldContains(FieldGuildID, v))
+}
+
+// GuildIDHasPrefix applies the HasPrefix predicate on the "guild_id" field.
+func GuildIDHasPrefix(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldHasPrefix(FieldGuildID, v))
+}
+
+// GuildIDHasSuffix applies the HasSuffix predicate on the "guild_id" field.
+func GuildIDHasSuffix(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldHasSuffix(FieldGuildID, v))
+}
+
+// GuildIDEqualFold applies the EqualFold predicate on the "guild_id" field.
+func GuildIDEqualFold(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEqualFold(FieldGuildID, v))
+}
+
+// GuildIDContainsFold applies the ContainsFold predicate on the "guild_id" field.
+func GuildIDContainsFold(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldContainsFold(FieldGuildID, v))
+}
+
+// OpusApplicationEQ applies the EQ predicate on the "opus_application" field.
+func OpusApplicationEQ(v OpusApplication) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldOpusApplication, v))
+}
+
+// OpusApplicationNEQ applies the NEQ predicate on the "opus_application" field.
+func OpusApplicationNEQ(v OpusApplication) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNEQ(FieldOpusApplication, v))
+}
+
+// OpusApplicationIn applies the In predicate on the "opus_application" field.
+func OpusApplicationIn(vs ...OpusApplication) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIn(FieldOpusApplication, vs...))
+}
+
+// OpusApplicationNotIn applies the NotIn predicate on the "opus_application" field.
+func OpusApplicationNotIn(vs ...OpusApplication) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotIn(FieldOpusApplication, vs...))
+}
+
+// OpusApplicationIsNil applies the IsNil predicate on the "opus_application" field.
+func OpusApplicationIsNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIsNull(FieldOpusApplication))
+}
+
+// OpusApplicationNotNil applies the NotNil predicate on the "opus_application" field.
+func OpusApplicationNotNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotNull(FieldOpusApplication))
+}
+
+// OpusBitrateEQ applies the EQ predicate on the "opus_bitrate" field.
+func OpusBitrateEQ(v int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldOpusBitrate, v))
+}
+
+// OpusBitrateNEQ applies the NEQ predicate on the "opus_bitrate" field.
+func OpusBitrateNEQ(v int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNEQ(FieldOpusBitrate, v))
+}
+
+// OpusBitrateIn applies the In predicate on the "opus_bitrate" field.
+func OpusBitrateIn(vs ...int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIn(FieldOpusBitrate, vs...))
+}
+
+// OpusBitrateNotIn applies the NotIn predicate on the "opus_bitrate" field.
+func OpusBitrateNotIn(vs ...int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotIn(FieldOpusBitrate, vs...))
+}
+
+// OpusBitrateGT applies the GT predicate on the "opus_bitrate" field.
+func OpusBitrateGT(v int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldGT(FieldOpusBitrate, v))
+}
+
+// OpusBitrateGTE applies the GTE predicate on the "opus_bitrate" field.
+func OpusBitrateGTE(v int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldGTE(FieldOpusBitrate, v))
+}
+
+// OpusBitrateLT applies the LT predicate on the "opus_bitrate" field.
+func OpusBitrateLT(v int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldLT(FieldOpusBitrate, v))
+}
+
+// OpusBitrateLTE applies the LTE predicate on the "opus_bitrate" field.
+func OpusBitrateLTE(v int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldLTE(FieldOpusBitrate, v))
+}
+
+// OpusBitrateIsNil applies the IsNil predicate on the "opus_bitrate" field.
+func OpusBitrateIsNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIsNull(FieldOpusBitrate))
+}
+
+// OpusBitrateNotNil applies the NotNil predicate on the "opus_bitrate" field.
+func OpusBitrateNotNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotNull(FieldOpusBitrate))
+}
+
+// OpusComplexityEQ applies the EQ predicate on the "opus_complexity" field.
+func OpusComplexityEQ(v int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldOpusComplexity, v))
+}
+
+// OpusComplexityNEQ applies the NEQ predicate on the "opus_complexity" field.
+func OpusComplexityNEQ(v int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNEQ(FieldOpusComplexity, v))
+}
+
+// OpusComplexityIn applies the In predicate on the "opus_complexity" field.
+func OpusComplexityIn(vs ...int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIn(FieldOpusComplexity, vs...))
+}
+
+// OpusComplexityNotIn applies the NotIn predicate on the "opus_complexity" field.
+func OpusComplexityNotIn(vs ...int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotIn(FieldOpusComplexity, vs...))
+}
+
+// OpusComplexityGT applies the GT predicate on the "opus_complexity" field.
+func OpusComplexityGT(v int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldGT(FieldOpusComplexity, v))
+}
+
+// OpusComplexityGTE applies the GTE predicate on the "opus_complexity" field.
+func OpusComplexityGTE(v int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldGTE(FieldOpusComplexity, v))
+}
+
+// OpusComplexityLT applies the LT predicate on the "opus_complexity" field.
+func OpusComplexityLT(v int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldLT(FieldOpusComplexity, v))
+}
+
+// OpusComplexityLTE applies the LTE predicate on the "opus_complexity" field.
+func OpusComplexityLTE(v int) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldLTE(FieldOpusComplexity, v))
+}
+
+// OpusComplexityIsNil applies the IsNil predicate on the "opus_complexity" field.
+func OpusComplexityIsNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIsNull(FieldOpusComplexity))
+}
+
+// OpusComplexityNotNil applies the NotNil predicate on the "opus_complexity" field.
+func OpusComplexityNotNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotNull(FieldOpusComplexity))
+}
+
+// OpusFecEQ applies the EQ predicate on the "opus_fec" field.
+func OpusFecEQ(v bool) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldOpusFec, v))
+}
+
+// OpusFecNEQ applies the NEQ predicate on the "opus_fec" field.
+func OpusFecNEQ(v bool) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNEQ(FieldOpusFec, v))
+}
+
+// OpusFecIsNil applies the IsNil predicate on the "opus_fec" field.
+func OpusFecIsNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIsNull(FieldOpusFec))
+}
+
+// OpusFecNotNil applies the NotNil predicate on the "opus_fec" field.
+func OpusFecNotNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotNull(FieldOpusFec))
+}
+
+// OpusDtxEQ applies the EQ predicate on the "opus_dtx" field.
+func OpusDtxEQ(v bool) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldOpusDtx, v))
+}
+
+// OpusDtxNEQ applies the NEQ predicate on the "opus_dtx" field.
+func OpusDtxNEQ(v bool) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNEQ(FieldOpusDtx, v))
+}
+
+// OpusDtxIsNil applies the IsNil predicate on the "opus_dtx" field.
+func OpusDtxIsNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIsNull(FieldOpusDtx))
+}
+
+// OpusDtxNotNil applies the NotNil predicate on the "opus_dtx" field.
+func OpusDtxNotNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotNull(FieldOpusDtx))
+}
+
+// ReadBotsEQ applies the EQ predicate on the "read_bots" field.
+func ReadBotsEQ(v bool) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldReadBots, v))
+}
+
+// ReadBotsNEQ applies the NEQ predicate on the "read_bots" field.
+func ReadBotsNEQ(v bool) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNEQ(FieldReadBots, v))
+}
+
+// ReadBotsIsNil applies the IsNil predicate on the "read_bots" field.
+func ReadBotsIsNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIsNull(FieldReadBots))
+}
+
+// ReadBotsNotNil applies the NotNil predicate on the "read_bots" field.
+func ReadBotsNotNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotNull(FieldReadBots))
+}
+
+// ReadWebhooksEQ applies the EQ predicate on the "read_webhooks" field.
+func ReadWebhooksEQ(v bool) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldReadWebhooks, v))
+}
+
+// ReadWebhooksNEQ applies the NEQ predicate on the "read_webhooks" field.
+func ReadWebhooksNEQ(v bool) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNEQ(FieldReadWebhooks, v))
+}
+
+// ReadWebhooksIsNil applies the IsNil predicate on the "read_webhooks" field.
+func ReadWebhooksIsNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIsNull(FieldReadWebhooks))
+}
+
+// ReadWebhooksNotNil applies the NotNil predicate on the "read_webhooks" field.
+func ReadWebhooksNotNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotNull(FieldReadWebhooks))
+}
+
+// NgWordModeEQ applies the EQ predicate on the "ng_word_mode" field.
+func NgWordModeEQ(v NgWordMode) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldNgWordMode, v))
+}
+
+// NgWordModeNEQ applies the NEQ predicate on the "ng_word_mode" field.
+func NgWordModeNEQ(v NgWordMode) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNEQ(FieldNgWordMode, v))
+}
+
+// NgWordModeIn applies the In predicate on the "ng_word_mode" field.
+func NgWordModeIn(vs ...NgWordMode) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIn(FieldNgWordMode, vs...))
+}
+
+// NgWordModeNotIn applies the NotIn predicate on the "ng_word_mode" field.
+func NgWordModeNotIn(vs ...NgWordMode) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotIn(FieldNgWordMode, vs...))
+}
+
+// NgWordModeIsNil applies the IsNil predicate on the "ng_word_mode" field.
+func NgWordModeIsNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIsNull(FieldNgWordMode))
+}
+
+// NgWordModeNotNil applies the NotNil predicate on the "ng_word_mode" field.
+func NgWordModeNotNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotNull(FieldNgWordMode))
+}
+
+// NgWordReplacementEQ applies the EQ predicate on the "ng_word_replacement" field.
+func NgWordReplacementEQ(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEQ(FieldNgWordReplacement, v))
+}
+
+// NgWordReplacementNEQ applies the NEQ predicate on the "ng_word_replacement" field.
+func NgWordReplacementNEQ(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNEQ(FieldNgWordReplacement, v))
+}
+
+// NgWordReplacementIn applies the In predicate on the "ng_word_replacement" field.
+func NgWordReplacementIn(vs ...string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIn(FieldNgWordReplacement, vs...))
+}
+
+// NgWordReplacementNotIn applies the NotIn predicate on the "ng_word_replacement" field.
+func NgWordReplacementNotIn(vs ...string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotIn(FieldNgWordReplacement, vs...))
+}
+
+// NgWordReplacementGT applies the GT predicate on the "ng_word_replacement" field.
+func NgWordReplacementGT(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldGT(FieldNgWordReplacement, v))
+}
+
+// NgWordReplacementGTE applies the GTE predicate on the "ng_word_replacement" field.
+func NgWordReplacementGTE(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldGTE(FieldNgWordReplacement, v))
+}
+
+// NgWordReplacementLT applies the LT predicate on the "ng_word_replacement" field.
+func NgWordReplacementLT(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldLT(FieldNgWordReplacement, v))
+}
+
+// NgWordReplacementLTE applies the LTE predicate on the "ng_word_replacement" field.
+func NgWordReplacementLTE(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldLTE(FieldNgWordReplacement, v))
+}
+
+// NgWordReplacementContains applies the Contains predicate on the "ng_word_replacement" field.
+func NgWordReplacementContains(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldContains(FieldNgWordReplacement, v))
+}
+
+// NgWordReplacementHasPrefix applies the HasPrefix predicate on the "ng_word_replacement" field.
+func NgWordReplacementHasPrefix(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldHasPrefix(FieldNgWordReplacement, v))
+}
+
+// NgWordReplacementHasSuffix applies the HasSuffix predicate on the "ng_word_replacement" field.
+func NgWordReplacementHasSuffix(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldHasSuffix(FieldNgWordReplacement, v))
+}
+
+// NgWordReplacementIsNil applies the IsNil predicate on the "ng_word_replacement" field.
+func NgWordReplacementIsNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldIsNull(FieldNgWordReplacement))
+}
+
+// NgWordReplacementNotNil applies the NotNil predicate on the "ng_word_replacement" field.
+func NgWordReplacementNotNil() predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldNotNull(FieldNgWordReplacement))
+}
+
+// NgWordReplacementEqualFold applies the EqualFold predicate on the "ng_word_replacement" field.
+func NgWordReplacementEqualFold(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldEqualFold(FieldNgWordReplacement, v))
+}
+
+// NgWordReplacementContainsFold applies the ContainsFold predicate on the "ng_word_replacement" field.
+func NgWordReplacementContainsFold(v string) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.FieldContainsFold(FieldNgWordReplacement, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GuildSetting) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GuildSetting) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GuildSetting) predicate.GuildSetting {
+	return predicate.GuildSetting(sql.NotPredicates(p))
+}
@@ -0,0 +1,360 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/guildsetting"
+)
+
+// GuildSettingCreate is the builder for creating a GuildSetting entity.
+type GuildSettingCreate struct {
+	config
+	mutation *GuildSettingMutation
+	hooks    []Hook
+}
+
+// SetGuildID sets the "guild_id" field.
+func (gsc *GuildSettingCreate) SetGuildID(s string) *GuildSettingCreate {
+	gsc.mutation.SetGuildID(s)
+	return gsc
+}
+
+// SetOpusApplication sets the "opus_application" field.
+func (gsc *GuildSettingCreate) SetOpusApplication(ga guildsetting.OpusApplication) *GuildSettingCreate {
+	gsc.mutation.SetOpusApplication(ga)
+	return gsc
+}
+
+// SetNillableOpusApplication sets the "opus_application" field if the given value is not nil.
+func (gsc *GuildSettingCreate) SetNillableOpusApplication(ga *guildsetting.OpusApplication) *GuildSettingCreate {
+	if ga != nil {
+		gsc.SetOpusApplication(*ga)
+	}
+	return gsc
+}
+
+// SetOpusBitrate sets the "opus_bitrate" field.
+func (gsc *GuildSettingCreate) SetOpusBitrate(i int) *GuildSettingCreate {
+	gsc.mutation.SetOpusBitrate(i)
+	return gsc
+}
+
+// SetNillableOpusBitrate sets the "opus_bitrate" field if the given value is not nil.
+func (gsc *GuildSettingCreate) SetNillableOpusBitrate(i *int) *GuildSettingCreate {
+	if i != nil {
+		gsc.SetOpusBitrate(*i)
+	}
+	return gsc
+}
+
+// SetOpusComplexity sets the "opus_complexity" field.
+func (gsc *GuildSettingCreate) SetOpusComplexity(i int) *GuildSettingCreate {
+	gsc.mutation.SetOpusComplexity(i)
+	return gsc
+}
+
+// SetNillableOpusComplexity sets the "opus_complexity" field if the given value is not nil.
+func (gsc *GuildSettingCreate) SetNillableOpusComplexity(i *int) *GuildSettingCreate {
+	if i != nil {
+		gsc.SetOpusComplexity(*i)
+	}
+	return gsc
+}
+
+// SetOpusFec sets the "opus_fec" field.
+func (gsc *GuildSettingCreate) SetOpusFec(b bool) *GuildSettingCreate {
+	gsc.mutation.SetOpusFec(b)
+	return gsc
+}
+
+// SetNillableOpusFec sets the "opus_fec" field if the given value is not nil.
+func (gsc *GuildSettingCreate) SetNillableOpusFec(b *bool) *GuildSettingCreate {
+	if b != nil {
+		gsc.SetOpusFec(*b)
+	}
+	return gsc
+}
+
+// SetOpusDtx sets the "opus_dtx" field.
+func (gsc *GuildSettingCreate) SetOpusDtx(b bool) *GuildSettingCreate {
+	gsc.mutation.SetOpusDtx(b)
+	return gsc
+}
+
+// SetNillableOpusDtx sets the "opus_dtx" field if the given value is not nil.
+func (gsc *GuildSettingCreate) SetNillableOpusDtx(b *bool) *GuildSettingCreate {
+	if b != nil {
+		gsc.SetOpusDtx(*b)
+	}
+	return gsc
+}
+
+// SetReadBots sets the "read_bots" field.
+func (gsc *GuildSettingCreate) SetReadBots(b bool) *GuildSettingCreate {
+	gsc.mutation.SetReadBots(b)
+	return gsc
+}
+
+// SetNillableReadBots sets the "read_bots" field if the given value is not nil.
+func (gsc *GuildSettingCreate) SetNillableReadBots(b *bool) *GuildSettingCreate {
+	if b != nil {
+		gsc.SetReadBots(*b)
+	}
+	return gsc
+}
+
+// SetReadWebhooks sets the "read_webhooks" field.
+func (gsc *GuildSettingCreate) SetReadWebhooks(b bool) *GuildSettingCreate {
+	gsc.mutation.SetReadWebhooks(b)
+	return gsc
+}
+
+// SetNillableReadWebhooks sets the "read_webhooks" field if the given value is not nil.
+func (gsc *GuildSettingCreate) SetNillableReadWebhooks(b *bool) *GuildSettingCreate {
+	if b != nil {
+		gsc.SetReadWebhooks(*b)
+	}
+	return gsc
+}
+
+// SetNgWordMode sets the "ng_word_mode" field.
+func (gsc *GuildSettingCreate) SetNgWordMode(gwm guildsetting.NgWordMode) *GuildSettingCreate {
+	gsc.mutation.SetNgWordMode(gwm)
+	return gsc
+}
+
+// SetNillableNgWordMode sets the "ng_word_mode" field if the given value is not nil.
+func (gsc *GuildSettingCreate) SetNillableNgWordMode(gwm *guildsetting.NgWordMode) *GuildSettingCreate {
+	if gwm != nil {
+		gsc.SetNgWordMode(*gwm)
+	}
+	return gsc
+}
+
+// SetNgWordReplacement sets the "ng_word_replacement" field.
+func (gsc *GuildSettingCreate) SetNgWordReplacement(s string) *GuildSettingCreate {
+	gsc.mutation.SetNgWordReplacement(s)
+	return gsc
+}
+
+// SetNillableNgWordReplacement sets the "ng_word_replacement" field if the given value is not nil.
+func (gsc *GuildSettingCreate) SetNillableNgWordReplacement(s *string) *GuildSettingCreate {
+	if s != nil {
+		gsc.SetNgWordReplacement(*s)
+	}
+	return gsc
+}
+
+// Mutation returns the GuildSettingMutation object of the builder.
+func (gsc *GuildSettingCreate) Mutation() *GuildSettingMutation {
+	return gsc.mutation
+}
+
+// Save creates the GuildSetting in the database.
+func (gsc *GuildSettingCreate) Save(ctx context.Context) (*GuildSetting, error) {
+	return withHooks(ctx, gsc.sqlSave, gsc.mutation, gsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (gsc *GuildSettingCreate) SaveX(ctx context.Context) *GuildSetting {
+	v, err := gsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gsc *GuildSettingCreate) Exec(ctx context.Context) error {
+	_, err := gsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gsc *GuildSettingCreate) ExecX(ctx context.Context) {
+	if err := gsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gsc *GuildSettingCreate) check() error {
+	if _, ok := gsc.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "GuildSetting.guild_id"`)}
+	}
+	if v, ok := gsc.mutation.GuildID(); ok {
+		if err := guildsetting.GuildIDValidator(v); err != nil {
+			return &ValidationError{Name: "guild_id", err: fmt.Errorf(`ent: validator failed for field "GuildSetting.guild_id": %w`, err)}
+		}
+	}
+	if v, ok := gsc.mutation.OpusApplication(); ok {
+		if err := guildsetting.OpusApplicationValidator(v); err != nil {
+			return &ValidationError{Name: "opus_application", err: fmt.Errorf(`ent: validator failed for field "GuildSetting.opus_application": %w`, err)}
+		}
+	}
+	if v, ok := gsc.mutation.NgWordMode(); ok {
+		if err := guildsetting.NgWordModeValidator(v); err != nil {
+			return &ValidationError{Name: "ng_word_mode", err: fmt.Errorf(`ent: validator failed for field "GuildSetting.ng_word_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gsc *GuildSettingCreate) sqlSave(ctx context.Context) (*GuildSetting, error) {
+	if err := gsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := gsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	gsc.mutation.id = &_node.ID
+	gsc.mutation.done = true
+	return _node, nil
+}
+
+func (gsc *GuildSettingCreate) createSpec() (*GuildSetting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GuildSetting{config: gsc.config}
+		_spec = sqlgraph.NewCreateSpec(guildsetting.Table, sqlgraph.NewFieldSpec(guildsetting.FieldID, field.TypeInt))
+	)
+	if value, ok := gsc.mutation.GuildID(); ok {
+		_spec.SetField(guildsetting.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := gsc.mutation.OpusApplication(); ok {
+		_spec.SetField(guildsetting.FieldOpusApplication, field.TypeEnum, value)
+		_node.OpusApplication = &value
+	}
+	if value, ok := gsc.mutation.OpusBitrate(); ok {
+		_spec.SetField(guildsetting.FieldOpusBitrate, field.TypeInt, value)
+		_node.OpusBitrate = &value
+	}
+	if value, ok := gsc.mutation.OpusComplexity(); ok {
+		_spec.SetField(guildsetting.FieldOpusComplexity, field.TypeInt, value)
+		_node.OpusComplexity = &value
+	}
+	if value, ok := gsc.mutation.OpusFec(); ok {
+		_spec.SetField(guildsetting.FieldOpusFec, field.TypeBool, value)
+		_node.OpusFec = &value
+	}
+	if value, ok := gsc.mutation.OpusDtx(); ok {
+		_spec.SetField(guildsetting.FieldOpusDtx, field.TypeBool, value)
+		_node.OpusDtx = &value
+	}
+	if value, ok := gsc.mutation.ReadBots(); ok {
+		_spec.SetField(guildsetting.FieldReadBots, field.TypeBool, value)
+		_node.ReadBots = &value
+	}
+	if value, ok := gsc.mutation.ReadWebhooks(); ok {
+		_spec.SetField(guildsetting.FieldReadWebhooks, field.TypeBool, value)
+		_node.ReadWebhooks = &value
+	}
+	if value, ok := gsc.mutation.NgWordMode(); ok {
+		_spec.SetField(guildsetting.FieldNgWordMode, field.TypeEnum, value)
+		_node.NgWordMode = &value
+	}
+	if value, ok := gsc.mutation.NgWordReplacement(); ok {
+		_spec.SetField(guildsetting.FieldNgWordReplacement, field.TypeString, value)
+		_node.NgWordReplacement = &value
+	}
+	return _node, _spec
+}
+
+// GuildSettingCreateBulk is the builder for creating many GuildSetting entities in bulk.
+type GuildSettingCreateBulk struct {
+	config
+	err      error
+	builders []*GuildSettingCreate
+}
+
+// Save creates the GuildSetting entities in the database.
+func (gscb *GuildSettingCreateBulk) Save(ctx context.Context) ([]*GuildSetting, error) {
+	if gscb.err != nil {
+		return nil, gscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(gscb.builders))
+	nodes := make([]*GuildSetting, len(gscb.builders))
+	mutators := make([]Mutator, len(gscb.builders))
+	for i := range gscb.builders {
+		func(i int, root context.Context) {
+			builder := gscb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GuildSettingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, gscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gscb *GuildSettingCreateBulk) SaveX(ctx context.Context) []*GuildSetting {
+	v, err := gscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (gscb *GuildSettingCreateBulk) Exec(ctx context.Context) error {
+	_, err := gscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gscb *GuildSettingCreateBulk) ExecX(ctx context.Context) {
+	if err := gscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/guildsetting"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// GuildSettingDelete is the builder for deleting a GuildSetting entity.
+type GuildSettingDelete struct {
+	config
+	hooks    []Hook
+	mutation *GuildSettingMutation
+}
+
+// Where appends a list predicates to the GuildSettingDelete builder.
+func (gsd *GuildSettingDelete) Where(ps ...predicate.GuildSetting) *GuildSettingDelete {
+	gsd.mutation.Where(ps...)
+	return gsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (gsd *GuildSettingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, gsd.sqlExec, gsd.mutation, gsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gsd *GuildSettingDelete) ExecX(ctx context.Context) int {
+	n, err := gsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (gsd *GuildSettingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(guildsetting.Table, sqlgraph.NewFieldSpec(guildsetting.FieldID, field.TypeInt))
+	if ps := gsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, gsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	gsd.mutation.done = true
+	return affected, err
+}
+
+// GuildSettingDeleteOne is the builder for deleting a single GuildSetting entity.
+type GuildSettingDeleteOne struct {
+	gsd *GuildSettingDelete
+}
+
+// Where appends a list predicates to the GuildSettingDelete builder.
+func (gsdo *GuildSettingDeleteOne) Where(ps ...predicate.GuildSetting) *GuildSettingDeleteOne {
+	gsdo.gsd.mutation.Where(ps...)
+	return gsdo
+}
+
+// Exec executes the deletion query.
+func (gsdo *GuildSettingDeleteOne) Exec(ctx context.Context) error {
+	n, err := gsdo.gsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{guildsetting.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gsdo *GuildSettingDeleteOne) ExecX(ctx context.Context) {
+	if err := gsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,719 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/guildsetting"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// GuildSettingUpdate is the builder for updating GuildSetting entities.
+type GuildSettingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GuildSettingMutation
+}
+
+// Where appends a list predicates to the GuildSettingUpdate builder.
+func (gsu *GuildSettingUpdate) Where(ps ...predicate.GuildSetting) *GuildSettingUpdate {
+	gsu.mutation.Where(ps...)
+	return gsu
+}
+
+// SetOpusApplication sets the "opus_application" field.
+func (gsu *GuildSettingUpdate) SetOpusApplication(ga guildsetting.OpusApplication) *GuildSettingUpdate {
+	gsu.mutation.SetOpusApplication(ga)
+	return gsu
+}
+
+// SetNillableOpusApplication sets the "opus_application" field if the given value is not nil.
+func (gsu *GuildSettingUpdate) SetNillableOpusApplication(ga *guildsetting.OpusApplication) *GuildSettingUpdate {
+	if ga != nil {
+		gsu.SetOpusApplication(*ga)
+	}
+	return gsu
+}
+
+// ClearOpusApplication clears the value of the "opus_application" field.
+func (gsu *GuildSettingUpdate) ClearOpusApplication() *GuildSettingUpdate {
+	gsu.mutation.ClearOpusApplication()
+	return gsu
+}
+
+// SetOpusBitrate sets the "opus_bitrate" field.
+func (gsu *GuildSettingUpdate) SetOpusBitrate(i int) *GuildSettingUpdate {
+	gsu.mutation.ResetOpusBitrate()
+	gsu.mutation.SetOpusBitrate(i)
+	return gsu
+}
+
+// SetNillableOpusBitrate sets the "opus_bitrate" field if the given value is not nil.
+func (gsu *GuildSettingUpdate) SetNillableOpusBitrate(i *int) *GuildSettingUpdate {
+	if i != nil {
+		gsu.SetOpusBitrate(*i)
+	}
+	return gsu
+}
+
+// AddOpusBitrate adds i to the "opus_bitrate" field.
+func (gsu *GuildSettingUpdate) AddOpusBitrate(i int) *GuildSettingUpdate {
+	gsu.mutation.AddOpusBitrate(i)
+	return gsu
+}
+
+// ClearOpusBitrate clears the value of the "opus_bitrate" field.
+func (gsu *GuildSettingUpdate) ClearOpusBitrate() *GuildSettingUpdate {
+	gsu.mutation.ClearOpusBitrate()
+	return gsu
+}
+
+// SetOpusComplexity sets the "opus_complexity" field.
+func (gsu *GuildSettingUpdate) SetOpusComplexity(i int) *GuildSettingUpdate {
+	gsu.mutation.ResetOpusComplexity()
+	gsu.mutation.SetOpusComplexity(i)
+	return gsu
+}
+
+// SetNillableOpusComplexity sets the "opus_complexity" field if the given value is not nil.
+func (gsu *GuildSettingUpdate) SetNillableOpusComplexity(i *int) *GuildSettingUpdate {
+	if i != nil {
+		gsu.SetOpusComplexity(*i)
+	}
+	return gsu
+}
+
+// AddOpusComplexity adds i to the "opus_complexity" field.
+func (gsu *GuildSettingUpdate) AddOpusComplexity(i int) *GuildSettingUpdate {
+	gsu.mutation.AddOpusComplexity(i)
+	return gsu
+}
+
+// ClearOpusComplexity clears the value of the "opus_complexity" field.
+func (gsu *GuildSettingUpdate) ClearOpusComplexity() *GuildSettingUpdate {
+	gsu.mutation.ClearOpusComplexity()
+	return gsu
+}
+
+// SetOpusFec sets the "opus_fec" field.
+func (gsu *GuildSettingUpdate) SetOpusFec(b bool) *GuildSettingUpdate {
+	gsu.mutation.SetOpusFec(b)
+	return gsu
+}
+
+// SetNillableOpusFec sets the "opus_fec" field if the given value is not nil.
+func (gsu *GuildSettingUpdate) SetNillableOpusFec(b *bool) *GuildSettingUpdate {
+	if b != nil {
+		gsu.SetOpusFec(*b)
+	}
+	return gsu
+}
+
+// ClearOpusFec clears the value of the "opus_fec" field.
+func (gsu *GuildSettingUpdate) ClearOpusFec() *GuildSettingUpdate {
+	gsu.mutation.ClearOpusFec()
+	return gsu
+}
+
+// SetOpusDtx sets the "opus_dtx" field.
+func (gsu *GuildSettingUpdate) SetOpusDtx(b bool) *GuildSettingUpdate {
+	gsu.mutation.SetOpusDtx(b)
+	return gsu
+}
+
+// SetNillableOpusDtx sets the "opus_dtx" field if the given value is not nil.
+func (gsu *GuildSettingUpdate) SetNillableOpusDtx(b *bool) *GuildSettingUpdate {
+	if b != nil {
+		gsu.SetOpusDtx(*b)
+	}
+	return gsu
+}
+
+// ClearOpusDtx clears the value of the "opus_dtx" field.
+func (gsu *GuildSettingUpdate) ClearOpusDtx() *GuildSettingUpdate {
+	gsu.mutation.ClearOpusDtx()
+	return gsu
+}
+
+// SetReadBots sets the "read_bots" field.
+func (gsu *GuildSettingUpdate) SetReadBots(b bool) *GuildSettingUpdate {
+	gsu.mutation.SetReadBots(b)
+	return gsu
+}
+
+// SetNillableReadBots sets the "read_bots" field if the given value is not nil.
+func (gsu *GuildSettingUpdate) SetNillableReadBots(b *bool) *GuildSettingUpdate {
+	if b != nil {
+		gsu.SetReadBots(*b)
+	}
+	return gsu
+}
+
+// ClearReadBots clears the value of the "read_bots" field.
+func (gsu *GuildSettingUpdate) ClearReadBots() *GuildSettingUpdate {
+	gsu.mutation.ClearReadBots()
+	return gsu
+}
+
+// SetReadWebhooks sets the "read_webhooks" field.
+func (gsu *GuildSettingUpdate) SetReadWebhooks(b bool) *GuildSettingUpdate {
+	gsu.mutation.SetReadWebhooks(b)
+	return gsu
+}
+
+// SetNillableReadWebhooks sets the "read_webhooks" field if the given value is not nil.
+func (gsu *GuildSettingUpdate) SetNillableReadWebhooks(b *bool) *GuildSettingUpdate {
+	if b != nil {
+		gsu.SetReadWebhooks(*b)
+	}
+	return gsu
+}
+
+// ClearReadWebhooks clears the value of the "read_webhooks" field.
+func (gsu *GuildSettingUpdate) ClearReadWebhooks() *GuildSettingUpdate {
+	gsu.mutation.ClearReadWebhooks()
+	return gsu
+}
+
+// SetNgWordMode sets the "ng_word_mode" field.
+func (gsu *GuildSettingUpdate) SetNgWordMode(gwm guildsetting.NgWordMode) *GuildSettingUpdate {
+	gsu.mutation.SetNgWordMode(gwm)
+	return gsu
+}
+
+// SetNillableNgWordMode sets the "ng_word_mode" field if the given value is not nil.
+func (gsu *GuildSettingUpdate) SetNillableNgWordMode(gwm *guildsetting.NgWordMode) *GuildSettingUpdate {
+	if gwm != nil {
+		gsu.SetNgWordMode(*gwm)
+	}
+	return gsu
+}
+
+// ClearNgWordMode clears the value of the "ng_word_mode" field.
+func (gsu *GuildSettingUpdate) ClearNgWordMode() *GuildSettingUpdate {
+	gsu.mutation.ClearNgWordMode()
+	return gsu
+}
+
+// SetNgWordReplacement sets the "ng_word_replacement" field.
+func (gsu *GuildSettingUpdate) SetNgWordReplacement(s string) *GuildSettingUpdate {
+	gsu.mutation.SetNgWordReplacement(s)
+	return gsu
+}
+
+// SetNillableNgWordReplacement sets the "ng_word_replacement" field if the given value is not nil.
+func (gsu *GuildSettingUpdate) SetNillableNgWordReplacement(s *string) *GuildSettingUpdate {
+	if s != nil {
+		gsu.SetNgWordReplacement(*s)
+	}
+	return gsu
+}
+
+// ClearNgWordReplacement clears the value of the "ng_word_replacement" field.
+func (gsu *GuildSettingUpdate) ClearNgWordReplacement() *GuildSettingUpdate {
+	gsu.mutation.ClearNgWordReplacement()
+	return gsu
+}
+
+// Mutation returns the GuildSettingMutation object of the builder.
+func (gsu *GuildSettingUpdate) Mutation() *GuildSettingMutation {
+	return gsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (gsu *GuildSettingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, gsu.sqlSave, gsu.mutation, gsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gsu *GuildSettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := gsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (gsu *GuildSettingUpdate) Exec(ctx context.Context) error {
+	_, err := gsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gsu *GuildSettingUpdate) ExecX(ctx context.Context) {
+	if err := gsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gsu *GuildSettingUpdate) check() error {
+	if v, ok := gsu.mutation.OpusApplication(); ok {
+		if err := guildsetting.OpusApplicationValidator(v); err != nil {
+			return &ValidationError{Name: "opus_application", err: fmt.Errorf(`ent: validator failed for field "GuildSetting.opus_application": %w`, err)}
+		}
+	}
+	if v, ok := gsu.mutation.NgWordMode(); ok {
+		if err := guildsetting.NgWordModeValidator(v); err != nil {
+			return &ValidationError{Name: "ng_word_mode", err: fmt.Errorf(`ent: validator failed for field "GuildSetting.ng_word_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gsu *GuildSettingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := gsu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guildsetting.Table, guildsetting.Columns, sqlgraph.NewFieldSpec(guildsetting.FieldID, field.TypeInt))
+	if ps := gsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gsu.mutation.OpusApplication(); ok {
+		_spec.SetField(guildsetting.FieldOpusApplication, field.TypeEnum, value)
+	}
+	if gsu.mutation.OpusApplicationCleared() {
+		_spec.ClearField(guildsetting.FieldOpusApplication, field.TypeEnum)
+	}
+	if value, ok := gsu.mutation.OpusBitrate(); ok {
+		_spec.SetField(guildsetting.FieldOpusBitrate, field.TypeInt, value)
+	}
+	if value, ok := gsu.mutation.AddedOpusBitrate(); ok {
+		_spec.AddField(guildsetting.FieldOpusBitrate, field.TypeInt, value)
+	}
+	if gsu.mutation.OpusBitrateCleared() {
+		_spec.ClearField(guildsetting.FieldOpusBitrate, field.TypeInt)
+	}
+	if value, ok := gsu.mutation.OpusComplexity(); ok {
+		_spec.SetField(guildsetting.FieldOpusComplexity, field.TypeInt, value)
+	}
+	if value, ok := gsu.mutation.AddedOpusComplexity(); ok {
+		_spec.AddField(guildsetting.FieldOpusComplexity, field.TypeInt, value)
+	}
+	if gsu.mutation.OpusComplexityCleared() {
+		_spec.ClearField(guildsetting.FieldOpusComplexity, field.TypeInt)
+	}
+	if value, ok := gsu.mutation.OpusFec(); ok {
+		_spec.SetField(guildsetting.FieldOpusFec, field.TypeBool, value)
+	}
+	if gsu.mutation.OpusFecCleared() {
+		_spec.ClearField(guildsetting.FieldOpusFec, field.TypeBool)
+	}
+	if value, ok := gsu.mutation.OpusDtx(); ok {
+		_spec.SetField(guildsetting.FieldOpusDtx, field.TypeBool, value)
+	}
+	if gsu.mutation.OpusDtxCleared() {
+		_spec.ClearField(guildsetting.FieldOpusDtx, field.TypeBool)
+	}
+	if value, ok := gsu.mutation.ReadBots(); ok {
+		_spec.SetField(guildsetting.FieldReadBots, field.TypeBool, value)
+	}
+	if gsu.mutation.ReadBotsCleared() {
+		_spec.ClearField(guildsetting.FieldReadBots, field.TypeBool)
+	}
+	if value, ok := gsu.mutation.ReadWebhooks(); ok {
+		_spec.SetField(guildsetting.FieldReadWebhooks, field.TypeBool, value)
+	}
+	if gsu.mutation.ReadWebhooksCleared() {
+		_spec.ClearField(guildsetting.FieldReadWebhooks, field.TypeBool)
+	}
+	if value, ok := gsu.mutation.NgWordMode(); ok {
+		_spec.SetField(guildsetting.FieldNgWordMode, field.TypeEnum, value)
+	}
+	if gsu.mutation.NgWordModeCleared() {
+		_spec.ClearField(guildsetting.FieldNgWordMode, field.TypeEnum)
+	}
+	if value, ok := gsu.mutation.NgWordReplacement(); ok {
+		_spec.SetField(guildsetting.FieldNgWordReplacement, field.TypeString, value)
+	}
+	if gsu.mutation.NgWordReplacementCleared() {
+		_spec.ClearField(guildsetting.FieldNgWordReplacement, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, gsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guildsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	gsu.mutation.done = true
+	return n, nil
+}
+
+// GuildSettingUpdateOne is the builder for updating a single GuildSetting entity.
+type GuildSettingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GuildSettingMutation
+}
+
+// SetOpusApplication sets the "opus_application" field.
+func (gsuo *GuildSettingUpdateOne) SetOpusApplication(ga guildsetting.OpusApplication) *GuildSettingUpdateOne {
+	gsuo.mutation.SetOpusApplication(ga)
+	return gsuo
+}
+
+// SetNillableOpusApplication sets the "opus_application" field if the given value is not nil.
+func (gsuo *GuildSettingUpdateOne) SetNillableOpusApplication(ga *guildsetting.OpusApplication) *GuildSettingUpdateOne {
+	if ga != nil {
+		gsuo.SetOpusApplication(*ga)
+	}
+	return gsuo
+}
+
+// ClearOpusApplication clears the value of the "opus_application" field.
+func (gsuo *GuildSettingUpdateOne) ClearOpusApplication() *GuildSettingUpdateOne {
+	gsuo.mutation.ClearOpusApplication()
+	return gsuo
+}
+
+// SetOpusBitrate sets the "opus_bitrate" field.
+func (gsuo *GuildSettingUpdateOne) SetOpusBitrate(i int) *GuildSettingUpdateOne {
+	gsuo.mutation.ResetOpusBitrate()
+	gsuo.mutation.SetOpusBitrate(i)
+	return gsuo
+}
+
+// SetNillableOpusBitrate sets the "opus_bitrate" field if the given value is not nil.
+func (gsuo *GuildSettingUpdateOne) SetNillableOpusBitrate(i *int) *GuildSettingUpdateOne {
+	if i != nil {
+		gsuo.SetOpusBitrate(*i)
+	}
+	return gsuo
+}
+
+// AddOpusBitrate adds i to the "opus_bitrate" field.
+func (gsuo *GuildSettingUpdateOne) AddOpusBitrate(i int) *GuildSettingUpdateOne {
+	gsuo.mutation.AddOpusBitrate(i)
+	return gsuo
+}
+
+// ClearOpusBitrate clears the value of the "opus_bitrate" field.
+func (gsuo *GuildSettingUpdateOne) ClearOpusBitrate() *GuildSettingUpdateOne {
+	gsuo.mutation.ClearOpusBitrate()
+	return gsuo
+}
+
+// SetOpusComplexity sets the "opus_complexity" field.
+func (gsuo *GuildSettingUpdateOne) SetOpusComplexity(i int) *GuildSettingUpdateOne {
+	gsuo.mutation.ResetOpusComplexity()
+	gsuo.mutation.SetOpusComplexity(i)
+	return gsuo
+}
+
+// SetNillableOpusComplexity sets the "opus_complexity" field if the given value is not nil.
+func (gsuo *GuildSettingUpdateOne) SetNillableOpusComplexity(i *int) *GuildSettingUpdateOne {
+	if i != nil {
+		gsuo.SetOpusComplexity(*i)
+	}
+	return gsuo
+}
+
+// AddOpusComplexity adds i to the "opus_complexity" field.
+func (gsuo *GuildSettingUpdateOne) AddOpusComplexity(i int) *GuildSettingUpdateOne {
+	gsuo.mutation.AddOpusComplexity(i)
+	return gsuo
+}
+
+// ClearOpusComplexity clears the value of the "opus_complexity" field.
+func (gsuo *GuildSettingUpdateOne) ClearOpusComplexity() *GuildSettingUpdateOne {
+	gsuo.mutation.ClearOpusComplexity()
+	return gsuo
+}
+
+// SetOpusFec sets the "opus_fec" field.
+func (gsuo *GuildSettingUpdateOne) SetOpusFec(b bool) *GuildSettingUpdateOne {
+	gsuo.mutation.SetOpusFec(b)
+	return gsuo
+}
+
+// SetNillableOpusFec sets the "opus_fec" field if the given value is not nil.
+func (gsuo *GuildSettingUpdateOne) SetNillableOpusFec(b *bool) *GuildSettingUpdateOne {
+	if b != nil {
+		gsuo.SetOpusFec(*b)
+	}
+	return gsuo
+}
+
+// ClearOpusFec clears the value of the "opus_fec" field.
+func (gsuo *GuildSettingUpdateOne) ClearOpusFec() *GuildSettingUpdateOne {
+	gsuo.mutation.ClearOpusFec()
+	return gsuo
+}
+
+// SetOpusDtx sets the "opus_dtx" field.
+func (gsuo *GuildSettingUpdateOne) SetOpusDtx(b bool) *GuildSettingUpdateOne {
+	gsuo.mutation.SetOpusDtx(b)
+	return gsuo
+}
+
+// SetNillableOpusDtx sets the "opus_dtx" field if the given value is not nil.
+func (gsuo *GuildSettingUpdateOne) SetNillableOpusDtx(b *bool) *GuildSettingUpdateOne {
+	if b != nil {
+		gsuo.SetOpusDtx(*b)
+	}
+	return gsuo
+}
+
+// ClearOpusDtx clears the value of the "opus_dtx" field.
+func (gsuo *GuildSettingUpdateOne) ClearOpusDtx() *GuildSettingUpdateOne {
+	gsuo.mutation.ClearOpusDtx()
+	return gsuo
+}
+
+// SetReadBots sets the "read_bots" field.
+func (gsuo *GuildSettingUpdateOne) SetReadBots(b bool) *GuildSettingUpdateOne {
+	gsuo.mutation.SetReadBots(b)
+	return gsuo
+}
+
+// SetNillableReadBots sets the "read_bots" field if the given value is not nil.
+func (gsuo *GuildSettingUpdateOne) SetNillableReadBots(b *bool) *GuildSettingUpdateOne {
+	if b != nil {
+		gsuo.SetReadBots(*b)
+	}
+	return gsuo
+}
+
+// ClearReadBots clears the value of the "read_bots" field.
+func (gsuo *GuildSettingUpdateOne) ClearReadBots() *GuildSettingUpdateOne {
+	gsuo.mutation.ClearReadBots()
+	return gsuo
+}
+
+// SetReadWebhooks sets the "read_webhooks" field.
+func (gsuo *GuildSettingUpdateOne) SetReadWebhooks(b bool) *GuildSettingUpdateOne {
+	gsuo.mutation.SetReadWebhooks(b)
+	return gsuo
+}
+
+// SetNillableReadWebhooks sets the "read_webhooks" field if the given value is not nil.
+func (gsuo *GuildSettingUpdateOne) SetNillableReadWebhooks(b *bool) *GuildSettingUpdateOne {
+	if b != nil {
+		gsuo.SetReadWebhooks(*b)
+	}
+	return gsuo
+}
+
+// ClearReadWebhooks clears the value of the "read_webhooks" field.
+func (gsuo *GuildSettingUpdateOne) ClearReadWebhooks() *GuildSettingUpdateOne {
+	gsuo.mutation.ClearReadWebhooks()
+	return gsuo
+}
+
+// SetNgWordMode sets the "ng_word_mode" field.
+func (gsuo *GuildSettingUpdateOne) SetNgWordMode(gwm guildsetting.NgWordMode) *GuildSettingUpdateOne {
+	gsuo.mutation.SetNgWordMode(gwm)
+	return gsuo
+}
+
+// SetNillableNgWordMode sets the "ng_word_mode" field if the given value is not nil.
+func (gsuo *GuildSettingUpdateOne) SetNillableNgWordMode(gwm *guildsetting.NgWordMode) *GuildSettingUpdateOne {
+	if gwm != nil {
+		gsuo.SetNgWordMode(*gwm)
+	}
+	return gsuo
+}
+
+// ClearNgWordMode clears the value of the "ng_word_mode" field.
+func (gsuo *GuildSettingUpdateOne) ClearNgWordMode() *GuildSettingUpdateOne {
+	gsuo.mutation.ClearNgWordMode()
+	return gsuo
+}
+
+// SetNgWordReplacement sets the "ng_word_replacement" field.
+func (gsuo *GuildSettingUpdateOne) SetNgWordReplacement(s string) *GuildSettingUpdateOne {
+	gsuo.mutation.SetNgWordReplacement(s)
+	return gsuo
+}
+
+// SetNillableNgWordReplacement sets the "ng_word_replacement" field if the given value is not nil.
+func (gsuo *GuildSettingUpdateOne) SetNillableNgWordReplacement(s *string) *GuildSettingUpdateOne {
+	if s != nil {
+		gsuo.SetNgWordReplacement(*s)
+	}
+	return gsuo
+}
+
+// ClearNgWordReplacement clears the value of the "ng_word_replacement" field.
+func (gsuo *GuildSettingUpdateOne) ClearNgWordReplacement() *GuildSettingUpdateOne {
+	gsuo.mutation.ClearNgWordReplacement()
+	return gsuo
+}
+
+// Mutation returns the GuildSettingMutation object of the builder.
+func (gsuo *GuildSettingUpdateOne) Mutation() *GuildSettingMutation {
+	return gsuo.mutation
+}
+
+// Where appends a list predicates to the GuildSettingUpdate builder.
+func (gsuo *GuildSettingUpdateOne) Where(ps ...predicate.GuildSetting) *GuildSettingUpdateOne {
+	gsuo.mutation.Where(ps...)
+	return gsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (gsuo *GuildSettingUpdateOne) Select(field string, fields ...string) *GuildSettingUpdateOne {
+	gsuo.fields = append([]string{field}, fields...)
+	return gsuo
+}
+
+// Save executes the query and returns the updated GuildSetting entity.
+func (gsuo *GuildSettingUpdateOne) Save(ctx context.Context) (*GuildSetting, error) {
+	return withHooks(ctx, gsuo.sqlSave, gsuo.mutation, gsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gsuo *GuildSettingUpdateOne) SaveX(ctx context.Context) *GuildSetting {
+	node, err := gsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (gsuo *GuildSettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := gsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gsuo *GuildSettingUpdateOne) ExecX(ctx context.Context) {
+	if err := gsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gsuo *GuildSettingUpdateOne) check() error {
+	if v, ok := gsuo.mutation.OpusApplication(); ok {
+		if err := guildsetting.OpusApplicationValidator(v); err != nil {
+			return &ValidationError{Name: "opus_application", err: fmt.Errorf(`ent: validator failed for field "GuildSetting.opus_application": %w`, err)}
+		}
+	}
+	if v, ok := gsuo.mutation.NgWordMode(); ok {
+		if err := guildsetting.NgWordModeValidator(v); err != nil {
+			return &ValidationError{Name: "ng_word_mode", err: fmt.Errorf(`ent: validator failed for field "GuildSetting.ng_word_mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (gsuo *GuildSettingUpdateOne) sqlSave(ctx context.Context) (_node *GuildSetting, err error) {
+	if err := gsuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(guildsetting.Table, guildsetting.Columns, sqlgraph.NewFieldSpec(guildsetting.FieldID, field.TypeInt))
+	id, ok := gsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GuildSetting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := gsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, guildsetting.FieldID)
+		for _, f := range fields {
+			if !guildsetting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != guildsetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := gsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := gsuo.mutation.OpusApplication(); ok {
+		_spec.SetField(guildsetting.FieldOpusApplication, field.TypeEnum, value)
+	}
+	if gsuo.mutation.OpusApplicationCleared() {
+		_spec.ClearField(guildsetting.FieldOpusApplication, field.TypeEnum)
+	}
+	if value, ok := gsuo.mutation.OpusBitrate(); ok {
+		_spec.SetField(guildsetting.FieldOpusBitrate, field.TypeInt, value)
+	}
+	if value, ok := gsuo.mutation.AddedOpusBitrate(); ok {
+		_spec.AddField(guildsetting.FieldOpusBitrate, field.TypeInt, value)
+	}
+	if gsuo.mutation.OpusBitrateCleared() {
+		_spec.ClearField(guildsetting.FieldOpusBitrate, field.TypeInt)
+	}
+	if value, ok := gsuo.mutation.OpusComplexity(); ok {
+		_spec.SetField(guildsetting.FieldOpusComplexity, field.TypeInt, value)
+	}
+	if value, ok := gsuo.mutation.AddedOpusComplexity(); ok {
+		_spec.AddField(guildsetting.FieldOpusComplexity, field.TypeInt, value)
+	}
+	if gsuo.mutation.OpusComplexityCleared() {
+		_spec.ClearField(guildsetting.FieldOpusComplexity, field.TypeInt)
+	}
+	if value, ok := gsuo.mutation.OpusFec(); ok {
+		_spec.SetField(guildsetting.FieldOpusFec, field.TypeBool, value)
+	}
+	if gsuo.mutation.OpusFecCleared() {
+		_spec.ClearField(guildsetting.FieldOpusFec, field.TypeBool)
+	}
+	if value, ok := gsuo.mutation.OpusDtx(); ok {
+		_spec.SetField(guildsetting.FieldOpusDtx, field.TypeBool, value)
+	}
+	if gsuo.mutation.OpusDtxCleared() {
+		_spec.ClearField(guildsetting.FieldOpusDtx, field.TypeBool)
+	}
+	if value, ok := gsuo.mutation.ReadBots(); ok {
+		_spec.SetField(guildsetting.FieldReadBots, field.TypeBool, value)
+	}
+	if gsuo.mutation.ReadBotsCleared() {
+		_spec.ClearField(guildsetting.FieldReadBots, field.TypeBool)
+	}
+	if value, ok := gsuo.mutation.ReadWebhooks(); ok {
+		_spec.SetField(guildsetting.FieldReadWebhooks, field.TypeBool, value)
+	}
+	if gsuo.mutation.ReadWebhooksCleared() {
+		_spec.ClearField(guildsetting.FieldReadWebhooks, field.TypeBool)
+	}
+	if value, ok := gsuo.mutation.NgWordMode(); ok {
+		_spec.SetField(guildsetting.FieldNgWordMode, field.TypeEnum, value)
+	}
+	if gsuo.mutation.NgWordModeCleared() {
+		_spec.ClearField(guildsetting.FieldNgWordMode, field.TypeEnum)
+	}
+	if value, ok := gsuo.mutation.NgWordReplacement(); ok {
+		_spec.SetField(guildsetting.FieldNgWordReplacement, field.TypeString, value)
+	}
+	if gsuo.mutation.NgWordReplacementCleared() {
+		_spec.ClearField(guildsetting.FieldNgWordReplacement, field.TypeString)
+	}
+	_node = &GuildSetting{config: gsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, gsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{guildsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	gsuo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,90 @@ import (
 	"github.com/kechako/yomiko/ent"
 )
 
+// The AccessRuleFunc type is an adapter to allow the use of ordinary
+// function as AccessRule mutator.
+type AccessRuleFunc func(context.Context, *ent.AccessRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AccessRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AccessRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AccessRuleMutation", m)
+}
+
+// The GuildDictEntryFunc type is an adapter to allow the use of ordinary
+// function as GuildDictEntry mutator.
+type GuildDictEntryFunc func(context.Context, *ent.GuildDictEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GuildDictEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GuildDictEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GuildDictEntryMutation", m)
+}
+
+// The GuildSettingFunc type is an adapter to allow the use of ordinary
+// function as GuildSetting mutator.
+type GuildSettingFunc func(context.Context, *ent.GuildSettingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GuildSettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GuildSettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GuildSettingMutation", m)
+}
+
+// The IgnoreEntryFunc type is an adapter to allow the use of ordinary
+// function as IgnoreEntry mutator.
+type IgnoreEntryFunc func(context.Context, *ent.IgnoreEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IgnoreEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IgnoreEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IgnoreEntryMutation", m)
+}
+
+// The NGWordFunc type is an adapter to allow the use of ordinary
+// function as NGWord mutator.
+type NGWordFunc func(context.Context, *ent.NGWordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f NGWordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.NGWordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.NGWordMutation", m)
+}
+
+// The SpokenNameFunc type is an adapter to allow the use of ordinary
+// function as SpokenName mutator.
+type SpokenNameFunc func(context.Context, *ent.SpokenNameMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SpokenNameFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SpokenNameMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SpokenNameMutation", m)
+}
+
+// The UserDictEntryFunc type is an adapter to allow the use of ordinary
+// function as UserDictEntry mutator.
+type UserDictEntryFunc func(context.Context, *ent.UserDictEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserDictEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserDictEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserDictEntryMutation", m)
+}
+
 // The VoiceSettingFunc type is an adapter to allow the use of ordinary
 // function as VoiceSetting mutator.
 type VoiceSettingFunc func(context.Context, *ent.VoiceSettingMutation) (ent.Value, error)
@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/ignoreentry"
+)
+
+// IgnoreEntry is the model entity for the IgnoreEntry schema.
+type IgnoreEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID string `json:"guild_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind ignoreentry.Kind `json:"kind,omitempty"`
+	// TargetID holds the value of the "target_id" field.
+	TargetID     string `json:"target_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IgnoreEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ignoreentry.FieldID:
+			values[i] = new(sql.NullInt64)
+		case ignoreentry.FieldGuildID, ignoreentry.FieldKind, ignoreentry.FieldTargetID:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IgnoreEntry fields.
+func (ie *IgnoreEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ignoreentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ie.ID = int(value.Int64)
+		case ignoreentry.FieldGuildID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				ie.GuildID = value.String
+			}
+		case ignoreentry.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				ie.Kind = ignoreentry.Kind(value.String)
+			}
+		case ignoreentry.FieldTargetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				ie.TargetID = value.String
+			}
+		default:
+			ie.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IgnoreEntry.
+// This includes values selected through modifiers, order, etc.
+func (ie *IgnoreEntry) Value(name string) (ent.Value, error) {
+	return ie.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this IgnoreEntry.
+// Note that you need to call IgnoreEntry.Unwrap() before calling this method if this IgnoreEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ie *IgnoreEntry) Update() *IgnoreEntryUpdateOne {
+	return NewIgnoreEntryClient(ie.config).UpdateOne(ie)
+}
+
+// Unwrap unwraps the IgnoreEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ie *IgnoreEntry) Unwrap() *IgnoreEntry {
+	_tx, ok := ie.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IgnoreEntry is not a transactional entity")
+	}
+	ie.config.driver = _tx.drv
+	return ie
+}
+
+// String implements the fmt.Stringer.
+func (ie *IgnoreEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("IgnoreEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ie.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(ie.GuildID)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", ie.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(ie.TargetID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IgnoreEntries is a parsable slice of IgnoreEntry.
+type IgnoreEntries []*IgnoreEntry
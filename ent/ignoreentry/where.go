@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ignoreentry
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldLTE(FieldID, id))
+}
+
+// GuildID applies equality check predicate on the "guild_id" field. It's identical to GuildIDEQ.
+func GuildID(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldEQ(FieldGuildID, v))
+}
+
+// TargetID applies equality check predicate on the "target_id" field. It's identical to TargetIDEQ.
+func TargetID(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldEQ(FieldTargetID, v))
+}
+
+// GuildIDEQ applies the EQ predicate on the "guild_id" field.
+func GuildIDEQ(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldEQ(FieldGuildID, v))
+}
+
+// GuildIDNEQ applies the NEQ predicate on the "guild_id" field.
+func GuildIDNEQ(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldNEQ(FieldGuildID, v))
+}
+
+// GuildIDIn applies the In predicate on the "guild_id" field.
+func GuildIDIn(vs ...string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldIn(FieldGuildID, vs...))
+}
+
+// GuildIDNotIn applies the NotIn predicate on the "guild_id" field.
+func GuildIDNotIn(vs ...string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldNotIn(FieldGuildID, vs...))
+}
+
+// GuildIDGT applies the GT predicate on the "guild_id" field.
+func GuildIDGT(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldGT(FieldGuildID, v))
+}
+
+// GuildIDGTE applies the GTE predicate on the "guild_id" field.
+func GuildIDGTE(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldGTE(FieldGuildID, v))
+}
+
+// GuildIDLT applies the LT predicate on the "guild_id" field.
+func GuildIDLT(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldLT(FieldGuildID, v))
+}
+
+// GuildIDLTE applies the LTE predicate on the "guild_id" field.
+func GuildIDLTE(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldLTE(FieldGuildID, v))
+}
+
+// GuildIDContains applies the Contains predicate on the "guild_id" field.
+func GuildIDContains(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldContains(FieldGuildID, v))
+}
+
+// GuildIDHasPrefix applies the HasPrefix predicate on the "guild_id" field.
+func GuildIDHasPrefix(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldHasPrefix(FieldGuildID, v))
+}
+
+// GuildIDHasSuffix applies the HasSuffix predicate on the "guild_id" field.
+func GuildIDHasSuffix(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldHasSuffix(FieldGuildID, v))
+}
+
+// GuildIDEqualFold applies the EqualFold predicate on the "guild_id" field.
+func GuildIDEqualFold(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldEqualFold(FieldGuildID, v))
+}
+
+// GuildIDContainsFold applies the ContainsFold predicate on the "guild_id" field.
+func GuildIDContainsFold(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldContainsFold(FieldGuildID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// TargetIDEQ applies the EQ predicate on the "target_id" field.
+func TargetIDEQ(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldEQ(FieldTargetID, v))
+}
+
+// TargetIDNEQ applies the NEQ predicate on the "target_id" field.
+func TargetIDNEQ(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldNEQ(FieldTargetID, v))
+}
+
+// TargetIDIn applies the In predicate on the "target_id" field.
+func TargetIDIn(vs ...string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldIn(FieldTargetID, vs...))
+}
+
+// TargetIDNotIn applies the NotIn predicate on the "target_id" field.
+func TargetIDNotIn(vs ...string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldNotIn(FieldTargetID, vs...))
+}
+
+// TargetIDGT applies the GT predicate on the "target_id" field.
+func TargetIDGT(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldGT(FieldTargetID, v))
+}
+
+// TargetIDGTE applies the GTE predicate on the "target_id" field.
+func TargetIDGTE(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldGTE(FieldTargetID, v))
+}
+
+// TargetIDLT applies the LT predicate on the "target_id" field.
+func TargetIDLT(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldLT(FieldTargetID, v))
+}
+
+// TargetIDLTE applies the LTE predicate on the "target_id" field.
+func TargetIDLTE(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldLTE(FieldTargetID, v))
+}
+
+// TargetIDContains applies the Contains predicate on the "target_id" field.
+func TargetIDContains(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldContains(FieldTargetID, v))
+}
+
+// TargetIDHasPrefix applies the HasPrefix predicate on the "target_id" field.
+func TargetIDHasPrefix(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldHasPrefix(FieldTargetID, v))
+}
+
+// TargetIDHasSuffix applies the HasSuffix predicate on the "target_id" field.
+func TargetIDHasSuffix(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldHasSuffix(FieldTargetID, v))
+}
+
+// TargetIDEqualFold applies the EqualFold predicate on the "target_id" field.
+func TargetIDEqualFold(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldEqualFold(FieldTargetID, v))
+}
+
+// TargetIDContainsFold applies the ContainsFold predicate on the "target_id" field.
+func TargetIDContainsFold(v string) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.FieldContainsFold(FieldTargetID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IgnoreEntry) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IgnoreEntry) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IgnoreEntry) predicate.IgnoreEntry {
+	return predicate.IgnoreEntry(sql.NotPredicates(p))
+}
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/ignoreentry"
+)
+
+// IgnoreEntryCreate is the builder for creating a IgnoreEntry entity.
+type IgnoreEntryCreate struct {
+	config
+	mutation *IgnoreEntryMutation
+	hooks    []Hook
+}
+
+// SetGuildID sets the "guild_id" field.
+func (iec *IgnoreEntryCreate) SetGuildID(s string) *IgnoreEntryCreate {
+	iec.mutation.SetGuildID(s)
+	return iec
+}
+
+// SetKind sets the "kind" field.
+func (iec *IgnoreEntryCreate) SetKind(i ignoreentry.Kind) *IgnoreEntryCreate {
+	iec.mutation.SetKind(i)
+	return iec
+}
+
+// SetTargetID sets the "target_id" field.
+func (iec *IgnoreEntryCreate) SetTargetID(s string) *IgnoreEntryCreate {
+	iec.mutation.SetTargetID(s)
+	return iec
+}
+
+// Mutation returns the IgnoreEntryMutation object of the builder.
+func (iec *IgnoreEntryCreate) Mutation() *IgnoreEntryMutation {
+	return iec.mutation
+}
+
+// Save creates the IgnoreEntry in the database.
+func (iec *IgnoreEntryCreate) Save(ctx context.Context) (*IgnoreEntry, error) {
+	return withHooks(ctx, iec.sqlSave, iec.mutation, iec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (iec *IgnoreEntryCreate) SaveX(ctx context.Context) *IgnoreEntry {
+	v, err := iec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iec *IgnoreEntryCreate) Exec(ctx context.Context) error {
+	_, err := iec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iec *IgnoreEntryCreate) ExecX(ctx context.Context) {
+	if err := iec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iec *IgnoreEntryCreate) check() error {
+	if _, ok := iec.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "IgnoreEntry.guild_id"`)}
+	}
+	if v, ok := iec.mutation.GuildID(); ok {
+		if err := ignoreentry.GuildIDValidator(v); err != nil {
+			return &ValidationError{Name: "guild_id", err: fmt.Errorf(`ent: validator failed for field "IgnoreEntry.guild_id": %w`, err)}
+		}
+	}
+	if _, ok := iec.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "IgnoreEntry.kind"`)}
+	}
+	if v, ok := iec.mutation.Kind(); ok {
+		if err := ignoreentry.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "IgnoreEntry.kind": %w`, err)}
+		}
+	}
+	if _, ok := iec.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "IgnoreEntry.target_id"`)}
+	}
+	if v, ok := iec.mutation.TargetID(); ok {
+		if err := ignoreentry.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`ent: validator failed for field "IgnoreEntry.target_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iec *IgnoreEntryCreate) sqlSave(ctx context.Context) (*IgnoreEntry, error) {
+	if err := iec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := iec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, iec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	iec.mutation.id = &_node.ID
+	iec.mutation.done = true
+	return _node, nil
+}
+
+func (iec *IgnoreEntryCreate) createSpec() (*IgnoreEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IgnoreEntry{config: iec.config}
+		_spec = sqlgraph.NewCreateSpec(ignoreentry.Table, sqlgraph.NewFieldSpec(ignoreentry.FieldID, field.TypeInt))
+	)
+	if value, ok := iec.mutation.GuildID(); ok {
+		_spec.SetField(ignoreentry.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := iec.mutation.Kind(); ok {
+		_spec.SetField(ignoreentry.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := iec.mutation.TargetID(); ok {
+		_spec.SetField(ignoreentry.FieldTargetID, field.TypeString, value)
+		_node.TargetID = value
+	}
+	return _node, _spec
+}
+
+// IgnoreEntryCreateBulk is the builder for creating many IgnoreEntry entities in bulk.
+type IgnoreEntryCreateBulk struct {
+	config
+	err      error
+	builders []*IgnoreEntryCreate
+}
+
+// Save creates the IgnoreEntry entities in the database.
+func (iecb *IgnoreEntryCreateBulk) Save(ctx context.Context) ([]*IgnoreEntry, error) {
+	if iecb.err != nil {
+		return nil, iecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(iecb.builders))
+	nodes := make([]*IgnoreEntry, len(iecb.builders))
+	mutators := make([]Mutator, len(iecb.builders))
+	for i := range iecb.builders {
+		func(i int, root context.Context) {
+			builder := iecb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IgnoreEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, iecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, iecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, iecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iecb *IgnoreEntryCreateBulk) SaveX(ctx context.Context) []*IgnoreEntry {
+	v, err := iecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (iecb *IgnoreEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := iecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iecb *IgnoreEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := iecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
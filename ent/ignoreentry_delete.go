@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/ignoreentry"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// IgnoreEntryDelete is the builder for deleting a IgnoreEntry entity.
+type IgnoreEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *IgnoreEntryMutation
+}
+
+// Where appends a list predicates to the IgnoreEntryDelete builder.
+func (ied *IgnoreEntryDelete) Where(ps ...predicate.IgnoreEntry) *IgnoreEntryDelete {
+	ied.mutation.Where(ps...)
+	return ied
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ied *IgnoreEntryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ied.sqlExec, ied.mutation, ied.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ied *IgnoreEntryDelete) ExecX(ctx context.Context) int {
+	n, err := ied.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ied *IgnoreEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ignoreentry.Table, sqlgraph.NewFieldSpec(ignoreentry.FieldID, field.TypeInt))
+	if ps := ied.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ied.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ied.mutation.done = true
+	return affected, err
+}
+
+// IgnoreEntryDeleteOne is the builder for deleting a single IgnoreEntry entity.
+type IgnoreEntryDeleteOne struct {
+	ied *IgnoreEntryDelete
+}
+
+// Where appends a list predicates to the IgnoreEntryDelete builder.
+func (iedo *IgnoreEntryDeleteOne) Where(ps ...predicate.IgnoreEntry) *IgnoreEntryDeleteOne {
+	iedo.ied.mutation.Where(ps...)
+	return iedo
+}
+
+// Exec executes the deletion query.
+func (iedo *IgnoreEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := iedo.ied.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ignoreentry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iedo *IgnoreEntryDeleteOne) ExecX(ctx context.Context) {
+	if err := iedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
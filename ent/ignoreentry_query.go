@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/ignoreentry"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// IgnoreEntryQuery is the builder for querying IgnoreEntry entities.
+type IgnoreEntryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []ignoreentry.OrderOption
+	inters     []Interceptor
+	predicates []predicate.IgnoreEntry
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the IgnoreEntryQuery builder.
+func (ieq *IgnoreEntryQuery) Where(ps ...predicate.IgnoreEntry) *IgnoreEntryQuery {
+	ieq.predicates = append(ieq.predicates, ps...)
+	return ieq
+}
+
+// Limit the number of records to be returned by this query.
+func (ieq *IgnoreEntryQuery) Limit(limit int) *IgnoreEntryQuery {
+	ieq.ctx.Limit = &limit
+	return ieq
+}
+
+// Offset to start from.
+func (ieq *IgnoreEntryQuery) Offset(offset int) *IgnoreEntryQuery {
+	ieq.ctx.Offset = &offset
+	return ieq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ieq *IgnoreEntryQuery) Unique(unique bool) *IgnoreEntryQuery {
+	ieq.ctx.Unique = &unique
+	return ieq
+}
+
+// Order specifies how the records should be ordered.
+func (ieq *IgnoreEntryQuery) Order(o ...ignoreentry.OrderOption) *IgnoreEntryQuery {
+	ieq.order = append(ieq.order, o...)
+	return ieq
+}
+
+// First returns the first IgnoreEntry entity from the query.
+// Returns a *NotFoundError when no IgnoreEntry was found.
+func (ieq *IgnoreEntryQuery) First(ctx context.Context) (*IgnoreEntry, error) {
+	nodes, err := ieq.Limit(1).All(setContextOp(ctx, ieq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ignoreentry.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ieq *IgnoreEntryQuery) FirstX(ctx context.Context) *IgnoreEntry {
+	node, err := ieq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first IgnoreEntry ID from the query.
+// Returns a *NotFoundError when no IgnoreEntry ID was found.
+func (ieq *IgnoreEntryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ieq.Limit(1).IDs(setContextOp(ctx, ieq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ignoreentry.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ieq *IgnoreEntryQuery) FirstIDX(ctx context.Context) int {
+	id, err := ieq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single IgnoreEntry entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one IgnoreEntry entity is found.
+// Returns a *NotFoundError when no IgnoreEntry entities are found.
+func (ieq *IgnoreEntryQuery) Only(ctx context.Context) (*IgnoreEntry, error) {
+	nodes, err := ieq.Limit(2).All(setContextOp(ctx, ieq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ignoreentry.Label}
+	default:
+		return nil, &NotSingularError{ignoreentry.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ieq *IgnoreEntryQuery) OnlyX(ctx context.Context) *IgnoreEntry {
+	node, err := ieq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only IgnoreEntry ID in the query.
+// Returns a *NotSingularError when more than one IgnoreEntry ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ieq *IgnoreEntryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ieq.Limit(2).IDs(setContextOp(ctx, ieq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ignoreentry.Label}
+	default:
+		err = &NotSingularError{ignoreentry.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ieq *IgnoreEntryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ieq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of IgnoreEntries.
+func (ieq *IgnoreEntryQuery) All(ctx context.Context) ([]*IgnoreEntry, error) {
+	ctx = setContextOp(ctx, ieq.ctx, "All")
+	if err := ieq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*IgnoreEntry, *IgnoreEntryQuery]()
+	return withInterceptors[[]*IgnoreEntry](ctx, ieq, qr, ieq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ieq *IgnoreEntryQuery) AllX(ctx context.Context) []*IgnoreEntry {
+	nodes, err := ieq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of IgnoreEntry IDs.
+func (ieq *IgnoreEntryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ieq.ctx.Unique == nil && ieq.path != nil {
+		ieq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ieq.ctx, "IDs")
+	if err = ieq.Select(ignoreentry.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ieq *IgnoreEntryQuery) IDsX(ctx context.Context) []int {
+	ids, err := ieq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ieq *IgnoreEntryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ieq.ctx, "Count")
+	if err := ieq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ieq, querierCount[*IgnoreEntryQuery](), ieq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ieq *IgnoreEntryQuery) CountX(ctx context.Context) int {
+	count, err := ieq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ieq *IgnoreEntryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ieq.ctx, "Exist")
+	switch _, err := ieq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ieq *IgnoreEntryQuery) ExistX(ctx context.Context) bool {
+	exist, err := ieq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the IgnoreEntryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ieq *IgnoreEntryQuery) Clone() *IgnoreEntryQuery {
+	if ieq == nil {
+		return nil
+	}
+	return &IgnoreEntryQuery{
+		config:     ieq.config,
+		ctx:        ieq.ctx.Clone(),
+		order:      append([]ignoreentry.OrderOption{}, ieq.order...),
+		inters:     append([]Interceptor{}, ieq.inters...),
+		predicates: append([]predicate.IgnoreEntry{}, ieq.predicates...),
+		// clone intermediate query.
+		sql:  ieq.sql.Clone(),
+		path: ieq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID string `json:"guild_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.IgnoreEntry.Query().
+//		GroupBy(ignoreentry.FieldGuildID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ieq *IgnoreEntryQuery) GroupBy(field string, fields ...string) *IgnoreEntryGroupBy {
+	ieq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &IgnoreEntryGroupBy{build: ieq}
+	grbuild.flds = &ieq.ctx.Fields
+	grbuild.label = ignoreentry.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID string `json:"guild_id,omitempty"`
+//	}
+//
+//	client.IgnoreEntry.Query().
+//		Select(ignoreentry.FieldGuildID).
+//		Scan(ctx, &v)
+func (ieq *IgnoreEntryQuery) Select(fields ...string) *IgnoreEntrySelect {
+	ieq.ctx.Fields = append(ieq.ctx.Fields, fields...)
+	sbuild := &IgnoreEntrySelect{IgnoreEntryQuery: ieq}
+	sbuild.label = ignoreentry.Label
+	sbuild.flds, sbuild.scan = &ieq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a IgnoreEntrySelect configured with the given aggregations.
+func (ieq *IgnoreEntryQuery) Aggregate(fns ...AggregateFunc) *IgnoreEntrySelect {
+	return ieq.Select().Aggregate(fns...)
+}
+
+func (ieq *IgnoreEntryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ieq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ieq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ieq.ctx.Fields {
+		if !ignoreentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ieq.path != nil {
+		prev, err := ieq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ieq.sql = prev
+	}
+	return nil
+}
+
+func (ieq *IgnoreEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*IgnoreEntry, error) {
+	var (
+		nodes = []*IgnoreEntry{}
+		_spec = ieq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*IgnoreEntry).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &IgnoreEntry{config: ieq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ieq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ieq *IgnoreEntryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ieq.querySpec()
+	_spec.Node.Columns = ieq.ctx.Fields
+	if len(ieq.ctx.Fields) > 0 {
+		_spec.Unique = ieq.ctx.Unique != nil && *ieq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ieq.driver, _spec)
+}
+
+func (ieq *IgnoreEntryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(ignoreentry.Table, ignoreentry.Columns, sqlgraph.NewFieldSpec(ignoreentry.FieldID, field.TypeInt))
+	_spec.From = ieq.sql
+	if unique := ieq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ieq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ieq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ignoreentry.FieldID)
+		for i := range fields {
+			if fields[i] != ignoreentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ieq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ieq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ieq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ieq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ieq *IgnoreEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ieq.driver.Dialect())
+	t1 := builder.Table(ignoreentry.Table)
+	columns := ieq.ctx.Fields
+	if len(columns) == 0 {
+		columns = ignoreentry.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ieq.sql != nil {
+		selector = ieq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ieq.ctx.Unique != nil && *ieq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ieq.predicates {
+		p(selector)
+	}
+	for _, p := range ieq.order {
+		p(selector)
+	}
+	if offset := ieq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ieq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// IgnoreEntryGroupBy is the group-by builder for IgnoreEntry entities.
+type IgnoreEntryGroupBy struct {
+	selector
+	build *IgnoreEntryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (iegb *IgnoreEntryGroupBy) Aggregate(fns ...AggregateFunc) *IgnoreEntryGroupBy {
+	iegb.fns = append(iegb.fns, fns...)
+	return iegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (iegb *IgnoreEntryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, iegb.build.ctx, "GroupBy")
+	if err := iegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*IgnoreEntryQuery, *IgnoreEntryGroupBy](ctx, iegb.build, iegb, iegb.build.inters, v)
+}
+
+func (iegb *IgnoreEntryGroupBy) sqlScan(ctx context.Context, root *IgnoreEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(iegb.fns))
+	for _, fn := range iegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*iegb.flds)+len(iegb.fns))
+		for _, f := range *iegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*iegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := iegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// IgnoreEntrySelect is the builder for selecting fields of IgnoreEntry entities.
+type IgnoreEntrySelect struct {
+	*IgnoreEntryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ies *IgnoreEntrySelect) Aggregate(fns ...AggregateFunc) *IgnoreEntrySelect {
+	ies.fns = append(ies.fns, fns...)
+	return ies
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ies *IgnoreEntrySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ies.ctx, "Select")
+	if err := ies.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*IgnoreEntryQuery, *IgnoreEntrySelect](ctx, ies.IgnoreEntryQuery, ies, ies.inters, v)
+}
+
+func (ies *IgnoreEntrySelect) sqlScan(ctx context.Context, root *IgnoreEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ies.fns))
+	for _, fn := range ies.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ies.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ies.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/ignoreentry"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// IgnoreEntryUpdate is the builder for updating IgnoreEntry entities.
+type IgnoreEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IgnoreEntryMutation
+}
+
+// Where appends a list predicates to the IgnoreEntryUpdate builder.
+func (ieu *IgnoreEntryUpdate) Where(ps ...predicate.IgnoreEntry) *IgnoreEntryUpdate {
+	ieu.mutation.Where(ps...)
+	return ieu
+}
+
+// Mutation returns the IgnoreEntryMutation object of the builder.
+func (ieu *IgnoreEntryUpdate) Mutation() *IgnoreEntryMutation {
+	return ieu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ieu *IgnoreEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ieu.sqlSave, ieu.mutation, ieu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ieu *IgnoreEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := ieu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ieu *IgnoreEntryUpdate) Exec(ctx context.Context) error {
+	_, err := ieu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ieu *IgnoreEntryUpdate) ExecX(ctx context.Context) {
+	if err := ieu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ieu *IgnoreEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ignoreentry.Table, ignoreentry.Columns, sqlgraph.NewFieldSpec(ignoreentry.FieldID, field.TypeInt))
+	if ps := ieu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ieu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ignoreentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ieu.mutation.done = true
+	return n, nil
+}
+
+// IgnoreEntryUpdateOne is the builder for updating a single IgnoreEntry entity.
+type IgnoreEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IgnoreEntryMutation
+}
+
+// Mutation returns the IgnoreEntryMutation object of the builder.
+func (ieuo *IgnoreEntryUpdateOne) Mutation() *IgnoreEntryMutation {
+	return ieuo.mutation
+}
+
+// Where appends a list predicates to the IgnoreEntryUpdate builder.
+func (ieuo *IgnoreEntryUpdateOne) Where(ps ...predicate.IgnoreEntry) *IgnoreEntryUpdateOne {
+	ieuo.mutation.Where(ps...)
+	return ieuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ieuo *IgnoreEntryUpdateOne) Select(field string, fields ...string) *IgnoreEntryUpdateOne {
+	ieuo.fields = append([]string{field}, fields...)
+	return ieuo
+}
+
+// Save executes the query and returns the updated IgnoreEntry entity.
+func (ieuo *IgnoreEntryUpdateOne) Save(ctx context.Context) (*IgnoreEntry, error) {
+	return withHooks(ctx, ieuo.sqlSave, ieuo.mutation, ieuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ieuo *IgnoreEntryUpdateOne) SaveX(ctx context.Context) *IgnoreEntry {
+	node, err := ieuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ieuo *IgnoreEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := ieuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ieuo *IgnoreEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := ieuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ieuo *IgnoreEntryUpdateOne) sqlSave(ctx context.Context) (_node *IgnoreEntry, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ignoreentry.Table, ignoreentry.Columns, sqlgraph.NewFieldSpec(ignoreentry.FieldID, field.TypeInt))
+	id, ok := ieuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IgnoreEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ieuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ignoreentry.FieldID)
+		for _, f := range fields {
+			if !ignoreentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ignoreentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ieuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &IgnoreEntry{config: ieuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ieuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ignoreentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ieuo.mutation.done = true
+	return _node, nil
+}
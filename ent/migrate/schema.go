@@ -8,6 +8,146 @@ import (
 )
 
 var (
+	// AccessRulesColumns holds the columns for the "access_rules" table.
+	AccessRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "guild_id", Type: field.TypeString},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"join", "leave", "skip", "dictionary", "settings"}},
+		{Name: "role_id", Type: field.TypeString},
+	}
+	// AccessRulesTable holds the schema information for the "access_rules" table.
+	AccessRulesTable = &schema.Table{
+		Name:       "access_rules",
+		Columns:    AccessRulesColumns,
+		PrimaryKey: []*schema.Column{AccessRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accessrule_guild_id_action_role_id",
+				Unique:  true,
+				Columns: []*schema.Column{AccessRulesColumns[1], AccessRulesColumns[2], AccessRulesColumns[3]},
+			},
+		},
+	}
+	// GuildDictEntriesColumns holds the columns for the "guild_dict_entries" table.
+	GuildDictEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "guild_id", Type: field.TypeString},
+		{Name: "word", Type: field.TypeString},
+		{Name: "reading", Type: field.TypeString},
+	}
+	// GuildDictEntriesTable holds the schema information for the "guild_dict_entries" table.
+	GuildDictEntriesTable = &schema.Table{
+		Name:       "guild_dict_entries",
+		Columns:    GuildDictEntriesColumns,
+		PrimaryKey: []*schema.Column{GuildDictEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "guilddictentry_guild_id_word",
+				Unique:  true,
+				Columns: []*schema.Column{GuildDictEntriesColumns[1], GuildDictEntriesColumns[2]},
+			},
+		},
+	}
+	// GuildSettingsColumns holds the columns for the "guild_settings" table.
+	GuildSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "guild_id", Type: field.TypeString, Unique: true},
+		{Name: "opus_application", Type: field.TypeEnum, Nullable: true, Enums: []string{"voip", "audio"}},
+		{Name: "opus_bitrate", Type: field.TypeInt, Nullable: true},
+		{Name: "opus_complexity", Type: field.TypeInt, Nullable: true},
+		{Name: "opus_fec", Type: field.TypeBool, Nullable: true},
+		{Name: "opus_dtx", Type: field.TypeBool, Nullable: true},
+		{Name: "read_bots", Type: field.TypeBool, Nullable: true},
+		{Name: "read_webhooks", Type: field.TypeBool, Nullable: true},
+		{Name: "ng_word_mode", Type: field.TypeEnum, Nullable: true, Enums: []string{"bleep", "replace", "drop"}},
+		{Name: "ng_word_replacement", Type: field.TypeString, Nullable: true},
+	}
+	// GuildSettingsTable holds the schema information for the "guild_settings" table.
+	GuildSettingsTable = &schema.Table{
+		Name:       "guild_settings",
+		Columns:    GuildSettingsColumns,
+		PrimaryKey: []*schema.Column{GuildSettingsColumns[0]},
+	}
+	// IgnoreEntriesColumns holds the columns for the "ignore_entries" table.
+	IgnoreEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "guild_id", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"user", "role"}},
+		{Name: "target_id", Type: field.TypeString},
+	}
+	// IgnoreEntriesTable holds the schema information for the "ignore_entries" table.
+	IgnoreEntriesTable = &schema.Table{
+		Name:       "ignore_entries",
+		Columns:    IgnoreEntriesColumns,
+		PrimaryKey: []*schema.Column{IgnoreEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ignoreentry_guild_id_kind_target_id",
+				Unique:  true,
+				Columns: []*schema.Column{IgnoreEntriesColumns[1], IgnoreEntriesColumns[2], IgnoreEntriesColumns[3]},
+			},
+		},
+	}
+	// NgWordsColumns holds the columns for the "ng_words" table.
+	NgWordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "guild_id", Type: field.TypeString},
+		{Name: "pattern", Type: field.TypeString},
+		{Name: "regex", Type: field.TypeBool, Default: false},
+	}
+	// NgWordsTable holds the schema information for the "ng_words" table.
+	NgWordsTable = &schema.Table{
+		Name:       "ng_words",
+		Columns:    NgWordsColumns,
+		PrimaryKey: []*schema.Column{NgWordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ngword_guild_id_pattern",
+				Unique:  true,
+				Columns: []*schema.Column{NgWordsColumns[1], NgWordsColumns[2]},
+			},
+		},
+	}
+	// SpokenNamesColumns holds the columns for the "spoken_names" table.
+	SpokenNamesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "guild_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+	}
+	// SpokenNamesTable holds the schema information for the "spoken_names" table.
+	SpokenNamesTable = &schema.Table{
+		Name:       "spoken_names",
+		Columns:    SpokenNamesColumns,
+		PrimaryKey: []*schema.Column{SpokenNamesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "spokenname_guild_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{SpokenNamesColumns[1], SpokenNamesColumns[2]},
+			},
+		},
+	}
+	// UserDictEntriesColumns holds the columns for the "user_dict_entries" table.
+	UserDictEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "word", Type: field.TypeString},
+		{Name: "reading", Type: field.TypeString},
+	}
+	// UserDictEntriesTable holds the schema information for the "user_dict_entries" table.
+	UserDictEntriesTable = &schema.Table{
+		Name:       "user_dict_entries",
+		Columns:    UserDictEntriesColumns,
+		PrimaryKey: []*schema.Column{UserDictEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userdictentry_user_id_word",
+				Unique:  true,
+				Columns: []*schema.Column{UserDictEntriesColumns[1], UserDictEntriesColumns[2]},
+			},
+		},
+	}
 	// VoiceSettingsColumns holds the columns for the "voice_settings" table.
 	VoiceSettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -24,6 +164,13 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessRulesTable,
+		GuildDictEntriesTable,
+		GuildSettingsTable,
+		IgnoreEntriesTable,
+		NgWordsTable,
+		SpokenNamesTable,
+		UserDictEntriesTable,
 		VoiceSettingsTable,
 	}
 )
@@ -10,7 +10,14 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/accessrule"
+	"github.com/kechako/yomiko/ent/guilddictentry"
+	"github.com/kechako/yomiko/ent/guildsetting"
+	"github.com/kechako/yomiko/ent/ignoreentry"
+	"github.com/kechako/yomiko/ent/ngword"
 	"github.com/kechako/yomiko/ent/predicate"
+	"github.com/kechako/yomiko/ent/spokenname"
+	"github.com/kechako/yomiko/ent/userdictentry"
 	"github.com/kechako/yomiko/ent/voicesetting"
 )
 
@@ -23,9 +30,3677 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeVoiceSetting = "VoiceSetting"
+	TypeAccessRule     = "AccessRule"
+	TypeGuildDictEntry = "GuildDictEntry"
+	TypeGuildSetting   = "GuildSetting"
+	TypeIgnoreEntry    = "IgnoreEntry"
+	TypeNGWord         = "NGWord"
+	TypeSpokenName     = "SpokenName"
+	TypeUserDictEntry  = "UserDictEntry"
+	TypeVoiceSetting   = "VoiceSetting"
 )
 
+// AccessRuleMutation represents an operation that mutates the AccessRule nodes in the graph.
+type AccessRuleMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	guild_id      *string
+	action        *accessrule.Action
+	role_id       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AccessRule, error)
+	predicates    []predicate.AccessRule
+}
+
+var _ ent.Mutation = (*AccessRuleMutation)(nil)
+
+// accessruleOption allows management of the mutation configuration using functional options.
+type accessruleOption func(*AccessRuleMutation)
+
+// newAccessRuleMutation creates new mutation for the AccessRule entity.
+func newAccessRuleMutation(c config, op Op, opts ...accessruleOption) *AccessRuleMutation {
+	m := &AccessRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAccessRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAccessRuleID sets the ID field of the mutation.
+func withAccessRuleID(id int) accessruleOption {
+	return func(m *AccessRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AccessRule
+		)
+		m.oldValue = func(ctx context.Context) (*AccessRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AccessRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAccessRule sets the old AccessRule of the mutation.
+func withAccessRule(node *AccessRule) accessruleOption {
+	return func(m *AccessRuleMutation) {
+		m.oldValue = func(context.Context) (*AccessRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AccessRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AccessRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AccessRuleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AccessRuleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AccessRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *AccessRuleMutation) SetGuildID(s string) {
+	m.guild_id = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *AccessRuleMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the AccessRule entity.
+// If the AccessRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessRuleMutation) OldGuildID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *AccessRuleMutation) ResetGuildID() {
+	m.guild_id = nil
+}
+
+// SetAction sets the "action" field.
+func (m *AccessRuleMutation) SetAction(a accessrule.Action) {
+	m.action = &a
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AccessRuleMutation) Action() (r accessrule.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the AccessRule entity.
+// If the AccessRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessRuleMutation) OldAction(ctx context.Context) (v accessrule.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *AccessRuleMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetRoleID sets the "role_id" field.
+func (m *AccessRuleMutation) SetRoleID(s string) {
+	m.role_id = &s
+}
+
+// RoleID returns the value of the "role_id" field in the mutation.
+func (m *AccessRuleMutation) RoleID() (r string, exists bool) {
+	v := m.role_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoleID returns the old "role_id" field's value of the AccessRule entity.
+// If the AccessRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccessRuleMutation) OldRoleID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoleID: %w", err)
+	}
+	return oldValue.RoleID, nil
+}
+
+// ResetRoleID resets all changes to the "role_id" field.
+func (m *AccessRuleMutation) ResetRoleID() {
+	m.role_id = nil
+}
+
+// Where appends a list predicates to the AccessRuleMutation builder.
+func (m *AccessRuleMutation) Where(ps ...predicate.AccessRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AccessRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AccessRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AccessRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AccessRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AccessRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AccessRule).
+func (m *AccessRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AccessRuleMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.guild_id != nil {
+		fields = append(fields, accessrule.FieldGuildID)
+	}
+	if m.action != nil {
+		fields = append(fields, accessrule.FieldAction)
+	}
+	if m.role_id != nil {
+		fields = append(fields, accessrule.FieldRoleID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AccessRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case accessrule.FieldGuildID:
+		return m.GuildID()
+	case accessrule.FieldAction:
+		return m.Action()
+	case accessrule.FieldRoleID:
+		return m.RoleID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AccessRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case accessrule.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case accessrule.FieldAction:
+		return m.OldAction(ctx)
+	case accessrule.FieldRoleID:
+		return m.OldRoleID(ctx)
+	}
+	return nil, fmt.Errorf("unknown AccessRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccessRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case accessrule.FieldGuildID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case accessrule.FieldAction:
+		v, ok := value.(accessrule.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case accessrule.FieldRoleID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoleID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AccessRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AccessRuleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AccessRuleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccessRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AccessRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AccessRuleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AccessRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AccessRuleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AccessRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AccessRuleMutation) ResetField(name string) error {
+	switch name {
+	case accessrule.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case accessrule.FieldAction:
+		m.ResetAction()
+		return nil
+	case accessrule.FieldRoleID:
+		m.ResetRoleID()
+		return nil
+	}
+	return fmt.Errorf("unknown AccessRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AccessRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AccessRuleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AccessRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AccessRuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AccessRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AccessRuleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AccessRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AccessRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AccessRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AccessRule edge %s", name)
+}
+
+// GuildDictEntryMutation represents an operation that mutates the GuildDictEntry nodes in the graph.
+type GuildDictEntryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	guild_id      *string
+	word          *string
+	reading       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*GuildDictEntry, error)
+	predicates    []predicate.GuildDictEntry
+}
+
+var _ ent.Mutation = (*GuildDictEntryMutation)(nil)
+
+// guilddictentryOption allows management of the mutation configuration using functional options.
+type guilddictentryOption func(*GuildDictEntryMutation)
+
+// newGuildDictEntryMutation creates new mutation for the GuildDictEntry entity.
+func newGuildDictEntryMutation(c config, op Op, opts ...guilddictentryOption) *GuildDictEntryMutation {
+	m := &GuildDictEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGuildDictEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGuildDictEntryID sets the ID field of the mutation.
+func withGuildDictEntryID(id int) guilddictentryOption {
+	return func(m *GuildDictEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GuildDictEntry
+		)
+		m.oldValue = func(ctx context.Context) (*GuildDictEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GuildDictEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGuildDictEntry sets the old GuildDictEntry of the mutation.
+func withGuildDictEntry(node *GuildDictEntry) guilddictentryOption {
+	return func(m *GuildDictEntryMutation) {
+		m.oldValue = func(context.Context) (*GuildDictEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GuildDictEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GuildDictEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GuildDictEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GuildDictEntryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GuildDictEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *GuildDictEntryMutation) SetGuildID(s string) {
+	m.guild_id = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *GuildDictEntryMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the GuildDictEntry entity.
+// If the GuildDictEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildDictEntryMutation) OldGuildID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *GuildDictEntryMutation) ResetGuildID() {
+	m.guild_id = nil
+}
+
+// SetWord sets the "word" field.
+func (m *GuildDictEntryMutation) SetWord(s string) {
+	m.word = &s
+}
+
+// Word returns the value of the "word" field in the mutation.
+func (m *GuildDictEntryMutation) Word() (r string, exists bool) {
+	v := m.word
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWord returns the old "word" field's value of the GuildDictEntry entity.
+// If the GuildDictEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildDictEntryMutation) OldWord(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWord is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWord requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWord: %w", err)
+	}
+	return oldValue.Word, nil
+}
+
+// ResetWord resets all changes to the "word" field.
+func (m *GuildDictEntryMutation) ResetWord() {
+	m.word = nil
+}
+
+// SetReading sets the "reading" field.
+func (m *GuildDictEntryMutation) SetReading(s string) {
+	m.reading = &s
+}
+
+// Reading returns the value of the "reading" field in the mutation.
+func (m *GuildDictEntryMutation) Reading() (r string, exists bool) {
+	v := m.reading
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReading returns the old "reading" field's value of the GuildDictEntry entity.
+// If the GuildDictEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildDictEntryMutation) OldReading(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReading is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReading requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReading: %w", err)
+	}
+	return oldValue.Reading, nil
+}
+
+// ResetReading resets all changes to the "reading" field.
+func (m *GuildDictEntryMutation) ResetReading() {
+	m.reading = nil
+}
+
+// Where appends a list predicates to the GuildDictEntryMutation builder.
+func (m *GuildDictEntryMutation) Where(ps ...predicate.GuildDictEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GuildDictEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GuildDictEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GuildDictEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GuildDictEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GuildDictEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GuildDictEntry).
+func (m *GuildDictEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GuildDictEntryMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.guild_id != nil {
+		fields = append(fields, guilddictentry.FieldGuildID)
+	}
+	if m.word != nil {
+		fields = append(fields, guilddictentry.FieldWord)
+	}
+	if m.reading != nil {
+		fields = append(fields, guilddictentry.FieldReading)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GuildDictEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case guilddictentry.FieldGuildID:
+		return m.GuildID()
+	case guilddictentry.FieldWord:
+		return m.Word()
+	case guilddictentry.FieldReading:
+		return m.Reading()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GuildDictEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case guilddictentry.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case guilddictentry.FieldWord:
+		return m.OldWord(ctx)
+	case guilddictentry.FieldReading:
+		return m.OldReading(ctx)
+	}
+	return nil, fmt.Errorf("unknown GuildDictEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GuildDictEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case guilddictentry.FieldGuildID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case guilddictentry.FieldWord:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWord(v)
+		return nil
+	case guilddictentry.FieldReading:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReading(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GuildDictEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GuildDictEntryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GuildDictEntryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GuildDictEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown GuildDictEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GuildDictEntryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GuildDictEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GuildDictEntryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown GuildDictEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GuildDictEntryMutation) ResetField(name string) error {
+	switch name {
+	case guilddictentry.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case guilddictentry.FieldWord:
+		m.ResetWord()
+		return nil
+	case guilddictentry.FieldReading:
+		m.ResetReading()
+		return nil
+	}
+	return fmt.Errorf("unknown GuildDictEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GuildDictEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GuildDictEntryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GuildDictEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GuildDictEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GuildDictEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GuildDictEntryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GuildDictEntryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown GuildDictEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GuildDictEntryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown GuildDictEntry edge %s", name)
+}
+
+// GuildSettingMutation represents an operation that mutates the GuildSetting nodes in the graph.
+type GuildSettingMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	guild_id            *string
+	opus_application    *guildsetting.OpusApplication
+	opus_bitrate        *int
+	addopus_bitrate     *int
+	opus_complexity     *int
+	addopus_complexity  *int
+	opus_fec            *bool
+	opus_dtx            *bool
+	read_bots           *bool
+	read_webhooks       *bool
+	ng_word_mode        *guildsetting.NgWordMode
+	ng_word_replacement *string
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*GuildSetting, error)
+	predicates          []predicate.GuildSetting
+}
+
+var _ ent.Mutation = (*GuildSettingMutation)(nil)
+
+// guildsettingOption allows management of the mutation configuration using functional options.
+type guildsettingOption func(*GuildSettingMutation)
+
+// newGuildSettingMutation creates new mutation for the GuildSetting entity.
+func newGuildSettingMutation(c config, op Op, opts ...guildsettingOption) *GuildSettingMutation {
+	m := &GuildSettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGuildSetting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGuildSettingID sets the ID field of the mutation.
+func withGuildSettingID(id int) guildsettingOption {
+	return func(m *GuildSettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *GuildSetting
+		)
+		m.oldValue = func(ctx context.Context) (*GuildSetting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().GuildSetting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGuildSetting sets the old GuildSetting of the mutation.
+func withGuildSetting(node *GuildSetting) guildsettingOption {
+	return func(m *GuildSettingMutation) {
+		m.oldValue = func(context.Context) (*GuildSetting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GuildSettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GuildSettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GuildSettingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GuildSettingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().GuildSetting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *GuildSettingMutation) SetGuildID(s string) {
+	m.guild_id = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *GuildSettingMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the GuildSetting entity.
+// If the GuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildSettingMutation) OldGuildID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *GuildSettingMutation) ResetGuildID() {
+	m.guild_id = nil
+}
+
+// SetOpusApplication sets the "opus_application" field.
+func (m *GuildSettingMutation) SetOpusApplication(ga guildsetting.OpusApplication) {
+	m.opus_application = &ga
+}
+
+// OpusApplication returns the value of the "opus_application" field in the mutation.
+func (m *GuildSettingMutation) OpusApplication() (r guildsetting.OpusApplication, exists bool) {
+	v := m.opus_application
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpusApplication returns the old "opus_application" field's value of the GuildSetting entity.
+// If the GuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildSettingMutation) OldOpusApplication(ctx context.Context) (v *guildsetting.OpusApplication, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpusApplication is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpusApplication requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpusApplication: %w", err)
+	}
+	return oldValue.OpusApplication, nil
+}
+
+// ClearOpusApplication clears the value of the "opus_application" field.
+func (m *GuildSettingMutation) ClearOpusApplication() {
+	m.opus_application = nil
+	m.clearedFields[guildsetting.FieldOpusApplication] = struct{}{}
+}
+
+// OpusApplicationCleared returns if the "opus_application" field was cleared in this mutation.
+func (m *GuildSettingMutation) OpusApplicationCleared() bool {
+	_, ok := m.clearedFields[guildsetting.FieldOpusApplication]
+	return ok
+}
+
+// ResetOpusApplication resets all changes to the "opus_application" field.
+func (m *GuildSettingMutation) ResetOpusApplication() {
+	m.opus_application = nil
+	delete(m.clearedFields, guildsetting.FieldOpusApplication)
+}
+
+// SetOpusBitrate sets the "opus_bitrate" field.
+func (m *GuildSettingMutation) SetOpusBitrate(i int) {
+	m.opus_bitrate = &i
+	m.addopus_bitrate = nil
+}
+
+// OpusBitrate returns the value of the "opus_bitrate" field in the mutation.
+func (m *GuildSettingMutation) OpusBitrate() (r int, exists bool) {
+	v := m.opus_bitrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpusBitrate returns the old "opus_bitrate" field's value of the GuildSetting entity.
+// If the GuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildSettingMutation) OldOpusBitrate(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpusBitrate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpusBitrate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpusBitrate: %w", err)
+	}
+	return oldValue.OpusBitrate, nil
+}
+
+// AddOpusBitrate adds i to the "opus_bitrate" field.
+func (m *GuildSettingMutation) AddOpusBitrate(i int) {
+	if m.addopus_bitrate != nil {
+		*m.addopus_bitrate += i
+	} else {
+		m.addopus_bitrate = &i
+	}
+}
+
+// AddedOpusBitrate returns the value that was added to the "opus_bitrate" field in this mutation.
+func (m *GuildSettingMutation) AddedOpusBitrate() (r int, exists bool) {
+	v := m.addopus_bitrate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOpusBitrate clears the value of the "opus_bitrate" field.
+func (m *GuildSettingMutation) ClearOpusBitrate() {
+	m.opus_bitrate = nil
+	m.addopus_bitrate = nil
+	m.clearedFields[guildsetting.FieldOpusBitrate] = struct{}{}
+}
+
+// OpusBitrateCleared returns if the "opus_bitrate" field was cleared in this mutation.
+func (m *GuildSettingMutation) OpusBitrateCleared() bool {
+	_, ok := m.clearedFields[guildsetting.FieldOpusBitrate]
+	return ok
+}
+
+// ResetOpusBitrate resets all changes to the "opus_bitrate" field.
+func (m *GuildSettingMutation) ResetOpusBitrate() {
+	m.opus_bitrate = nil
+	m.addopus_bitrate = nil
+	delete(m.clearedFields, guildsetting.FieldOpusBitrate)
+}
+
+// SetOpusComplexity sets the "opus_complexity" field.
+func (m *GuildSettingMutation) SetOpusComplexity(i int) {
+	m.opus_complexity = &i
+	m.addopus_complexity = nil
+}
+
+// OpusComplexity returns the value of the "opus_complexity" field in the mutation.
+func (m *GuildSettingMutation) OpusComplexity() (r int, exists bool) {
+	v := m.opus_complexity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpusComplexity returns the old "opus_complexity" field's value of the GuildSetting entity.
+// If the GuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildSettingMutation) OldOpusComplexity(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpusComplexity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpusComplexity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpusComplexity: %w", err)
+	}
+	return oldValue.OpusComplexity, nil
+}
+
+// AddOpusComplexity adds i to the "opus_complexity" field.
+func (m *GuildSettingMutation) AddOpusComplexity(i int) {
+	if m.addopus_complexity != nil {
+		*m.addopus_complexity += i
+	} else {
+		m.addopus_complexity = &i
+	}
+}
+
+// AddedOpusComplexity returns the value that was added to the "opus_complexity" field in this mutation.
+func (m *GuildSettingMutation) AddedOpusComplexity() (r int, exists bool) {
+	v := m.addopus_complexity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOpusComplexity clears the value of the "opus_complexity" field.
+func (m *GuildSettingMutation) ClearOpusComplexity() {
+	m.opus_complexity = nil
+	m.addopus_complexity = nil
+	m.clearedFields[guildsetting.FieldOpusComplexity] = struct{}{}
+}
+
+// OpusComplexityCleared returns if the "opus_complexity" field was cleared in this mutation.
+func (m *GuildSettingMutation) OpusComplexityCleared() bool {
+	_, ok := m.clearedFields[guildsetting.FieldOpusComplexity]
+	return ok
+}
+
+// ResetOpusComplexity resets all changes to the "opus_complexity" field.
+func (m *GuildSettingMutation) ResetOpusComplexity() {
+	m.opus_complexity = nil
+	m.addopus_complexity = nil
+	delete(m.clearedFields, guildsetting.FieldOpusComplexity)
+}
+
+// SetOpusFec sets the "opus_fec" field.
+func (m *GuildSettingMutation) SetOpusFec(b bool) {
+	m.opus_fec = &b
+}
+
+// OpusFec returns the value of the "opus_fec" field in the mutation.
+func (m *GuildSettingMutation) OpusFec() (r bool, exists bool) {
+	v := m.opus_fec
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpusFec returns the old "opus_fec" field's value of the GuildSetting entity.
+// If the GuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildSettingMutation) OldOpusFec(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpusFec is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpusFec requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpusFec: %w", err)
+	}
+	return oldValue.OpusFec, nil
+}
+
+// ClearOpusFec clears the value of the "opus_fec" field.
+func (m *GuildSettingMutation) ClearOpusFec() {
+	m.opus_fec = nil
+	m.clearedFields[guildsetting.FieldOpusFec] = struct{}{}
+}
+
+// OpusFecCleared returns if the "opus_fec" field was cleared in this mutation.
+func (m *GuildSettingMutation) OpusFecCleared() bool {
+	_, ok := m.clearedFields[guildsetting.FieldOpusFec]
+	return ok
+}
+
+// ResetOpusFec resets all changes to the "opus_fec" field.
+func (m *GuildSettingMutation) ResetOpusFec() {
+	m.opus_fec = nil
+	delete(m.clearedFields, guildsetting.FieldOpusFec)
+}
+
+// SetOpusDtx sets the "opus_dtx" field.
+func (m *GuildSettingMutation) SetOpusDtx(b bool) {
+	m.opus_dtx = &b
+}
+
+// OpusDtx returns the value of the "opus_dtx" field in the mutation.
+func (m *GuildSettingMutation) OpusDtx() (r bool, exists bool) {
+	v := m.opus_dtx
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpusDtx returns the old "opus_dtx" field's value of the GuildSetting entity.
+// If the GuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildSettingMutation) OldOpusDtx(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpusDtx is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpusDtx requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpusDtx: %w", err)
+	}
+	return oldValue.OpusDtx, nil
+}
+
+// ClearOpusDtx clears the value of the "opus_dtx" field.
+func (m *GuildSettingMutation) ClearOpusDtx() {
+	m.opus_dtx = nil
+	m.clearedFields[guildsetting.FieldOpusDtx] = struct{}{}
+}
+
+// OpusDtxCleared returns if the "opus_dtx" field was cleared in this mutation.
+func (m *GuildSettingMutation) OpusDtxCleared() bool {
+	_, ok := m.clearedFields[guildsetting.FieldOpusDtx]
+	return ok
+}
+
+// ResetOpusDtx resets all changes to the "opus_dtx" field.
+func (m *GuildSettingMutation) ResetOpusDtx() {
+	m.opus_dtx = nil
+	delete(m.clearedFields, guildsetting.FieldOpusDtx)
+}
+
+// SetReadBots sets the "read_bots" field.
+func (m *GuildSettingMutation) SetReadBots(b bool) {
+	m.read_bots = &b
+}
+
+// ReadBots returns the value of the "read_bots" field in the mutation.
+func (m *GuildSettingMutation) ReadBots() (r bool, exists bool) {
+	v := m.read_bots
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadBots returns the old "read_bots" field's value of the GuildSetting entity.
+// If the GuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildSettingMutation) OldReadBots(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadBots is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadBots requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadBots: %w", err)
+	}
+	return oldValue.ReadBots, nil
+}
+
+// ClearReadBots clears the value of the "read_bots" field.
+func (m *GuildSettingMutation) ClearReadBots() {
+	m.read_bots = nil
+	m.clearedFields[guildsetting.FieldReadBots] = struct{}{}
+}
+
+// ReadBotsCleared returns if the "read_bots" field was cleared in this mutation.
+func (m *GuildSettingMutation) ReadBotsCleared() bool {
+	_, ok := m.clearedFields[guildsetting.FieldReadBots]
+	return ok
+}
+
+// ResetReadBots resets all changes to the "read_bots" field.
+func (m *GuildSettingMutation) ResetReadBots() {
+	m.read_bots = nil
+	delete(m.clearedFields, guildsetting.FieldReadBots)
+}
+
+// SetReadWebhooks sets the "read_webhooks" field.
+func (m *GuildSettingMutation) SetReadWebhooks(b bool) {
+	m.read_webhooks = &b
+}
+
+// ReadWebhooks returns the value of the "read_webhooks" field in the mutation.
+func (m *GuildSettingMutation) ReadWebhooks() (r bool, exists bool) {
+	v := m.read_webhooks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadWebhooks returns the old "read_webhooks" field's value of the GuildSetting entity.
+// If the GuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildSettingMutation) OldReadWebhooks(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadWebhooks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadWebhooks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadWebhooks: %w", err)
+	}
+	return oldValue.ReadWebhooks, nil
+}
+
+// ClearReadWebhooks clears the value of the "read_webhooks" field.
+func (m *GuildSettingMutation) ClearReadWebhooks() {
+	m.read_webhooks = nil
+	m.clearedFields[guildsetting.FieldReadWebhooks] = struct{}{}
+}
+
+// ReadWebhooksCleared returns if the "read_webhooks" field was cleared in this mutation.
+func (m *GuildSettingMutation) ReadWebhooksCleared() bool {
+	_, ok := m.clearedFields[guildsetting.FieldReadWebhooks]
+	return ok
+}
+
+// ResetReadWebhooks resets all changes to the "read_webhooks" field.
+func (m *GuildSettingMutation) ResetReadWebhooks() {
+	m.read_webhooks = nil
+	delete(m.clearedFields, guildsetting.FieldReadWebhooks)
+}
+
+// SetNgWordMode sets the "ng_word_mode" field.
+func (m *GuildSettingMutation) SetNgWordMode(gwm guildsetting.NgWordMode) {
+	m.ng_word_mode = &gwm
+}
+
+// NgWordMode returns the value of the "ng_word_mode" field in the mutation.
+func (m *GuildSettingMutation) NgWordMode() (r guildsetting.NgWordMode, exists bool) {
+	v := m.ng_word_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNgWordMode returns the old "ng_word_mode" field's value of the GuildSetting entity.
+// If the GuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildSettingMutation) OldNgWordMode(ctx context.Context) (v *guildsetting.NgWordMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNgWordMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNgWordMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNgWordMode: %w", err)
+	}
+	return oldValue.NgWordMode, nil
+}
+
+// ClearNgWordMode clears the value of the "ng_word_mode" field.
+func (m *GuildSettingMutation) ClearNgWordMode() {
+	m.ng_word_mode = nil
+	m.clearedFields[guildsetting.FieldNgWordMode] = struct{}{}
+}
+
+// NgWordModeCleared returns if the "ng_word_mode" field was cleared in this mutation.
+func (m *GuildSettingMutation) NgWordModeCleared() bool {
+	_, ok := m.clearedFields[guildsetting.FieldNgWordMode]
+	return ok
+}
+
+// ResetNgWordMode resets all changes to the "ng_word_mode" field.
+func (m *GuildSettingMutation) ResetNgWordMode() {
+	m.ng_word_mode = nil
+	delete(m.clearedFields, guildsetting.FieldNgWordMode)
+}
+
+// SetNgWordReplacement sets the "ng_word_replacement" field.
+func (m *GuildSettingMutation) SetNgWordReplacement(s string) {
+	m.ng_word_replacement = &s
+}
+
+// NgWordReplacement returns the value of the "ng_word_replacement" field in the mutation.
+func (m *GuildSettingMutation) NgWordReplacement() (r string, exists bool) {
+	v := m.ng_word_replacement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNgWordReplacement returns the old "ng_word_replacement" field's value of the GuildSetting entity.
+// If the GuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GuildSettingMutation) OldNgWordReplacement(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNgWordReplacement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNgWordReplacement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNgWordReplacement: %w", err)
+	}
+	return oldValue.NgWordReplacement, nil
+}
+
+// ClearNgWordReplacement clears the value of the "ng_word_replacement" field.
+func (m *GuildSettingMutation) ClearNgWordReplacement() {
+	m.ng_word_replacement = nil
+	m.clearedFields[guildsetting.FieldNgWordReplacement] = struct{}{}
+}
+
+// NgWordReplacementCleared returns if the "ng_word_replacement" field was cleared in this mutation.
+func (m *GuildSettingMutation) NgWordReplacementCleared() bool {
+	_, ok := m.clearedFields[guildsetting.FieldNgWordReplacement]
+	return ok
+}
+
+// ResetNgWordReplacement resets all changes to the "ng_word_replacement" field.
+func (m *GuildSettingMutation) ResetNgWordReplacement() {
+	m.ng_word_replacement = nil
+	delete(m.clearedFields, guildsetting.FieldNgWordReplacement)
+}
+
+// Where appends a list predicates to the GuildSettingMutation builder.
+func (m *GuildSettingMutation) Where(ps ...predicate.GuildSetting) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GuildSettingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GuildSettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.GuildSetting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GuildSettingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GuildSettingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (GuildSetting).
+func (m *GuildSettingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GuildSettingMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.guild_id != nil {
+		fields = append(fields, guildsetting.FieldGuildID)
+	}
+	if m.opus_application != nil {
+		fields = append(fields, guildsetting.FieldOpusApplication)
+	}
+	if m.opus_bitrate != nil {
+		fields = append(fields, guildsetting.FieldOpusBitrate)
+	}
+	if m.opus_complexity != nil {
+		fields = append(fields, guildsetting.FieldOpusComplexity)
+	}
+	if m.opus_fec != nil {
+		fields = append(fields, guildsetting.FieldOpusFec)
+	}
+	if m.opus_dtx != nil {
+		fields = append(fields, guildsetting.FieldOpusDtx)
+	}
+	if m.read_bots != nil {
+		fields = append(fields, guildsetting.FieldReadBots)
+	}
+	if m.read_webhooks != nil {
+		fields = append(fields, guildsetting.FieldReadWebhooks)
+	}
+	if m.ng_word_mode != nil {
+		fields = append(fields, guildsetting.FieldNgWordMode)
+	}
+	if m.ng_word_replacement != nil {
+		fields = append(fields, guildsetting.FieldNgWordReplacement)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GuildSettingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case guildsetting.FieldGuildID:
+		return m.GuildID()
+	case guildsetting.FieldOpusApplication:
+		return m.OpusApplication()
+	case guildsetting.FieldOpusBitrate:
+		return m.OpusBitrate()
+	case guildsetting.FieldOpusComplexity:
+		return m.OpusComplexity()
+	case guildsetting.FieldOpusFec:
+		return m.OpusFec()
+	case guildsetting.FieldOpusDtx:
+		return m.OpusDtx()
+	case guildsetting.FieldReadBots:
+		return m.ReadBots()
+	case guildsetting.FieldReadWebhooks:
+		return m.ReadWebhooks()
+	case guildsetting.FieldNgWordMode:
+		return m.NgWordMode()
+	case guildsetting.FieldNgWordReplacement:
+		return m.NgWordReplacement()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GuildSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case guildsetting.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case guildsetting.FieldOpusApplication:
+		return m.OldOpusApplication(ctx)
+	case guildsetting.FieldOpusBitrate:
+		return m.OldOpusBitrate(ctx)
+	case guildsetting.FieldOpusComplexity:
+		return m.OldOpusComplexity(ctx)
+	case guildsetting.FieldOpusFec:
+		return m.OldOpusFec(ctx)
+	case guildsetting.FieldOpusDtx:
+		return m.OldOpusDtx(ctx)
+	case guildsetting.FieldReadBots:
+		return m.OldReadBots(ctx)
+	case guildsetting.FieldReadWebhooks:
+		return m.OldReadWebhooks(ctx)
+	case guildsetting.FieldNgWordMode:
+		return m.OldNgWordMode(ctx)
+	case guildsetting.FieldNgWordReplacement:
+		return m.OldNgWordReplacement(ctx)
+	}
+	return nil, fmt.Errorf("unknown GuildSetting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GuildSettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case guildsetting.FieldGuildID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case guildsetting.FieldOpusApplication:
+		v, ok := value.(guildsetting.OpusApplication)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpusApplication(v)
+		return nil
+	case guildsetting.FieldOpusBitrate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpusBitrate(v)
+		return nil
+	case guildsetting.FieldOpusComplexity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpusComplexity(v)
+		return nil
+	case guildsetting.FieldOpusFec:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpusFec(v)
+		return nil
+	case guildsetting.FieldOpusDtx:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpusDtx(v)
+		return nil
+	case guildsetting.FieldReadBots:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadBots(v)
+		return nil
+	case guildsetting.FieldReadWebhooks:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadWebhooks(v)
+		return nil
+	case guildsetting.FieldNgWordMode:
+		v, ok := value.(guildsetting.NgWordMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNgWordMode(v)
+		return nil
+	case guildsetting.FieldNgWordReplacement:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNgWordReplacement(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GuildSetting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GuildSettingMutation) AddedFields() []string {
+	var fields []string
+	if m.addopus_bitrate != nil {
+		fields = append(fields, guildsetting.FieldOpusBitrate)
+	}
+	if m.addopus_complexity != nil {
+		fields = append(fields, guildsetting.FieldOpusComplexity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GuildSettingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case guildsetting.FieldOpusBitrate:
+		return m.AddedOpusBitrate()
+	case guildsetting.FieldOpusComplexity:
+		return m.AddedOpusComplexity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GuildSettingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case guildsetting.FieldOpusBitrate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOpusBitrate(v)
+		return nil
+	case guildsetting.FieldOpusComplexity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOpusComplexity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown GuildSetting numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GuildSettingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(guildsetting.FieldOpusApplication) {
+		fields = append(fields, guildsetting.FieldOpusApplication)
+	}
+	if m.FieldCleared(guildsetting.FieldOpusBitrate) {
+		fields = append(fields, guildsetting.FieldOpusBitrate)
+	}
+	if m.FieldCleared(guildsetting.FieldOpusComplexity) {
+		fields = append(fields, guildsetting.FieldOpusComplexity)
+	}
+	if m.FieldCleared(guildsetting.FieldOpusFec) {
+		fields = append(fields, guildsetting.FieldOpusFec)
+	}
+	if m.FieldCleared(guildsetting.FieldOpusDtx) {
+		fields = append(fields, guildsetting.FieldOpusDtx)
+	}
+	if m.FieldCleared(guildsetting.FieldReadBots) {
+		fields = append(fields, guildsetting.FieldReadBots)
+	}
+	if m.FieldCleared(guildsetting.FieldReadWebhooks) {
+		fields = append(fields, guildsetting.FieldReadWebhooks)
+	}
+	if m.FieldCleared(guildsetting.FieldNgWordMode) {
+		fields = append(fields, guildsetting.FieldNgWordMode)
+	}
+	if m.FieldCleared(guildsetting.FieldNgWordReplacement) {
+		fields = append(fields, guildsetting.FieldNgWordReplacement)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GuildSettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GuildSettingMutation) ClearField(name string) error {
+	switch name {
+	case guildsetting.FieldOpusApplication:
+		m.ClearOpusApplication()
+		return nil
+	case guildsetting.FieldOpusBitrate:
+		m.ClearOpusBitrate()
+		return nil
+	case guildsetting.FieldOpusComplexity:
+		m.ClearOpusComplexity()
+		return nil
+	case guildsetting.FieldOpusFec:
+		m.ClearOpusFec()
+		return nil
+	case guildsetting.FieldOpusDtx:
+		m.ClearOpusDtx()
+		return nil
+	case guildsetting.FieldReadBots:
+		m.ClearReadBots()
+		return nil
+	case guildsetting.FieldReadWebhooks:
+		m.ClearReadWebhooks()
+		return nil
+	case guildsetting.FieldNgWordMode:
+		m.ClearNgWordMode()
+		return nil
+	case guildsetting.FieldNgWordReplacement:
+		m.ClearNgWordReplacement()
+		return nil
+	}
+	return fmt.Errorf("unknown GuildSetting nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GuildSettingMutation) ResetField(name string) error {
+	switch name {
+	case guildsetting.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case guildsetting.FieldOpusApplication:
+		m.ResetOpusApplication()
+		return nil
+	case guildsetting.FieldOpusBitrate:
+		m.ResetOpusBitrate()
+		return nil
+	case guildsetting.FieldOpusComplexity:
+		m.ResetOpusComplexity()
+		return nil
+	case guildsetting.FieldOpusFec:
+		m.ResetOpusFec()
+		return nil
+	case guildsetting.FieldOpusDtx:
+		m.ResetOpusDtx()
+		return nil
+	case guildsetting.FieldReadBots:
+		m.ResetReadBots()
+		return nil
+	case guildsetting.FieldReadWebhooks:
+		m.ResetReadWebhooks()
+		return nil
+	case guildsetting.FieldNgWordMode:
+		m.ResetNgWordMode()
+		return nil
+	case guildsetting.FieldNgWordReplacement:
+		m.ResetNgWordReplacement()
+		return nil
+	}
+	return fmt.Errorf("unknown GuildSetting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GuildSettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GuildSettingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GuildSettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GuildSettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GuildSettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GuildSettingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GuildSettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown GuildSetting unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GuildSettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown GuildSetting edge %s", name)
+}
+
+// IgnoreEntryMutation represents an operation that mutates the IgnoreEntry nodes in the graph.
+type IgnoreEntryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	guild_id      *string
+	kind          *ignoreentry.Kind
+	target_id     *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*IgnoreEntry, error)
+	predicates    []predicate.IgnoreEntry
+}
+
+var _ ent.Mutation = (*IgnoreEntryMutation)(nil)
+
+// ignoreentryOption allows management of the mutation configuration using functional options.
+type ignoreentryOption func(*IgnoreEntryMutation)
+
+// newIgnoreEntryMutation creates new mutation for the IgnoreEntry entity.
+func newIgnoreEntryMutation(c config, op Op, opts ...ignoreentryOption) *IgnoreEntryMutation {
+	m := &IgnoreEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIgnoreEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIgnoreEntryID sets the ID field of the mutation.
+func withIgnoreEntryID(id int) ignoreentryOption {
+	return func(m *IgnoreEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *IgnoreEntry
+		)
+		m.oldValue = func(ctx context.Context) (*IgnoreEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().IgnoreEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIgnoreEntry sets the old IgnoreEntry of the mutation.
+func withIgnoreEntry(node *IgnoreEntry) ignoreentryOption {
+	return func(m *IgnoreEntryMutation) {
+		m.oldValue = func(context.Context) (*IgnoreEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IgnoreEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IgnoreEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IgnoreEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IgnoreEntryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().IgnoreEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *IgnoreEntryMutation) SetGuildID(s string) {
+	m.guild_id = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *IgnoreEntryMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the IgnoreEntry entity.
+// If the IgnoreEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IgnoreEntryMutation) OldGuildID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *IgnoreEntryMutation) ResetGuildID() {
+	m.guild_id = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *IgnoreEntryMutation) SetKind(i ignoreentry.Kind) {
+	m.kind = &i
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *IgnoreEntryMutation) Kind() (r ignoreentry.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the IgnoreEntry entity.
+// If the IgnoreEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IgnoreEntryMutation) OldKind(ctx context.Context) (v ignoreentry.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *IgnoreEntryMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *IgnoreEntryMutation) SetTargetID(s string) {
+	m.target_id = &s
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *IgnoreEntryMutation) TargetID() (r string, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetID returns the old "target_id" field's value of the IgnoreEntry entity.
+// If the IgnoreEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IgnoreEntryMutation) OldTargetID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
+	}
+	return oldValue.TargetID, nil
+}
+
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *IgnoreEntryMutation) ResetTargetID() {
+	m.target_id = nil
+}
+
+// Where appends a list predicates to the IgnoreEntryMutation builder.
+func (m *IgnoreEntryMutation) Where(ps ...predicate.IgnoreEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IgnoreEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IgnoreEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.IgnoreEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IgnoreEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IgnoreEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (IgnoreEntry).
+func (m *IgnoreEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IgnoreEntryMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.guild_id != nil {
+		fields = append(fields, ignoreentry.FieldGuildID)
+	}
+	if m.kind != nil {
+		fields = append(fields, ignoreentry.FieldKind)
+	}
+	if m.target_id != nil {
+		fields = append(fields, ignoreentry.FieldTargetID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IgnoreEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ignoreentry.FieldGuildID:
+		return m.GuildID()
+	case ignoreentry.FieldKind:
+		return m.Kind()
+	case ignoreentry.FieldTargetID:
+		return m.TargetID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IgnoreEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ignoreentry.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case ignoreentry.FieldKind:
+		return m.OldKind(ctx)
+	case ignoreentry.FieldTargetID:
+		return m.OldTargetID(ctx)
+	}
+	return nil, fmt.Errorf("unknown IgnoreEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IgnoreEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ignoreentry.FieldGuildID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case ignoreentry.FieldKind:
+		v, ok := value.(ignoreentry.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case ignoreentry.FieldTargetID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown IgnoreEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IgnoreEntryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IgnoreEntryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IgnoreEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown IgnoreEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IgnoreEntryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IgnoreEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IgnoreEntryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown IgnoreEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IgnoreEntryMutation) ResetField(name string) error {
+	switch name {
+	case ignoreentry.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case ignoreentry.FieldKind:
+		m.ResetKind()
+		return nil
+	case ignoreentry.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	}
+	return fmt.Errorf("unknown IgnoreEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IgnoreEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IgnoreEntryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IgnoreEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IgnoreEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IgnoreEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IgnoreEntryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IgnoreEntryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown IgnoreEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IgnoreEntryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown IgnoreEntry edge %s", name)
+}
+
+// NGWordMutation represents an operation that mutates the NGWord nodes in the graph.
+type NGWordMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	guild_id      *string
+	pattern       *string
+	regex         *bool
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*NGWord, error)
+	predicates    []predicate.NGWord
+}
+
+var _ ent.Mutation = (*NGWordMutation)(nil)
+
+// ngwordOption allows management of the mutation configuration using functional options.
+type ngwordOption func(*NGWordMutation)
+
+// newNGWordMutation creates new mutation for the NGWord entity.
+func newNGWordMutation(c config, op Op, opts ...ngwordOption) *NGWordMutation {
+	m := &NGWordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNGWord,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNGWordID sets the ID field of the mutation.
+func withNGWordID(id int) ngwordOption {
+	return func(m *NGWordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *NGWord
+		)
+		m.oldValue = func(ctx context.Context) (*NGWord, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().NGWord.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNGWord sets the old NGWord of the mutation.
+func withNGWord(node *NGWord) ngwordOption {
+	return func(m *NGWordMutation) {
+		m.oldValue = func(context.Context) (*NGWord, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NGWordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NGWordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NGWordMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NGWordMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().NGWord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *NGWordMutation) SetGuildID(s string) {
+	m.guild_id = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *NGWordMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the NGWord entity.
+// If the NGWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NGWordMutation) OldGuildID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *NGWordMutation) ResetGuildID() {
+	m.guild_id = nil
+}
+
+// SetPattern sets the "pattern" field.
+func (m *NGWordMutation) SetPattern(s string) {
+	m.pattern = &s
+}
+
+// Pattern returns the value of the "pattern" field in the mutation.
+func (m *NGWordMutation) Pattern() (r string, exists bool) {
+	v := m.pattern
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPattern returns the old "pattern" field's value of the NGWord entity.
+// If the NGWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NGWordMutation) OldPattern(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPattern is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPattern requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPattern: %w", err)
+	}
+	return oldValue.Pattern, nil
+}
+
+// ResetPattern resets all changes to the "pattern" field.
+func (m *NGWordMutation) ResetPattern() {
+	m.pattern = nil
+}
+
+// SetRegex sets the "regex" field.
+func (m *NGWordMutation) SetRegex(b bool) {
+	m.regex = &b
+}
+
+// Regex returns the value of the "regex" field in the mutation.
+func (m *NGWordMutation) Regex() (r bool, exists bool) {
+	v := m.regex
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegex returns the old "regex" field's value of the NGWord entity.
+// If the NGWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NGWordMutation) OldRegex(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegex: %w", err)
+	}
+	return oldValue.Regex, nil
+}
+
+// ResetRegex resets all changes to the "regex" field.
+func (m *NGWordMutation) ResetRegex() {
+	m.regex = nil
+}
+
+// Where appends a list predicates to the NGWordMutation builder.
+func (m *NGWordMutation) Where(ps ...predicate.NGWord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NGWordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NGWordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.NGWord, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NGWordMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NGWordMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (NGWord).
+func (m *NGWordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NGWordMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.guild_id != nil {
+		fields = append(fields, ngword.FieldGuildID)
+	}
+	if m.pattern != nil {
+		fields = append(fields, ngword.FieldPattern)
+	}
+	if m.regex != nil {
+		fields = append(fields, ngword.FieldRegex)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NGWordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ngword.FieldGuildID:
+		return m.GuildID()
+	case ngword.FieldPattern:
+		return m.Pattern()
+	case ngword.FieldRegex:
+		return m.Regex()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NGWordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ngword.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case ngword.FieldPattern:
+		return m.OldPattern(ctx)
+	case ngword.FieldRegex:
+		return m.OldRegex(ctx)
+	}
+	return nil, fmt.Errorf("unknown NGWord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NGWordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ngword.FieldGuildID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case ngword.FieldPattern:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPattern(v)
+		return nil
+	case ngword.FieldRegex:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown NGWord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NGWordMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NGWordMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NGWordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown NGWord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NGWordMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NGWordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NGWordMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown NGWord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NGWordMutation) ResetField(name string) error {
+	switch name {
+	case ngword.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case ngword.FieldPattern:
+		m.ResetPattern()
+		return nil
+	case ngword.FieldRegex:
+		m.ResetRegex()
+		return nil
+	}
+	return fmt.Errorf("unknown NGWord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NGWordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NGWordMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NGWordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NGWordMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NGWordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NGWordMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NGWordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown NGWord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NGWordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown NGWord edge %s", name)
+}
+
+// SpokenNameMutation represents an operation that mutates the SpokenName nodes in the graph.
+type SpokenNameMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	guild_id      *string
+	user_id       *string
+	name          *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SpokenName, error)
+	predicates    []predicate.SpokenName
+}
+
+var _ ent.Mutation = (*SpokenNameMutation)(nil)
+
+// spokennameOption allows management of the mutation configuration using functional options.
+type spokennameOption func(*SpokenNameMutation)
+
+// newSpokenNameMutation creates new mutation for the SpokenName entity.
+func newSpokenNameMutation(c config, op Op, opts ...spokennameOption) *SpokenNameMutation {
+	m := &SpokenNameMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSpokenName,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSpokenNameID sets the ID field of the mutation.
+func withSpokenNameID(id int) spokennameOption {
+	return func(m *SpokenNameMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SpokenName
+		)
+		m.oldValue = func(ctx context.Context) (*SpokenName, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SpokenName.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSpokenName sets the old SpokenName of the mutation.
+func withSpokenName(node *SpokenName) spokennameOption {
+	return func(m *SpokenNameMutation) {
+		m.oldValue = func(context.Context) (*SpokenName, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SpokenNameMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SpokenNameMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SpokenNameMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SpokenNameMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SpokenName.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *SpokenNameMutation) SetGuildID(s string) {
+	m.guild_id = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *SpokenNameMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the SpokenName entity.
+// If the SpokenName object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpokenNameMutation) OldGuildID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *SpokenNameMutation) ResetGuildID() {
+	m.guild_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SpokenNameMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SpokenNameMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SpokenName entity.
+// If the SpokenName object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpokenNameMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SpokenNameMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *SpokenNameMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SpokenNameMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SpokenName entity.
+// If the SpokenName object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpokenNameMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SpokenNameMutation) ResetName() {
+	m.name = nil
+}
+
+// Where appends a list predicates to the SpokenNameMutation builder.
+func (m *SpokenNameMutation) Where(ps ...predicate.SpokenName) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SpokenNameMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SpokenNameMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SpokenName, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SpokenNameMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SpokenNameMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SpokenName).
+func (m *SpokenNameMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SpokenNameMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.guild_id != nil {
+		fields = append(fields, spokenname.FieldGuildID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, spokenname.FieldUserID)
+	}
+	if m.name != nil {
+		fields = append(fields, spokenname.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SpokenNameMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case spokenname.FieldGuildID:
+		return m.GuildID()
+	case spokenname.FieldUserID:
+		return m.UserID()
+	case spokenname.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SpokenNameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case spokenname.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case spokenname.FieldUserID:
+		return m.OldUserID(ctx)
+	case spokenname.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown SpokenName field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SpokenNameMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case spokenname.FieldGuildID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case spokenname.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case spokenname.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SpokenName field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SpokenNameMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SpokenNameMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SpokenNameMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SpokenName numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SpokenNameMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SpokenNameMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SpokenNameMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SpokenName nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SpokenNameMutation) ResetField(name string) error {
+	switch name {
+	case spokenname.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case spokenname.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case spokenname.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown SpokenName field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SpokenNameMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SpokenNameMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SpokenNameMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SpokenNameMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SpokenNameMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SpokenNameMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SpokenNameMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SpokenName unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SpokenNameMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SpokenName edge %s", name)
+}
+
+// UserDictEntryMutation represents an operation that mutates the UserDictEntry nodes in the graph.
+type UserDictEntryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	user_id       *string
+	word          *string
+	reading       *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*UserDictEntry, error)
+	predicates    []predicate.UserDictEntry
+}
+
+var _ ent.Mutation = (*UserDictEntryMutation)(nil)
+
+// userdictentryOption allows management of the mutation configuration using functional options.
+type userdictentryOption func(*UserDictEntryMutation)
+
+// newUserDictEntryMutation creates new mutation for the UserDictEntry entity.
+func newUserDictEntryMutation(c config, op Op, opts ...userdictentryOption) *UserDictEntryMutation {
+	m := &UserDictEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserDictEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserDictEntryID sets the ID field of the mutation.
+func withUserDictEntryID(id int) userdictentryOption {
+	return func(m *UserDictEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserDictEntry
+		)
+		m.oldValue = func(ctx context.Context) (*UserDictEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserDictEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUserDictEntry sets the old UserDictEntry of the mutation.
+func withUserDictEntry(node *UserDictEntry) userdictentryOption {
+	return func(m *UserDictEntryMutation) {
+		m.oldValue = func(context.Context) (*UserDictEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserDictEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserDictEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserDictEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserDictEntryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserDictEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *UserDictEntryMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserDictEntryMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the UserDictEntry entity.
+// If the UserDictEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserDictEntryMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserDictEntryMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetWord sets the "word" field.
+func (m *UserDictEntryMutation) SetWord(s string) {
+	m.word = &s
+}
+
+// Word returns the value of the "word" field in the mutation.
+func (m *UserDictEntryMutation) Word() (r string, exists bool) {
+	v := m.word
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWord returns the old "word" field's value of the UserDictEntry entity.
+// If the UserDictEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserDictEntryMutation) OldWord(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWord is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWord requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWord: %w", err)
+	}
+	return oldValue.Word, nil
+}
+
+// ResetWord resets all changes to the "word" field.
+func (m *UserDictEntryMutation) ResetWord() {
+	m.word = nil
+}
+
+// SetReading sets the "reading" field.
+func (m *UserDictEntryMutation) SetReading(s string) {
+	m.reading = &s
+}
+
+// Reading returns the value of the "reading" field in the mutation.
+func (m *UserDictEntryMutation) Reading() (r string, exists bool) {
+	v := m.reading
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReading returns the old "reading" field's value of the UserDictEntry entity.
+// If the UserDictEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserDictEntryMutation) OldReading(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReading is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReading requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReading: %w", err)
+	}
+	return oldValue.Reading, nil
+}
+
+// ResetReading resets all changes to the "reading" field.
+func (m *UserDictEntryMutation) ResetReading() {
+	m.reading = nil
+}
+
+// Where appends a list predicates to the UserDictEntryMutation builder.
+func (m *UserDictEntryMutation) Where(ps ...predicate.UserDictEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserDictEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserDictEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserDictEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserDictEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserDictEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UserDictEntry).
+func (m *UserDictEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserDictEntryMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user_id != nil {
+		fields = append(fields, userdictentry.FieldUserID)
+	}
+	if m.word != nil {
+		fields = append(fields, userdictentry.FieldWord)
+	}
+	if m.reading != nil {
+		fields = append(fields, userdictentry.FieldReading)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserDictEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case userdictentry.FieldUserID:
+		return m.UserID()
+	case userdictentry.FieldWord:
+		return m.Word()
+	case userdictentry.FieldReading:
+		return m.Reading()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserDictEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case userdictentry.FieldUserID:
+		return m.OldUserID(ctx)
+	case userdictentry.FieldWord:
+		return m.OldWord(ctx)
+	case userdictentry.FieldReading:
+		return m.OldReading(ctx)
+	}
+	return nil, fmt.Errorf("unknown UserDictEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserDictEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case userdictentry.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case userdictentry.FieldWord:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWord(v)
+		return nil
+	case userdictentry.FieldReading:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReading(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserDictEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserDictEntryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserDictEntryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserDictEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown UserDictEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserDictEntryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserDictEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserDictEntryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserDictEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserDictEntryMutation) ResetField(name string) error {
+	switch name {
+	case userdictentry.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case userdictentry.FieldWord:
+		m.ResetWord()
+		return nil
+	case userdictentry.FieldReading:
+		m.ResetReading()
+		return nil
+	}
+	return fmt.Errorf("unknown UserDictEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserDictEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserDictEntryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserDictEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserDictEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserDictEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserDictEntryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserDictEntryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UserDictEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserDictEntryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UserDictEntry edge %s", name)
+}
+
 // VoiceSettingMutation represents an operation that mutates the VoiceSetting nodes in the graph.
 type VoiceSettingMutation struct {
 	config
@@ -0,0 +1,127 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/ngword"
+)
+
+// NGWord is the model entity for the NGWord schema.
+type NGWord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID string `json:"guild_id,omitempty"`
+	// Pattern holds the value of the "pattern" field.
+	Pattern string `json:"pattern,omitempty"`
+	// Regex holds the value of the "regex" field.
+	Regex        bool `json:"regex,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NGWord) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ngword.FieldRegex:
+			values[i] = new(sql.NullBool)
+		case ngword.FieldID:
+			values[i] = new(sql.NullInt64)
+		case ngword.FieldGuildID, ngword.FieldPattern:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NGWord fields.
+func (nw *NGWord) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ngword.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			nw.ID = int(value.Int64)
+		case ngword.FieldGuildID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				nw.GuildID = value.String
+			}
+		case ngword.FieldPattern:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pattern", values[i])
+			} else if value.Valid {
+				nw.Pattern = value.String
+			}
+		case ngword.FieldRegex:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field regex", values[i])
+			} else if value.Valid {
+				nw.Regex = value.Bool
+			}
+		default:
+			nw.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NGWord.
+// This includes values selected through modifiers, order, etc.
+func (nw *NGWord) Value(name string) (ent.Value, error) {
+	return nw.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this NGWord.
+// Note that you need to call NGWord.Unwrap() before calling this method if this NGWord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nw *NGWord) Update() *NGWordUpdateOne {
+	return NewNGWordClient(nw.config).UpdateOne(nw)
+}
+
+// Unwrap unwraps the NGWord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nw *NGWord) Unwrap() *NGWord {
+	_tx, ok := nw.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NGWord is not a transactional entity")
+	}
+	nw.config.driver = _tx.drv
+	return nw
+}
+
+// String implements the fmt.Stringer.
+func (nw *NGWord) String() string {
+	var builder strings.Builder
+	builder.WriteString("NGWord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nw.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(nw.GuildID)
+	builder.WriteString(", ")
+	builder.WriteString("pattern=")
+	builder.WriteString(nw.Pattern)
+	builder.WriteString(", ")
+	builder.WriteString("regex=")
+	builder.WriteString(fmt.Sprintf("%v", nw.Regex))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NGWords is a parsable slice of NGWord.
+type NGWords []*NGWord
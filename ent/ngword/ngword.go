@@ -0,0 +1,72 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ngword
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the ngword type in the database.
+	Label = "ng_word"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGuildID holds the string denoting the guild_id field in the database.
+	FieldGuildID = "guild_id"
+	// FieldPattern holds the string denoting the pattern field in the database.
+	FieldPattern = "pattern"
+	// FieldRegex holds the string denoting the regex field in the database.
+	FieldRegex = "regex"
+	// Table holds the table name of the ngword in the database.
+	Table = "ng_words"
+)
+
+// Columns holds all SQL columns for ngword fields.
+var Columns = []string{
+	FieldID,
+	FieldGuildID,
+	FieldPattern,
+	FieldRegex,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	GuildIDValidator func(string) error
+	// PatternValidator is a validator for the "pattern" field. It is called by the builders before save.
+	PatternValidator func(string) error
+	// DefaultRegex holds the default value on creation for the "regex" field.
+	DefaultRegex bool
+)
+
+// OrderOption defines the ordering options for the NGWord queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGuildID orders the results by the guild_id field.
+func ByGuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuildID, opts...).ToFunc()
+}
+
+// ByPattern orders the results by the pattern field.
+func ByPattern(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPattern, opts...).ToFunc()
+}
+
+// ByRegex orders the results by the regex field.
+func ByRegex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegex, opts...).ToFunc()
+}
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ngword
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.NGWord {
+	return predicate.NGWord(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.NGWord {
+	return predicate.NGWord(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.NGWord {
+	return predicate.NGWord(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.NGWord {
+	return predicate.NGWord(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.NGWord {
+	return predicate.NGWord(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.NGWord {
+	return predicate.NGWord(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.NGWord {
+	return predicate.NGWord(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.NGWord {
+	return predicate.NGWord(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.NGWord {
+	return predicate.NGWord(sql.FieldLTE(FieldID, id))
+}
+
+// GuildID applies equality check predicate on the "guild_id" field. It's identical to GuildIDEQ.
+func GuildID(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldEQ(FieldGuildID, v))
+}
+
+// Pattern applies equality check predicate on the "pattern" field. It's identical to PatternEQ.
+func Pattern(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldEQ(FieldPattern, v))
+}
+
+// Regex applies equality check predicate on the "regex" field. It's identical to RegexEQ.
+func Regex(v bool) predicate.NGWord {
+	return predicate.NGWord(sql.FieldEQ(FieldRegex, v))
+}
+
+// GuildIDEQ applies the EQ predicate on the "guild_id" field.
+func GuildIDEQ(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldEQ(FieldGuildID, v))
+}
+
+// GuildIDNEQ applies the NEQ predicate on the "guild_id" field.
+func GuildIDNEQ(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldNEQ(FieldGuildID, v))
+}
+
+// GuildIDIn applies the In predicate on the "guild_id" field.
+func GuildIDIn(vs ...string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldIn(FieldGuildID, vs...))
+}
+
+// GuildIDNotIn applies the NotIn predicate on the "guild_id" field.
+func GuildIDNotIn(vs ...string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldNotIn(FieldGuildID, vs...))
+}
+
+// GuildIDGT applies the GT predicate on the "guild_id" field.
+func GuildIDGT(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldGT(FieldGuildID, v))
+}
+
+// GuildIDGTE applies the GTE predicate on the "guild_id" field.
+func GuildIDGTE(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldGTE(FieldGuildID, v))
+}
+
+// GuildIDLT applies the LT predicate on the "guild_id" field.
+func GuildIDLT(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldLT(FieldGuildID, v))
+}
+
+// GuildIDLTE applies the LTE predicate on the "guild_id" field.
+func GuildIDLTE(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldLTE(FieldGuildID, v))
+}
+
+// GuildIDContains applies the Contains predicate on the "guild_id" field.
+func GuildIDContains(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldContains(FieldGuildID, v))
+}
+
+// GuildIDHasPrefix applies the HasPrefix predicate on the "guild_id" field.
+func GuildIDHasPrefix(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldHasPrefix(FieldGuildID, v))
+}
+
+// GuildIDHasSuffix applies the HasSuffix predicate on the "guild_id" field.
+func GuildIDHasSuffix(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldHasSuffix(FieldGuildID, v))
+}
+
+// GuildIDEqualFold applies the EqualFold predicate on the "guild_id" field.
+func GuildIDEqualFold(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldEqualFold(FieldGuildID, v))
+}
+
+// GuildIDContainsFold applies the ContainsFold predicate on the "guild_id" field.
+func GuildIDContainsFold(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldContainsFold(FieldGuildID, v))
+}
+
+// PatternEQ applies the EQ predicate on the "pattern" field.
+func PatternEQ(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldEQ(FieldPattern, v))
+}
+
+// PatternNEQ applies the NEQ predicate on the "pattern" field.
+func PatternNEQ(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldNEQ(FieldPattern, v))
+}
+
+// PatternIn applies the In predicate on the "pattern" field.
+func PatternIn(vs ...string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldIn(FieldPattern, vs...))
+}
+
+// PatternNotIn applies the NotIn predicate on the "pattern" field.
+func PatternNotIn(vs ...string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldNotIn(FieldPattern, vs...))
+}
+
+// PatternGT applies the GT predicate on the "pattern" field.
+func PatternGT(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldGT(FieldPattern, v))
+}
+
+// PatternGTE applies the GTE predicate on the "pattern" field.
+func PatternGTE(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldGTE(FieldPattern, v))
+}
+
+// PatternLT applies the LT predicate on the "pattern" field.
+func PatternLT(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldLT(FieldPattern, v))
+}
+
+// PatternLTE applies the LTE predicate on the "pattern" field.
+func PatternLTE(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldLTE(FieldPattern, v))
+}
+
+// PatternContains applies the Contains predicate on the "pattern" field.
+func PatternContains(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldContains(FieldPattern, v))
+}
+
+// PatternHasPrefix applies the HasPrefix predicate on the "pattern" field.
+func PatternHasPrefix(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldHasPrefix(FieldPattern, v))
+}
+
+// PatternHasSuffix applies the HasSuffix predicate on the "pattern" field.
+func PatternHasSuffix(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldHasSuffix(FieldPattern, v))
+}
+
+// PatternEqualFold applies the EqualFold predicate on the "pattern" field.
+func PatternEqualFold(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldEqualFold(FieldPattern, v))
+}
+
+// PatternContainsFold applies the ContainsFold predicate on the "pattern" field.
+func PatternContainsFold(v string) predicate.NGWord {
+	return predicate.NGWord(sql.FieldContainsFold(FieldPattern, v))
+}
+
+// RegexEQ applies the EQ predicate on the "regex" field.
+func RegexEQ(v bool) predicate.NGWord {
+	return predicate.NGWord(sql.FieldEQ(FieldRegex, v))
+}
+
+// RegexNEQ applies the NEQ predicate on the "regex" field.
+func RegexNEQ(v bool) predicate.NGWord {
+	return predicate.NGWord(sql.FieldNEQ(FieldRegex, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.NGWord) predicate.NGWord {
+	return predicate.NGWord(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.NGWord) predicate.NGWord {
+	return predicate.NGWord(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.NGWord) predicate.NGWord {
+	return predicate.NGWord(sql.NotPredicates(p))
+}
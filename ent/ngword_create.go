@@ -0,0 +1,237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/ngword"
+)
+
+// NGWordCreate is the builder for creating a NGWord entity.
+type NGWordCreate struct {
+	config
+	mutation *NGWordMutation
+	hooks    []Hook
+}
+
+// SetGuildID sets the "guild_id" field.
+func (nwc *NGWordCreate) SetGuildID(s string) *NGWordCreate {
+	nwc.mutation.SetGuildID(s)
+	return nwc
+}
+
+// SetPattern sets the "pattern" field.
+func (nwc *NGWordCreate) SetPattern(s string) *NGWordCreate {
+	nwc.mutation.SetPattern(s)
+	return nwc
+}
+
+// SetRegex sets the "regex" field.
+func (nwc *NGWordCreate) SetRegex(b bool) *NGWordCreate {
+	nwc.mutation.SetRegex(b)
+	return nwc
+}
+
+// SetNillableRegex sets the "regex" field if the given value is not nil.
+func (nwc *NGWordCreate) SetNillableRegex(b *bool) *NGWordCreate {
+	if b != nil {
+		nwc.SetRegex(*b)
+	}
+	return nwc
+}
+
+// Mutation returns the NGWordMutation object of the builder.
+func (nwc *NGWordCreate) Mutation() *NGWordMutation {
+	return nwc.mutation
+}
+
+// Save creates the NGWord in the database.
+func (nwc *NGWordCreate) Save(ctx context.Context) (*NGWord, error) {
+	nwc.defaults()
+	return withHooks(ctx, nwc.sqlSave, nwc.mutation, nwc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nwc *NGWordCreate) SaveX(ctx context.Context) *NGWord {
+	v, err := nwc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nwc *NGWordCreate) Exec(ctx context.Context) error {
+	_, err := nwc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nwc *NGWordCreate) ExecX(ctx context.Context) {
+	if err := nwc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nwc *NGWordCreate) defaults() {
+	if _, ok := nwc.mutation.Regex(); !ok {
+		v := ngword.DefaultRegex
+		nwc.mutation.SetRegex(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nwc *NGWordCreate) check() error {
+	if _, ok := nwc.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "NGWord.guild_id"`)}
+	}
+	if v, ok := nwc.mutation.GuildID(); ok {
+		if err := ngword.GuildIDValidator(v); err != nil {
+			return &ValidationError{Name: "guild_id", err: fmt.Errorf(`ent: validator failed for field "NGWord.guild_id": %w`, err)}
+		}
+	}
+	if _, ok := nwc.mutation.Pattern(); !ok {
+		return &ValidationError{Name: "pattern", err: errors.New(`ent: missing required field "NGWord.pattern"`)}
+	}
+	if v, ok := nwc.mutation.Pattern(); ok {
+		if err := ngword.PatternValidator(v); err != nil {
+			return &ValidationError{Name: "pattern", err: fmt.Errorf(`ent: validator failed for field "NGWord.pattern": %w`, err)}
+		}
+	}
+	if _, ok := nwc.mutation.Regex(); !ok {
+		return &ValidationError{Name: "regex", err: errors.New(`ent: missing required field "NGWord.regex"`)}
+	}
+	return nil
+}
+
+func (nwc *NGWordCreate) sqlSave(ctx context.Context) (*NGWord, error) {
+	if err := nwc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nwc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nwc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	nwc.mutation.id = &_node.ID
+	nwc.mutation.done = true
+	return _node, nil
+}
+
+func (nwc *NGWordCreate) createSpec() (*NGWord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NGWord{config: nwc.config}
+		_spec = sqlgraph.NewCreateSpec(ngword.Table, sqlgraph.NewFieldSpec(ngword.FieldID, field.TypeInt))
+	)
+	if value, ok := nwc.mutation.GuildID(); ok {
+		_spec.SetField(ngword.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := nwc.mutation.Pattern(); ok {
+		_spec.SetField(ngword.FieldPattern, field.TypeString, value)
+		_node.Pattern = value
+	}
+	if value, ok := nwc.mutation.Regex(); ok {
+		_spec.SetField(ngword.FieldRegex, field.TypeBool, value)
+		_node.Regex = value
+	}
+	return _node, _spec
+}
+
+// NGWordCreateBulk is the builder for creating many NGWord entities in bulk.
+type NGWordCreateBulk struct {
+	config
+	err      error
+	builders []*NGWordCreate
+}
+
+// Save creates the NGWord entities in the database.
+func (nwcb *NGWordCreateBulk) Save(ctx context.Context) ([]*NGWord, error) {
+	if nwcb.err != nil {
+		return nil, nwcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(nwcb.builders))
+	nodes := make([]*NGWord, len(nwcb.builders))
+	mutators := make([]Mutator, len(nwcb.builders))
+	for i := range nwcb.builders {
+		func(i int, root context.Context) {
+			builder := nwcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NGWordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, nwcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, nwcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, nwcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nwcb *NGWordCreateBulk) SaveX(ctx context.Context) []*NGWord {
+	v, err := nwcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nwcb *NGWordCreateBulk) Exec(ctx context.Context) error {
+	_, err := nwcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nwcb *NGWordCreateBulk) ExecX(ctx context.Context) {
+	if err := nwcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
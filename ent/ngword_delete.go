@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/ngword"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// NGWordDelete is the builder for deleting a NGWord entity.
+type NGWordDelete struct {
+	config
+	hooks    []Hook
+	mutation *NGWordMutation
+}
+
+// Where appends a list predicates to the NGWordDelete builder.
+func (nwd *NGWordDelete) Where(ps ...predicate.NGWord) *NGWordDelete {
+	nwd.mutation.Where(ps...)
+	return nwd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (nwd *NGWordDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, nwd.sqlExec, nwd.mutation, nwd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nwd *NGWordDelete) ExecX(ctx context.Context) int {
+	n, err := nwd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (nwd *NGWordDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ngword.Table, sqlgraph.NewFieldSpec(ngword.FieldID, field.TypeInt))
+	if ps := nwd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, nwd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	nwd.mutation.done = true
+	return affected, err
+}
+
+// NGWordDeleteOne is the builder for deleting a single NGWord entity.
+type NGWordDeleteOne struct {
+	nwd *NGWordDelete
+}
+
+// Where appends a list predicates to the NGWordDelete builder.
+func (nwdo *NGWordDeleteOne) Where(ps ...predicate.NGWord) *NGWordDeleteOne {
+	nwdo.nwd.mutation.Where(ps...)
+	return nwdo
+}
+
+// Exec executes the deletion query.
+func (nwdo *NGWordDeleteOne) Exec(ctx context.Context) error {
+	n, err := nwdo.nwd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ngword.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nwdo *NGWordDeleteOne) ExecX(ctx context.Context) {
+	if err := nwdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/ngword"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// NGWordQuery is the builder for querying NGWord entities.
+type NGWordQuery struct {
+	config
+	ctx        *QueryContext
+	order      []ngword.OrderOption
+	inters     []Interceptor
+	predicates []predicate.NGWord
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the NGWordQuery builder.
+func (nwq *NGWordQuery) Where(ps ...predicate.NGWord) *NGWordQuery {
+	nwq.predicates = append(nwq.predicates, ps...)
+	return nwq
+}
+
+// Limit the number of records to be returned by this query.
+func (nwq *NGWordQuery) Limit(limit int) *NGWordQuery {
+	nwq.ctx.Limit = &limit
+	return nwq
+}
+
+// Offset to start from.
+func (nwq *NGWordQuery) Offset(offset int) *NGWordQuery {
+	nwq.ctx.Offset = &offset
+	return nwq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (nwq *NGWordQuery) Unique(unique bool) *NGWordQuery {
+	nwq.ctx.Unique = &unique
+	return nwq
+}
+
+// Order specifies how the records should be ordered.
+func (nwq *NGWordQuery) Order(o ...ngword.OrderOption) *NGWordQuery {
+	nwq.order = append(nwq.order, o...)
+	return nwq
+}
+
+// First returns the first NGWord entity from the query.
+// Returns a *NotFoundError when no NGWord was found.
+func (nwq *NGWordQuery) First(ctx context.Context) (*NGWord, error) {
+	nodes, err := nwq.Limit(1).All(setContextOp(ctx, nwq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ngword.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (nwq *NGWordQuery) FirstX(ctx context.Context) *NGWord {
+	node, err := nwq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first NGWord ID from the query.
+// Returns a *NotFoundError when no NGWord ID was found.
+func (nwq *NGWordQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = nwq.Limit(1).IDs(setContextOp(ctx, nwq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ngword.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (nwq *NGWordQuery) FirstIDX(ctx context.Context) int {
+	id, err := nwq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single NGWord entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one NGWord entity is found.
+// Returns a *NotFoundError when no NGWord entities are found.
+func (nwq *NGWordQuery) Only(ctx context.Context) (*NGWord, error) {
+	nodes, err := nwq.Limit(2).All(setContextOp(ctx, nwq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ngword.Label}
+	default:
+		return nil, &NotSingularError{ngword.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (nwq *NGWordQuery) OnlyX(ctx context.Context) *NGWord {
+	node, err := nwq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only NGWord ID in the query.
+// Returns a *NotSingularError when more than one NGWord ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (nwq *NGWordQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = nwq.Limit(2).IDs(setContextOp(ctx, nwq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ngword.Label}
+	default:
+		err = &NotSingularError{ngword.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (nwq *NGWordQuery) OnlyIDX(ctx context.Context) int {
+	id, err := nwq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of NGWords.
+func (nwq *NGWordQuery) All(ctx context.Context) ([]*NGWord, error) {
+	ctx = setContextOp(ctx, nwq.ctx, "All")
+	if err := nwq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*NGWord, *NGWordQuery]()
+	return withInterceptors[[]*NGWord](ctx, nwq, qr, nwq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (nwq *NGWordQuery) AllX(ctx context.Context) []*NGWord {
+	nodes, err := nwq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of NGWord IDs.
+func (nwq *NGWordQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if nwq.ctx.Unique == nil && nwq.path != nil {
+		nwq.Unique(true)
+	}
+	ctx = setContextOp(ctx, nwq.ctx, "IDs")
+	if err = nwq.Select(ngword.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (nwq *NGWordQuery) IDsX(ctx context.Context) []int {
+	ids, err := nwq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (nwq *NGWordQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, nwq.ctx, "Count")
+	if err := nwq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, nwq, querierCount[*NGWordQuery](), nwq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (nwq *NGWordQuery) CountX(ctx context.Context) int {
+	count, err := nwq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (nwq *NGWordQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, nwq.ctx, "Exist")
+	switch _, err := nwq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (nwq *NGWordQuery) ExistX(ctx context.Context) bool {
+	exist, err := nwq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the NGWordQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (nwq *NGWordQuery) Clone() *NGWordQuery {
+	if nwq == nil {
+		return nil
+	}
+	return &NGWordQuery{
+		config:     nwq.config,
+		ctx:        nwq.ctx.Clone(),
+		order:      append([]ngword.OrderOption{}, nwq.order...),
+		inters:     append([]Interceptor{}, nwq.inters...),
+		predicates: append([]predicate.NGWord{}, nwq.predicates...),
+		// clone intermediate query.
+		sql:  nwq.sql.Clone(),
+		path: nwq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID string `json:"guild_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.NGWord.Query().
+//		GroupBy(ngword.FieldGuildID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (nwq *NGWordQuery) GroupBy(field string, fields ...string) *NGWordGroupBy {
+	nwq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &NGWordGroupBy{build: nwq}
+	grbuild.flds = &nwq.ctx.Fields
+	grbuild.label = ngword.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID string `json:"guild_id,omitempty"`
+//	}
+//
+//	client.NGWord.Query().
+//		Select(ngword.FieldGuildID).
+//		Scan(ctx, &v)
+func (nwq *NGWordQuery) Select(fields ...string) *NGWordSelect {
+	nwq.ctx.Fields = append(nwq.ctx.Fields, fields...)
+	sbuild := &NGWordSelect{NGWordQuery: nwq}
+	sbuild.label = ngword.Label
+	sbuild.flds, sbuild.scan = &nwq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a NGWordSelect configured with the given aggregations.
+func (nwq *NGWordQuery) Aggregate(fns ...AggregateFunc) *NGWordSelect {
+	return nwq.Select().Aggregate(fns...)
+}
+
+func (nwq *NGWordQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range nwq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, nwq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range nwq.ctx.Fields {
+		if !ngword.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if nwq.path != nil {
+		prev, err := nwq.path(ctx)
+		if err != nil {
+			return err
+		}
+		nwq.sql = prev
+	}
+	return nil
+}
+
+func (nwq *NGWordQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*NGWord, error) {
+	var (
+		nodes = []*NGWord{}
+		_spec = nwq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*NGWord).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &NGWord{config: nwq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, nwq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (nwq *NGWordQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := nwq.querySpec()
+	_spec.Node.Columns = nwq.ctx.Fields
+	if len(nwq.ctx.Fields) > 0 {
+		_spec.Unique = nwq.ctx.Unique != nil && *nwq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, nwq.driver, _spec)
+}
+
+func (nwq *NGWordQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(ngword.Table, ngword.Columns, sqlgraph.NewFieldSpec(ngword.FieldID, field.TypeInt))
+	_spec.From = nwq.sql
+	if unique := nwq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if nwq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := nwq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ngword.FieldID)
+		for i := range fields {
+			if fields[i] != ngword.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := nwq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := nwq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := nwq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := nwq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (nwq *NGWordQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(nwq.driver.Dialect())
+	t1 := builder.Table(ngword.Table)
+	columns := nwq.ctx.Fields
+	if len(columns) == 0 {
+		columns = ngword.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if nwq.sql != nil {
+		selector = nwq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if nwq.ctx.Unique != nil && *nwq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range nwq.predicates {
+		p(selector)
+	}
+	for _, p := range nwq.order {
+		p(selector)
+	}
+	if offset := nwq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := nwq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// NGWordGroupBy is the group-by builder for NGWord entities.
+type NGWordGroupBy struct {
+	selector
+	build *NGWordQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (nwgb *NGWordGroupBy) Aggregate(fns ...AggregateFunc) *NGWordGroupBy {
+	nwgb.fns = append(nwgb.fns, fns...)
+	return nwgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nwgb *NGWordGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nwgb.build.ctx, "GroupBy")
+	if err := nwgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NGWordQuery, *NGWordGroupBy](ctx, nwgb.build, nwgb, nwgb.build.inters, v)
+}
+
+func (nwgb *NGWordGroupBy) sqlScan(ctx context.Context, root *NGWordQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(nwgb.fns))
+	for _, fn := range nwgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*nwgb.flds)+len(nwgb.fns))
+		for _, f := range *nwgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*nwgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nwgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// NGWordSelect is the builder for selecting fields of NGWord entities.
+type NGWordSelect struct {
+	*NGWordQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (nws *NGWordSelect) Aggregate(fns ...AggregateFunc) *NGWordSelect {
+	nws.fns = append(nws.fns, fns...)
+	return nws
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (nws *NGWordSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, nws.ctx, "Select")
+	if err := nws.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*NGWordQuery, *NGWordSelect](ctx, nws.NGWordQuery, nws, nws.inters, v)
+}
+
+func (nws *NGWordSelect) sqlScan(ctx context.Context, root *NGWordQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(nws.fns))
+	for _, fn := range nws.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*nws.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := nws.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
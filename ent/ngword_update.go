@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/ngword"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// NGWordUpdate is the builder for updating NGWord entities.
+type NGWordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NGWordMutation
+}
+
+// Where appends a list predicates to the NGWordUpdate builder.
+func (nwu *NGWordUpdate) Where(ps ...predicate.NGWord) *NGWordUpdate {
+	nwu.mutation.Where(ps...)
+	return nwu
+}
+
+// Mutation returns the NGWordMutation object of the builder.
+func (nwu *NGWordUpdate) Mutation() *NGWordMutation {
+	return nwu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nwu *NGWordUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, nwu.sqlSave, nwu.mutation, nwu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nwu *NGWordUpdate) SaveX(ctx context.Context) int {
+	affected, err := nwu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nwu *NGWordUpdate) Exec(ctx context.Context) error {
+	_, err := nwu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nwu *NGWordUpdate) ExecX(ctx context.Context) {
+	if err := nwu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (nwu *NGWordUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ngword.Table, ngword.Columns, sqlgraph.NewFieldSpec(ngword.FieldID, field.TypeInt))
+	if ps := nwu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nwu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ngword.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nwu.mutation.done = true
+	return n, nil
+}
+
+// NGWordUpdateOne is the builder for updating a single NGWord entity.
+type NGWordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NGWordMutation
+}
+
+// Mutation returns the NGWordMutation object of the builder.
+func (nwuo *NGWordUpdateOne) Mutation() *NGWordMutation {
+	return nwuo.mutation
+}
+
+// Where appends a list predicates to the NGWordUpdate builder.
+func (nwuo *NGWordUpdateOne) Where(ps ...predicate.NGWord) *NGWordUpdateOne {
+	nwuo.mutation.Where(ps...)
+	return nwuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nwuo *NGWordUpdateOne) Select(field string, fields ...string) *NGWordUpdateOne {
+	nwuo.fields = append([]string{field}, fields...)
+	return nwuo
+}
+
+// Save executes the query and returns the updated NGWord entity.
+func (nwuo *NGWordUpdateOne) Save(ctx context.Context) (*NGWord, error) {
+	return withHooks(ctx, nwuo.sqlSave, nwuo.mutation, nwuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nwuo *NGWordUpdateOne) SaveX(ctx context.Context) *NGWord {
+	node, err := nwuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nwuo *NGWordUpdateOne) Exec(ctx context.Context) error {
+	_, err := nwuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nwuo *NGWordUpdateOne) ExecX(ctx context.Context) {
+	if err := nwuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (nwuo *NGWordUpdateOne) sqlSave(ctx context.Context) (_node *NGWord, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ngword.Table, ngword.Columns, sqlgraph.NewFieldSpec(ngword.FieldID, field.TypeInt))
+	id, ok := nwuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NGWord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nwuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ngword.FieldID)
+		for _, f := range fields {
+			if !ngword.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ngword.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nwuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &NGWord{config: nwuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nwuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ngword.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nwuo.mutation.done = true
+	return _node, nil
+}
@@ -6,5 +6,26 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccessRule is the predicate function for accessrule builders.
+type AccessRule func(*sql.Selector)
+
+// GuildDictEntry is the predicate function for guilddictentry builders.
+type GuildDictEntry func(*sql.Selector)
+
+// GuildSetting is the predicate function for guildsetting builders.
+type GuildSetting func(*sql.Selector)
+
+// IgnoreEntry is the predicate function for ignoreentry builders.
+type IgnoreEntry func(*sql.Selector)
+
+// NGWord is the predicate function for ngword builders.
+type NGWord func(*sql.Selector)
+
+// SpokenName is the predicate function for spokenname builders.
+type SpokenName func(*sql.Selector)
+
+// UserDictEntry is the predicate function for userdictentry builders.
+type UserDictEntry func(*sql.Selector)
+
 // VoiceSetting is the predicate function for voicesetting builders.
 type VoiceSetting func(*sql.Selector)
@@ -3,7 +3,14 @@
 package ent
 
 import (
+	"github.com/kechako/yomiko/ent/accessrule"
+	"github.com/kechako/yomiko/ent/guilddictentry"
+	"github.com/kechako/yomiko/ent/guildsetting"
+	"github.com/kechako/yomiko/ent/ignoreentry"
+	"github.com/kechako/yomiko/ent/ngword"
 	"github.com/kechako/yomiko/ent/schema"
+	"github.com/kechako/yomiko/ent/spokenname"
+	"github.com/kechako/yomiko/ent/userdictentry"
 	"github.com/kechako/yomiko/ent/voicesetting"
 )
 
@@ -11,6 +18,88 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accessruleFields := schema.AccessRule{}.Fields()
+	_ = accessruleFields
+	// accessruleDescGuildID is the schema descriptor for guild_id field.
+	accessruleDescGuildID := accessruleFields[0].Descriptor()
+	// accessrule.GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	accessrule.GuildIDValidator = accessruleDescGuildID.Validators[0].(func(string) error)
+	// accessruleDescRoleID is the schema descriptor for role_id field.
+	accessruleDescRoleID := accessruleFields[2].Descriptor()
+	// accessrule.RoleIDValidator is a validator for the "role_id" field. It is called by the builders before save.
+	accessrule.RoleIDValidator = accessruleDescRoleID.Validators[0].(func(string) error)
+	guilddictentryFields := schema.GuildDictEntry{}.Fields()
+	_ = guilddictentryFields
+	// guilddictentryDescGuildID is the schema descriptor for guild_id field.
+	guilddictentryDescGuildID := guilddictentryFields[0].Descriptor()
+	// guilddictentry.GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	guilddictentry.GuildIDValidator = guilddictentryDescGuildID.Validators[0].(func(string) error)
+	// guilddictentryDescWord is the schema descriptor for word field.
+	guilddictentryDescWord := guilddictentryFields[1].Descriptor()
+	// guilddictentry.WordValidator is a validator for the "word" field. It is called by the builders before save.
+	guilddictentry.WordValidator = guilddictentryDescWord.Validators[0].(func(string) error)
+	// guilddictentryDescReading is the schema descriptor for reading field.
+	guilddictentryDescReading := guilddictentryFields[2].Descriptor()
+	// guilddictentry.ReadingValidator is a validator for the "reading" field. It is called by the builders before save.
+	guilddictentry.ReadingValidator = guilddictentryDescReading.Validators[0].(func(string) error)
+	guildsettingFields := schema.GuildSetting{}.Fields()
+	_ = guildsettingFields
+	// guildsettingDescGuildID is the schema descriptor for guild_id field.
+	guildsettingDescGuildID := guildsettingFields[0].Descriptor()
+	// guildsetting.GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	guildsetting.GuildIDValidator = guildsettingDescGuildID.Validators[0].(func(string) error)
+	ignoreentryFields := schema.IgnoreEntry{}.Fields()
+	_ = ignoreentryFields
+	// ignoreentryDescGuildID is the schema descriptor for guild_id field.
+	ignoreentryDescGuildID := ignoreentryFields[0].Descriptor()
+	// ignoreentry.GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	ignoreentry.GuildIDValidator = ignoreentryDescGuildID.Validators[0].(func(string) error)
+	// ignoreentryDescTargetID is the schema descriptor for target_id field.
+	ignoreentryDescTargetID := ignoreentryFields[2].Descriptor()
+	// ignoreentry.TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	ignoreentry.TargetIDValidator = ignoreentryDescTargetID.Validators[0].(func(string) error)
+	ngwordFields := schema.NGWord{}.Fields()
+	_ = ngwordFields
+	// ngwordDescGuildID is the schema descriptor for guild_id field.
+	ngwordDescGuildID := ngwordFields[0].Descriptor()
+	// ngword.GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	ngword.GuildIDValidator = ngwordDescGuildID.Validators[0].(func(string) error)
+	// ngwordDescPattern is the schema descriptor for pattern field.
+	ngwordDescPattern := ngwordFields[1].Descriptor()
+	// ngword.PatternValidator is a validator for the "pattern" field. It is called by the builders before save.
+	ngword.PatternValidator = ngwordDescPattern.Validators[0].(func(string) error)
+	// ngwordDescRegex is the schema descriptor for regex field.
+	ngwordDescRegex := ngwordFields[2].Descriptor()
+	// ngword.DefaultRegex holds the default value on creation for the regex field.
+	ngword.DefaultRegex = ngwordDescRegex.Default.(bool)
+	spokennameFields := schema.SpokenName{}.Fields()
+	_ = spokennameFields
+	// spokennameDescGuildID is the schema descriptor for guild_id field.
+	spokennameDescGuildID := spokennameFields[0].Descriptor()
+	// spokenname.GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	spokenname.GuildIDValidator = spokennameDescGuildID.Validators[0].(func(string) error)
+	// spokennameDescUserID is the schema descriptor for user_id field.
+	spokennameDescUserID := spokennameFields[1].Descriptor()
+	// spokenname.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	spokenname.UserIDValidator = spokennameDescUserID.Validators[0].(func(string) error)
+	// spokennameDescName is the schema descriptor for name field.
+	spokennameDescName := spokennameFields[2].Descriptor()
+	// spokenname.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	spokenname.NameValidator = spokennameDescName.Validators[0].(func(string) error)
+	userdictentryFields := schema.UserDictEntry{}.Fields()
+	_ = userdictentryFields
+	// userdictentryDescUserID is the schema descriptor for user_id field.
+	userdictentryDescUserID := userdictentryFields[0].Descriptor()
+	// userdictentry.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	userdictentry.UserIDValidator = userdictentryDescUserID.Validators[0].(func(string) error)
+	// userdictentryDescWord is the schema descriptor for word field.
+	userdictentryDescWord := userdictentryFields[1].Descriptor()
+	// userdictentry.WordValidator is a validator for the "word" field. It is called by the builders before save.
+	userdictentry.WordValidator = userdictentryDescWord.Validators[0].(func(string) error)
+	// userdictentryDescReading is the schema descriptor for reading field.
+	userdictentryDescReading := userdictentryFields[2].Descriptor()
+	// userdictentry.ReadingValidator is a validator for the "reading" field. It is called by the builders before save.
+	userdictentry.ReadingValidator = userdictentryDescReading.Validators[0].(func(string) error)
 	voicesettingFields := schema.VoiceSetting{}.Fields()
 	_ = voicesettingFields
 	// voicesettingDescUserID is the schema descriptor for user_id field.
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AccessRule holds the schema definition for the AccessRule entity. A rule
+// allows the members with the role to run the commands of the action in
+// the guild.
+type AccessRule struct {
+	ent.Schema
+}
+
+// Fields of the AccessRule.
+func (AccessRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("guild_id").
+			NotEmpty().
+			Immutable(),
+		field.Enum("action").
+			Values("join", "leave", "skip", "dictionary", "settings").
+			Immutable(),
+		field.String("role_id").
+			NotEmpty().
+			Immutable(),
+	}
+}
+
+// Edges of the AccessRule.
+func (AccessRule) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AccessRule.
+func (AccessRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("guild_id", "action", "role_id").
+			Unique(),
+	}
+}
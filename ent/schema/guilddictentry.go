@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GuildDictEntry holds the schema definition for the GuildDictEntry entity.
+// The entries of a guild replace the words in the messages in the guild.
+type GuildDictEntry struct {
+	ent.Schema
+}
+
+// Fields of the GuildDictEntry.
+func (GuildDictEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("guild_id").
+			NotEmpty().
+			Immutable(),
+		field.String("word").
+			NotEmpty().
+			Immutable(),
+		field.String("reading").
+			NotEmpty(),
+	}
+}
+
+// Edges of the GuildDictEntry.
+func (GuildDictEntry) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the GuildDictEntry.
+func (GuildDictEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("guild_id", "word").
+			Unique(),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// GuildSetting holds the schema definition for the GuildSetting entity.
+type GuildSetting struct {
+	ent.Schema
+}
+
+// Fields of the GuildSetting.
+func (GuildSetting) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("guild_id").
+			Unique().
+			NotEmpty().
+			Immutable(),
+		field.Enum("opus_application").
+			Values("voip", "audio").
+			Nillable().
+			Optional(),
+		field.Int("opus_bitrate").
+			Nillable().
+			Optional(),
+		field.Int("opus_complexity").
+			Nillable().
+			Optional(),
+		field.Bool("opus_fec").
+			Nillable().
+			Optional(),
+		field.Bool("opus_dtx").
+			Nillable().
+			Optional(),
+		field.Bool("read_bots").
+			Nillable().
+			Optional(),
+		field.Bool("read_webhooks").
+			Nillable().
+			Optional(),
+		field.Enum("ng_word_mode").
+			Values("bleep", "replace", "drop").
+			Nillable().
+			Optional(),
+		field.String("ng_word_replacement").
+			Nillable().
+			Optional(),
+	}
+}
+
+// Edges of the GuildSetting.
+func (GuildSetting) Edges() []ent.Edge {
+	return nil
+}
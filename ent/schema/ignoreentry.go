@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IgnoreEntry holds the schema definition for the IgnoreEntry entity. The
+// messages of an ignored user, or of the members with an ignored role, are
+// not read in the guild.
+type IgnoreEntry struct {
+	ent.Schema
+}
+
+// Fields of the IgnoreEntry.
+func (IgnoreEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("guild_id").
+			NotEmpty().
+			Immutable(),
+		field.Enum("kind").
+			Values("user", "role").
+			Immutable(),
+		field.String("target_id").
+			NotEmpty().
+			Immutable(),
+	}
+}
+
+// Edges of the IgnoreEntry.
+func (IgnoreEntry) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the IgnoreEntry.
+func (IgnoreEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("guild_id", "kind", "target_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// NGWord holds the schema definition for the NGWord entity. NG words are
+// bleeped, replaced or make the whole message dropped, by the mode of the
+// guild.
+type NGWord struct {
+	ent.Schema
+}
+
+// Fields of the NGWord.
+func (NGWord) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("guild_id").
+			NotEmpty().
+			Immutable(),
+		field.String("pattern").
+			NotEmpty().
+			Immutable(),
+		// pattern is a regular expression instead of a literal
+		field.Bool("regex").
+			Default(false).
+			Immutable(),
+	}
+}
+
+// Edges of the NGWord.
+func (NGWord) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the NGWord.
+func (NGWord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("guild_id", "pattern").
+			Unique(),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SpokenName holds the schema definition for the SpokenName entity. The
+// name is read instead of the display name of the user in the guild.
+type SpokenName struct {
+	ent.Schema
+}
+
+// Fields of the SpokenName.
+func (SpokenName) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("guild_id").
+			NotEmpty().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.String("name").
+			NotEmpty(),
+	}
+}
+
+// Edges of the SpokenName.
+func (SpokenName) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SpokenName.
+func (SpokenName) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("guild_id", "user_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserDictEntry holds the schema definition for the UserDictEntry entity.
+// The entries of a user replace the words in the messages of the user in
+// any guild.
+type UserDictEntry struct {
+	ent.Schema
+}
+
+// Fields of the UserDictEntry.
+func (UserDictEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.String("word").
+			NotEmpty().
+			Immutable(),
+		field.String("reading").
+			NotEmpty(),
+	}
+}
+
+// Edges of the UserDictEntry.
+func (UserDictEntry) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UserDictEntry.
+func (UserDictEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "word").
+			Unique(),
+	}
+}
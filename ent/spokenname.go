@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/spokenname"
+)
+
+// SpokenName is the model entity for the SpokenName schema.
+type SpokenName struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID string `json:"guild_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name         string `json:"name,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SpokenName) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case spokenname.FieldID:
+			values[i] = new(sql.NullInt64)
+		case spokenname.FieldGuildID, spokenname.FieldUserID, spokenname.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SpokenName fields.
+func (sn *SpokenName) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case spokenname.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sn.ID = int(value.Int64)
+		case spokenname.FieldGuildID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				sn.GuildID = value.String
+			}
+		case spokenname.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				sn.UserID = value.String
+			}
+		case spokenname.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sn.Name = value.String
+			}
+		default:
+			sn.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SpokenName.
+// This includes values selected through modifiers, order, etc.
+func (sn *SpokenName) Value(name string) (ent.Value, error) {
+	return sn.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SpokenName.
+// Note that you need to call SpokenName.Unwrap() before calling this method if this SpokenName
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sn *SpokenName) Update() *SpokenNameUpdateOne {
+	return NewSpokenNameClient(sn.config).UpdateOne(sn)
+}
+
+// Unwrap unwraps the SpokenName entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sn *SpokenName) Unwrap() *SpokenName {
+	_tx, ok := sn.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SpokenName is not a transactional entity")
+	}
+	sn.config.driver = _tx.drv
+	return sn
+}
+
+// String implements the fmt.Stringer.
+func (sn *SpokenName) String() string {
+	var builder strings.Builder
+	builder.WriteString("SpokenName(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sn.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(sn.GuildID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(sn.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sn.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SpokenNames is a parsable slice of SpokenName.
+type SpokenNames []*SpokenName
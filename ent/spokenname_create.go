@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/spokenname"
+)
+
+// SpokenNameCreate is the builder for creating a SpokenName entity.
+type SpokenNameCreate struct {
+	config
+	mutation *SpokenNameMutation
+	hooks    []Hook
+}
+
+// SetGuildID sets the "guild_id" field.
+func (snc *SpokenNameCreate) SetGuildID(s string) *SpokenNameCreate {
+	snc.mutation.SetGuildID(s)
+	return snc
+}
+
+// SetUserID sets the "user_id" field.
+func (snc *SpokenNameCreate) SetUserID(s string) *SpokenNameCreate {
+	snc.mutation.SetUserID(s)
+	return snc
+}
+
+// SetName sets the "name" field.
+func (snc *SpokenNameCreate) SetName(s string) *SpokenNameCreate {
+	snc.mutation.SetName(s)
+	return snc
+}
+
+// Mutation returns the SpokenNameMutation object of the builder.
+func (snc *SpokenNameCreate) Mutation() *SpokenNameMutation {
+	return snc.mutation
+}
+
+// Save creates the SpokenName in the database.
+func (snc *SpokenNameCreate) Save(ctx context.Context) (*SpokenName, error) {
+	return withHooks(ctx, snc.sqlSave, snc.mutation, snc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (snc *SpokenNameCreate) SaveX(ctx context.Context) *SpokenName {
+	v, err := snc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (snc *SpokenNameCreate) Exec(ctx context.Context) error {
+	_, err := snc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (snc *SpokenNameCreate) ExecX(ctx context.Context) {
+	if err := snc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (snc *SpokenNameCreate) check() error {
+	if _, ok := snc.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "SpokenName.guild_id"`)}
+	}
+	if v, ok := snc.mutation.GuildID(); ok {
+		if err := spokenname.GuildIDValidator(v); err != nil {
+			return &ValidationError{Name: "guild_id", err: fmt.Errorf(`ent: validator failed for field "SpokenName.guild_id": %w`, err)}
+		}
+	}
+	if _, ok := snc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SpokenName.user_id"`)}
+	}
+	if v, ok := snc.mutation.UserID(); ok {
+		if err := spokenname.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "SpokenName.user_id": %w`, err)}
+		}
+	}
+	if _, ok := snc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SpokenName.name"`)}
+	}
+	if v, ok := snc.mutation.Name(); ok {
+		if err := spokenname.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SpokenName.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (snc *SpokenNameCreate) sqlSave(ctx context.Context) (*SpokenName, error) {
+	if err := snc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := snc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, snc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	snc.mutation.id = &_node.ID
+	snc.mutation.done = true
+	return _node, nil
+}
+
+func (snc *SpokenNameCreate) createSpec() (*SpokenName, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SpokenName{config: snc.config}
+		_spec = sqlgraph.NewCreateSpec(spokenname.Table, sqlgraph.NewFieldSpec(spokenname.FieldID, field.TypeInt))
+	)
+	if value, ok := snc.mutation.GuildID(); ok {
+		_spec.SetField(spokenname.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := snc.mutation.UserID(); ok {
+		_spec.SetField(spokenname.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := snc.mutation.Name(); ok {
+		_spec.SetField(spokenname.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	return _node, _spec
+}
+
+// SpokenNameCreateBulk is the builder for creating many SpokenName entities in bulk.
+type SpokenNameCreateBulk struct {
+	config
+	err      error
+	builders []*SpokenNameCreate
+}
+
+// Save creates the SpokenName entities in the database.
+func (sncb *SpokenNameCreateBulk) Save(ctx context.Context) ([]*SpokenName, error) {
+	if sncb.err != nil {
+		return nil, sncb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sncb.builders))
+	nodes := make([]*SpokenName, len(sncb.builders))
+	mutators := make([]Mutator, len(sncb.builders))
+	for i := range sncb.builders {
+		func(i int, root context.Context) {
+			builder := sncb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SpokenNameMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sncb *SpokenNameCreateBulk) SaveX(ctx context.Context) []*SpokenName {
+	v, err := sncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sncb *SpokenNameCreateBulk) Exec(ctx context.Context) error {
+	_, err := sncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sncb *SpokenNameCreateBulk) ExecX(ctx context.Context) {
+	if err := sncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
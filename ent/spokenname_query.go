@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/predicate"
+	"github.com/kechako/yomiko/ent/spokenname"
+)
+
+// SpokenNameQuery is the builder for querying SpokenName entities.
+type SpokenNameQuery struct {
+	config
+	ctx        *QueryContext
+	order      []spokenname.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SpokenName
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SpokenNameQuery builder.
+func (snq *SpokenNameQuery) Where(ps ...predicate.SpokenName) *SpokenNameQuery {
+	snq.predicates = append(snq.predicates, ps...)
+	return snq
+}
+
+// Limit the number of records to be returned by this query.
+func (snq *SpokenNameQuery) Limit(limit int) *SpokenNameQuery {
+	snq.ctx.Limit = &limit
+	return snq
+}
+
+// Offset to start from.
+func (snq *SpokenNameQuery) Offset(offset int) *SpokenNameQuery {
+	snq.ctx.Offset = &offset
+	return snq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (snq *SpokenNameQuery) Unique(unique bool) *SpokenNameQuery {
+	snq.ctx.Unique = &unique
+	return snq
+}
+
+// Order specifies how the records should be ordered.
+func (snq *SpokenNameQuery) Order(o ...spokenname.OrderOption) *SpokenNameQuery {
+	snq.order = append(snq.order, o...)
+	return snq
+}
+
+// First returns the first SpokenName entity from the query.
+// Returns a *NotFoundError when no SpokenName was found.
+func (snq *SpokenNameQuery) First(ctx context.Context) (*SpokenName, error) {
+	nodes, err := snq.Limit(1).All(setContextOp(ctx, snq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{spokenname.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (snq *SpokenNameQuery) FirstX(ctx context.Context) *SpokenName {
+	node, err := snq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SpokenName ID from the query.
+// Returns a *NotFoundError when no SpokenName ID was found.
+func (snq *SpokenNameQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = snq.Limit(1).IDs(setContextOp(ctx, snq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{spokenname.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (snq *SpokenNameQuery) FirstIDX(ctx context.Context) int {
+	id, err := snq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SpokenName entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SpokenName entity is found.
+// Returns a *NotFoundError when no SpokenName entities are found.
+func (snq *SpokenNameQuery) Only(ctx context.Context) (*SpokenName, error) {
+	nodes, err := snq.Limit(2).All(setContextOp(ctx, snq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{spokenname.Label}
+	default:
+		return nil, &NotSingularError{spokenname.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (snq *SpokenNameQuery) OnlyX(ctx context.Context) *SpokenName {
+	node, err := snq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SpokenName ID in the query.
+// Returns a *NotSingularError when more than one SpokenName ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (snq *SpokenNameQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = snq.Limit(2).IDs(setContextOp(ctx, snq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{spokenname.Label}
+	default:
+		err = &NotSingularError{spokenname.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (snq *SpokenNameQuery) OnlyIDX(ctx context.Context) int {
+	id, err := snq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SpokenNames.
+func (snq *SpokenNameQuery) All(ctx context.Context) ([]*SpokenName, error) {
+	ctx = setContextOp(ctx, snq.ctx, "All")
+	if err := snq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SpokenName, *SpokenNameQuery]()
+	return withInterceptors[[]*SpokenName](ctx, snq, qr, snq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (snq *SpokenNameQuery) AllX(ctx context.Context) []*SpokenName {
+	nodes, err := snq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SpokenName IDs.
+func (snq *SpokenNameQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if snq.ctx.Unique == nil && snq.path != nil {
+		snq.Unique(true)
+	}
+	ctx = setContextOp(ctx, snq.ctx, "IDs")
+	if err = snq.Select(spokenname.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (snq *SpokenNameQuery) IDsX(ctx context.Context) []int {
+	ids, err := snq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (snq *SpokenNameQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, snq.ctx, "Count")
+	if err := snq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, snq, querierCount[*SpokenNameQuery](), snq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (snq *SpokenNameQuery) CountX(ctx context.Context) int {
+	count, err := snq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (snq *SpokenNameQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, snq.ctx, "Exist")
+	switch _, err := snq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (snq *SpokenNameQuery) ExistX(ctx context.Context) bool {
+	exist, err := snq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SpokenNameQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (snq *SpokenNameQuery) Clone() *SpokenNameQuery {
+	if snq == nil {
+		return nil
+	}
+	return &SpokenNameQuery{
+		config:     snq.config,
+		ctx:        snq.ctx.Clone(),
+		order:      append([]spokenname.OrderOption{}, snq.order...),
+		inters:     append([]Interceptor{}, snq.inters...),
+		predicates: append([]predicate.SpokenName{}, snq.predicates...),
+		// clone intermediate query.
+		sql:  snq.sql.Clone(),
+		path: snq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID string `json:"guild_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SpokenName.Query().
+//		GroupBy(spokenname.FieldGuildID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (snq *SpokenNameQuery) GroupBy(field string, fields ...string) *SpokenNameGroupBy {
+	snq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SpokenNameGroupBy{build: snq}
+	grbuild.flds = &snq.ctx.Fields
+	grbuild.label = spokenname.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID string `json:"guild_id,omitempty"`
+//	}
+//
+//	client.SpokenName.Query().
+//		Select(spokenname.FieldGuildID).
+//		Scan(ctx, &v)
+func (snq *SpokenNameQuery) Select(fields ...string) *SpokenNameSelect {
+	snq.ctx.Fields = append(snq.ctx.Fields, fields...)
+	sbuild := &SpokenNameSelect{SpokenNameQuery: snq}
+	sbuild.label = spokenname.Label
+	sbuild.flds, sbuild.scan = &snq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SpokenNameSelect configured with the given aggregations.
+func (snq *SpokenNameQuery) Aggregate(fns ...AggregateFunc) *SpokenNameSelect {
+	return snq.Select().Aggregate(fns...)
+}
+
+func (snq *SpokenNameQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range snq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, snq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range snq.ctx.Fields {
+		if !spokenname.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if snq.path != nil {
+		prev, err := snq.path(ctx)
+		if err != nil {
+			return err
+		}
+		snq.sql = prev
+	}
+	return nil
+}
+
+func (snq *SpokenNameQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SpokenName, error) {
+	var (
+		nodes = []*SpokenName{}
+		_spec = snq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SpokenName).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SpokenName{config: snq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, snq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (snq *SpokenNameQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := snq.querySpec()
+	_spec.Node.Columns = snq.ctx.Fields
+	if len(snq.ctx.Fields) > 0 {
+		_spec.Unique = snq.ctx.Unique != nil && *snq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, snq.driver, _spec)
+}
+
+func (snq *SpokenNameQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(spokenname.Table, spokenname.Columns, sqlgraph.NewFieldSpec(spokenname.FieldID, field.TypeInt))
+	_spec.From = snq.sql
+	if unique := snq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if snq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := snq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, spokenname.FieldID)
+		for i := range fields {
+			if fields[i] != spokenname.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := snq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := snq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := snq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := snq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (snq *SpokenNameQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(snq.driver.Dialect())
+	t1 := builder.Table(spokenname.Table)
+	columns := snq.ctx.Fields
+	if len(columns) == 0 {
+		columns = spokenname.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if snq.sql != nil {
+		selector = snq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if snq.ctx.Unique != nil && *snq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range snq.predicates {
+		p(selector)
+	}
+	for _, p := range snq.order {
+		p(selector)
+	}
+	if offset := snq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := snq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SpokenNameGroupBy is the group-by builder for SpokenName entities.
+type SpokenNameGroupBy struct {
+	selector
+	build *SpokenNameQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sngb *SpokenNameGroupBy) Aggregate(fns ...AggregateFunc) *SpokenNameGroupBy {
+	sngb.fns = append(sngb.fns, fns...)
+	return sngb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sngb *SpokenNameGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sngb.build.ctx, "GroupBy")
+	if err := sngb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SpokenNameQuery, *SpokenNameGroupBy](ctx, sngb.build, sngb, sngb.build.inters, v)
+}
+
+func (sngb *SpokenNameGroupBy) sqlScan(ctx context.Context, root *SpokenNameQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sngb.fns))
+	for _, fn := range sngb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sngb.flds)+len(sngb.fns))
+		for _, f := range *sngb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sngb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sngb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SpokenNameSelect is the builder for selecting fields of SpokenName entities.
+type SpokenNameSelect struct {
+	*SpokenNameQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sns *SpokenNameSelect) Aggregate(fns ...AggregateFunc) *SpokenNameSelect {
+	sns.fns = append(sns.fns, fns...)
+	return sns
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sns *SpokenNameSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sns.ctx, "Select")
+	if err := sns.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SpokenNameQuery, *SpokenNameSelect](ctx, sns.SpokenNameQuery, sns, sns.inters, v)
+}
+
+func (sns *SpokenNameSelect) sqlScan(ctx context.Context, root *SpokenNameQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sns.fns))
+	for _, fn := range sns.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sns.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sns.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
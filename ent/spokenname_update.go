@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/predicate"
+	"github.com/kechako/yomiko/ent/spokenname"
+)
+
+// SpokenNameUpdate is the builder for updating SpokenName entities.
+type SpokenNameUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SpokenNameMutation
+}
+
+// Where appends a list predicates to the SpokenNameUpdate builder.
+func (snu *SpokenNameUpdate) Where(ps ...predicate.SpokenName) *SpokenNameUpdate {
+	snu.mutation.Where(ps...)
+	return snu
+}
+
+// SetName sets the "name" field.
+func (snu *SpokenNameUpdate) SetName(s string) *SpokenNameUpdate {
+	snu.mutation.SetName(s)
+	return snu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (snu *SpokenNameUpdate) SetNillableName(s *string) *SpokenNameUpdate {
+	if s != nil {
+		snu.SetName(*s)
+	}
+	return snu
+}
+
+// Mutation returns the SpokenNameMutation object of the builder.
+func (snu *SpokenNameUpdate) Mutation() *SpokenNameMutation {
+	return snu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (snu *SpokenNameUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, snu.sqlSave, snu.mutation, snu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (snu *SpokenNameUpdate) SaveX(ctx context.Context) int {
+	affected, err := snu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (snu *SpokenNameUpdate) Exec(ctx context.Context) error {
+	_, err := snu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (snu *SpokenNameUpdate) ExecX(ctx context.Context) {
+	if err := snu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (snu *SpokenNameUpdate) check() error {
+	if v, ok := snu.mutation.Name(); ok {
+		if err := spokenname.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SpokenName.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (snu *SpokenNameUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := snu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(spokenname.Table, spokenname.Columns, sqlgraph.NewFieldSpec(spokenname.FieldID, field.TypeInt))
+	if ps := snu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := snu.mutation.Name(); ok {
+		_spec.SetField(spokenname.FieldName, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, snu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{spokenname.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	snu.mutation.done = true
+	return n, nil
+}
+
+// SpokenNameUpdateOne is the builder for updating a single SpokenName entity.
+type SpokenNameUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SpokenNameMutation
+}
+
+// SetName sets the "name" field.
+func (snuo *SpokenNameUpdateOne) SetName(s string) *SpokenNameUpdateOne {
+	snuo.mutation.SetName(s)
+	return snuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (snuo *SpokenNameUpdateOne) SetNillableName(s *string) *SpokenNameUpdateOne {
+	if s != nil {
+		snuo.SetName(*s)
+	}
+	return snuo
+}
+
+// Mutation returns the SpokenNameMutation object of the builder.
+func (snuo *SpokenNameUpdateOne) Mutation() *SpokenNameMutation {
+	return snuo.mutation
+}
+
+// Where appends a list predicates to the SpokenNameUpdate builder.
+func (snuo *SpokenNameUpdateOne) Where(ps ...predicate.SpokenName) *SpokenNameUpdateOne {
+	snuo.mutation.Where(ps...)
+	return snuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (snuo *SpokenNameUpdateOne) Select(field string, fields ...string) *SpokenNameUpdateOne {
+	snuo.fields = append([]string{field}, fields...)
+	return snuo
+}
+
+// Save executes the query and returns the updated SpokenName entity.
+func (snuo *SpokenNameUpdateOne) Save(ctx context.Context) (*SpokenName, error) {
+	return withHooks(ctx, snuo.sqlSave, snuo.mutation, snuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (snuo *SpokenNameUpdateOne) SaveX(ctx context.Context) *SpokenName {
+	node, err := snuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (snuo *SpokenNameUpdateOne) Exec(ctx context.Context) error {
+	_, err := snuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (snuo *SpokenNameUpdateOne) ExecX(ctx context.Context) {
+	if err := snuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (snuo *SpokenNameUpdateOne) check() error {
+	if v, ok := snuo.mutation.Name(); ok {
+		if err := spokenname.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SpokenName.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (snuo *SpokenNameUpdateOne) sqlSave(ctx context.Context) (_node *SpokenName, err error) {
+	if err := snuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(spokenname.Table, spokenname.Columns, sqlgraph.NewFieldSpec(spokenname.FieldID, field.TypeInt))
+	id, ok := snuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SpokenName.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := snuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, spokenname.FieldID)
+		for _, f := range fields {
+			if !spokenname.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != spokenname.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := snuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := snuo.mutation.Name(); ok {
+		_spec.SetField(spokenname.FieldName, field.TypeString, value)
+	}
+	_node = &SpokenName{config: snuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, snuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{spokenname.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	snuo.mutation.done = true
+	return _node, nil
+}
@@ -12,6 +12,20 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessRule is the client for interacting with the AccessRule builders.
+	AccessRule *AccessRuleClient
+	// GuildDictEntry is the client for interacting with the GuildDictEntry builders.
+	GuildDictEntry *GuildDictEntryClient
+	// GuildSetting is the client for interacting with the GuildSetting builders.
+	GuildSetting *GuildSettingClient
+	// IgnoreEntry is the client for interacting with the IgnoreEntry builders.
+	IgnoreEntry *IgnoreEntryClient
+	// NGWord is the client for interacting with the NGWord builders.
+	NGWord *NGWordClient
+	// SpokenName is the client for interacting with the SpokenName builders.
+	SpokenName *SpokenNameClient
+	// UserDictEntry is the client for interacting with the UserDictEntry builders.
+	UserDictEntry *UserDictEntryClient
 	// VoiceSetting is the client for interacting with the VoiceSetting builders.
 	VoiceSetting *VoiceSettingClient
 
@@ -145,6 +159,13 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessRule = NewAccessRuleClient(tx.config)
+	tx.GuildDictEntry = NewGuildDictEntryClient(tx.config)
+	tx.GuildSetting = NewGuildSettingClient(tx.config)
+	tx.IgnoreEntry = NewIgnoreEntryClient(tx.config)
+	tx.NGWord = NewNGWordClient(tx.config)
+	tx.SpokenName = NewSpokenNameClient(tx.config)
+	tx.UserDictEntry = NewUserDictEntryClient(tx.config)
 	tx.VoiceSetting = NewVoiceSettingClient(tx.config)
 }
 
@@ -155,7 +176,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: VoiceSetting.QueryXXX(), the query will be executed
+// applies a query, for example: AccessRule.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
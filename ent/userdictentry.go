@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/userdictentry"
+)
+
+// UserDictEntry is the model entity for the UserDictEntry schema.
+type UserDictEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Word holds the value of the "word" field.
+	Word string `json:"word,omitempty"`
+	// Reading holds the value of the "reading" field.
+	Reading      string `json:"reading,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserDictEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userdictentry.FieldID:
+			values[i] = new(sql.NullInt64)
+		case userdictentry.FieldUserID, userdictentry.FieldWord, userdictentry.FieldReading:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserDictEntry fields.
+func (ude *UserDictEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userdictentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ude.ID = int(value.Int64)
+		case userdictentry.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ude.UserID = value.String
+			}
+		case userdictentry.FieldWord:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field word", values[i])
+			} else if value.Valid {
+				ude.Word = value.String
+			}
+		case userdictentry.FieldReading:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reading", values[i])
+			} else if value.Valid {
+				ude.Reading = value.String
+			}
+		default:
+			ude.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserDictEntry.
+// This includes values selected through modifiers, order, etc.
+func (ude *UserDictEntry) Value(name string) (ent.Value, error) {
+	return ude.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserDictEntry.
+// Note that you need to call UserDictEntry.Unwrap() before calling this method if this UserDictEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ude *UserDictEntry) Update() *UserDictEntryUpdateOne {
+	return NewUserDictEntryClient(ude.config).UpdateOne(ude)
+}
+
+// Unwrap unwraps the UserDictEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ude *UserDictEntry) Unwrap() *UserDictEntry {
+	_tx, ok := ude.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserDictEntry is not a transactional entity")
+	}
+	ude.config.driver = _tx.drv
+	return ude
+}
+
+// String implements the fmt.Stringer.
+func (ude *UserDictEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserDictEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ude.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(ude.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("word=")
+	builder.WriteString(ude.Word)
+	builder.WriteString(", ")
+	builder.WriteString("reading=")
+	builder.WriteString(ude.Reading)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserDictEntries is a parsable slice of UserDictEntry.
+type UserDictEntries []*UserDictEntry
@@ -0,0 +1,72 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userdictentry
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the userdictentry type in the database.
+	Label = "user_dict_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldWord holds the string denoting the word field in the database.
+	FieldWord = "word"
+	// FieldReading holds the string denoting the reading field in the database.
+	FieldReading = "reading"
+	// Table holds the table name of the userdictentry in the database.
+	Table = "user_dict_entries"
+)
+
+// Columns holds all SQL columns for userdictentry fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldWord,
+	FieldReading,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// WordValidator is a validator for the "word" field. It is called by the builders before save.
+	WordValidator func(string) error
+	// ReadingValidator is a validator for the "reading" field. It is called by the builders before save.
+	ReadingValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the UserDictEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByWord orders the results by the word field.
+func ByWord(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWord, opts...).ToFunc()
+}
+
+// ByReading orders the results by the reading field.
+func ByReading(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReading, opts...).ToFunc()
+}
@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userdictentry
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/kechako/yomiko/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldEQ(FieldUserID, v))
+}
+
+// Word applies equality check predicate on the "word" field. It's identical to WordEQ.
+func Word(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldEQ(FieldWord, v))
+}
+
+// Reading applies equality check predicate on the "reading" field. It's identical to ReadingEQ.
+func Reading(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldEQ(FieldReading, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// WordEQ applies the EQ predicate on the "word" field.
+func WordEQ(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldEQ(FieldWord, v))
+}
+
+// WordNEQ applies the NEQ predicate on the "word" field.
+func WordNEQ(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldNEQ(FieldWord, v))
+}
+
+// WordIn applies the In predicate on the "word" field.
+func WordIn(vs ...string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldIn(FieldWord, vs...))
+}
+
+// WordNotIn applies the NotIn predicate on the "word" field.
+func WordNotIn(vs ...string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldNotIn(FieldWord, vs...))
+}
+
+// WordGT applies the GT predicate on the "word" field.
+func WordGT(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldGT(FieldWord, v))
+}
+
+// WordGTE applies the GTE predicate on the "word" field.
+func WordGTE(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldGTE(FieldWord, v))
+}
+
+// WordLT applies the LT predicate on the "word" field.
+func WordLT(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldLT(FieldWord, v))
+}
+
+// WordLTE applies the LTE predicate on the "word" field.
+func WordLTE(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldLTE(FieldWord, v))
+}
+
+// WordContains applies the Contains predicate on the "word" field.
+func WordContains(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldContains(FieldWord, v))
+}
+
+// WordHasPrefix applies the HasPrefix predicate on the "word" field.
+func WordHasPrefix(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldHasPrefix(FieldWord, v))
+}
+
+// WordHasSuffix applies the HasSuffix predicate on the "word" field.
+func WordHasSuffix(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldHasSuffix(FieldWord, v))
+}
+
+// WordEqualFold applies the EqualFold predicate on the "word" field.
+func WordEqualFold(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldEqualFold(FieldWord, v))
+}
+
+// WordContainsFold applies the ContainsFold predicate on the "word" field.
+func WordContainsFold(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldContainsFold(FieldWord, v))
+}
+
+// ReadingEQ applies the EQ predicate on the "reading" field.
+func ReadingEQ(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldEQ(FieldReading, v))
+}
+
+// ReadingNEQ applies the NEQ predicate on the "reading" field.
+func ReadingNEQ(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldNEQ(FieldReading, v))
+}
+
+// ReadingIn applies the In predicate on the "reading" field.
+func ReadingIn(vs ...string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldIn(FieldReading, vs...))
+}
+
+// ReadingNotIn applies the NotIn predicate on the "reading" field.
+func ReadingNotIn(vs ...string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldNotIn(FieldReading, vs...))
+}
+
+// ReadingGT applies the GT predicate on the "reading" field.
+func ReadingGT(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldGT(FieldReading, v))
+}
+
+// ReadingGTE applies the GTE predicate on the "reading" field.
+func ReadingGTE(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldGTE(FieldReading, v))
+}
+
+// ReadingLT applies the LT predicate on the "reading" field.
+func ReadingLT(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldLT(FieldReading, v))
+}
+
+// ReadingLTE applies the LTE predicate on the "reading" field.
+func ReadingLTE(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldLTE(FieldReading, v))
+}
+
+// ReadingContains applies the Contains predicate on the "reading" field.
+func ReadingContains(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldContains(FieldReading, v))
+}
+
+// ReadingHasPrefix applies the HasPrefix predicate on the "reading" field.
+func ReadingHasPrefix(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldHasPrefix(FieldReading, v))
+}
+
+// ReadingHasSuffix applies the HasSuffix predicate on the "reading" field.
+func ReadingHasSuffix(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldHasSuffix(FieldReading, v))
+}
+
+// ReadingEqualFold applies the EqualFold predicate on the "reading" field.
+func ReadingEqualFold(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldEqualFold(FieldReading, v))
+}
+
+// ReadingContainsFold applies the ContainsFold predicate on the "reading" field.
+func ReadingContainsFold(v string) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.FieldContainsFold(FieldReading, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserDictEntry) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserDictEntry) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserDictEntry) predicate.UserDictEntry {
+	return predicate.UserDictEntry(sql.NotPredicates(p))
+}
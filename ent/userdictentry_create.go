@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/userdictentry"
+)
+
+// UserDictEntryCreate is the builder for creating a UserDictEntry entity.
+type UserDictEntryCreate struct {
+	config
+	mutation *UserDictEntryMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (udec *UserDictEntryCreate) SetUserID(s string) *UserDictEntryCreate {
+	udec.mutation.SetUserID(s)
+	return udec
+}
+
+// SetWord sets the "word" field.
+func (udec *UserDictEntryCreate) SetWord(s string) *UserDictEntryCreate {
+	udec.mutation.SetWord(s)
+	return udec
+}
+
+// SetReading sets the "reading" field.
+func (udec *UserDictEntryCreate) SetReading(s string) *UserDictEntryCreate {
+	udec.mutation.SetReading(s)
+	return udec
+}
+
+// Mutation returns the UserDictEntryMutation object of the builder.
+func (udec *UserDictEntryCreate) Mutation() *UserDictEntryMutation {
+	return udec.mutation
+}
+
+// Save creates the UserDictEntry in the database.
+func (udec *UserDictEntryCreate) Save(ctx context.Context) (*UserDictEntry, error) {
+	return withHooks(ctx, udec.sqlSave, udec.mutation, udec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (udec *UserDictEntryCreate) SaveX(ctx context.Context) *UserDictEntry {
+	v, err := udec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (udec *UserDictEntryCreate) Exec(ctx context.Context) error {
+	_, err := udec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (udec *UserDictEntryCreate) ExecX(ctx context.Context) {
+	if err := udec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (udec *UserDictEntryCreate) check() error {
+	if _, ok := udec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserDictEntry.user_id"`)}
+	}
+	if v, ok := udec.mutation.UserID(); ok {
+		if err := userdictentry.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserDictEntry.user_id": %w`, err)}
+		}
+	}
+	if _, ok := udec.mutation.Word(); !ok {
+		return &ValidationError{Name: "word", err: errors.New(`ent: missing required field "UserDictEntry.word"`)}
+	}
+	if v, ok := udec.mutation.Word(); ok {
+		if err := userdictentry.WordValidator(v); err != nil {
+			return &ValidationError{Name: "word", err: fmt.Errorf(`ent: validator failed for field "UserDictEntry.word": %w`, err)}
+		}
+	}
+	if _, ok := udec.mutation.Reading(); !ok {
+		return &ValidationError{Name: "reading", err: errors.New(`ent: missing required field "UserDictEntry.reading"`)}
+	}
+	if v, ok := udec.mutation.Reading(); ok {
+		if err := userdictentry.ReadingValidator(v); err != nil {
+			return &ValidationError{Name: "reading", err: fmt.Errorf(`ent: validator failed for field "UserDictEntry.reading": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (udec *UserDictEntryCreate) sqlSave(ctx context.Context) (*UserDictEntry, error) {
+	if err := udec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := udec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, udec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	udec.mutation.id = &_node.ID
+	udec.mutation.done = true
+	return _node, nil
+}
+
+func (udec *UserDictEntryCreate) createSpec() (*UserDictEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserDictEntry{config: udec.config}
+		_spec = sqlgraph.NewCreateSpec(userdictentry.Table, sqlgraph.NewFieldSpec(userdictentry.FieldID, field.TypeInt))
+	)
+	if value, ok := udec.mutation.UserID(); ok {
+		_spec.SetField(userdictentry.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := udec.mutation.Word(); ok {
+		_spec.SetField(userdictentry.FieldWord, field.TypeString, value)
+		_node.Word = value
+	}
+	if value, ok := udec.mutation.Reading(); ok {
+		_spec.SetField(userdictentry.FieldReading, field.TypeString, value)
+		_node.Reading = value
+	}
+	return _node, _spec
+}
+
+// UserDictEntryCreateBulk is the builder for creating many UserDictEntry entities in bulk.
+type UserDictEntryCreateBulk struct {
+	config
+	err      error
+	builders []*UserDictEntryCreate
+}
+
+// Save creates the UserDictEntry entities in the database.
+func (udecb *UserDictEntryCreateBulk) Save(ctx context.Context) ([]*UserDictEntry, error) {
+	if udecb.err != nil {
+		return nil, udecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(udecb.builders))
+	nodes := make([]*UserDictEntry, len(udecb.builders))
+	mutators := make([]Mutator, len(udecb.builders))
+	for i := range udecb.builders {
+		func(i int, root context.Context) {
+			builder := udecb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserDictEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, udecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, udecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, udecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (udecb *UserDictEntryCreateBulk) SaveX(ctx context.Context) []*UserDictEntry {
+	v, err := udecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (udecb *UserDictEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := udecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (udecb *UserDictEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := udecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
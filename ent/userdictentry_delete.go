@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/predicate"
+	"github.com/kechako/yomiko/ent/userdictentry"
+)
+
+// UserDictEntryDelete is the builder for deleting a UserDictEntry entity.
+type UserDictEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserDictEntryMutation
+}
+
+// Where appends a list predicates to the UserDictEntryDelete builder.
+func (uded *UserDictEntryDelete) Where(ps ...predicate.UserDictEntry) *UserDictEntryDelete {
+	uded.mutation.Where(ps...)
+	return uded
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (uded *UserDictEntryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, uded.sqlExec, uded.mutation, uded.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uded *UserDictEntryDelete) ExecX(ctx context.Context) int {
+	n, err := uded.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (uded *UserDictEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(userdictentry.Table, sqlgraph.NewFieldSpec(userdictentry.FieldID, field.TypeInt))
+	if ps := uded.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, uded.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	uded.mutation.done = true
+	return affected, err
+}
+
+// UserDictEntryDeleteOne is the builder for deleting a single UserDictEntry entity.
+type UserDictEntryDeleteOne struct {
+	uded *UserDictEntryDelete
+}
+
+// Where appends a list predicates to the UserDictEntryDelete builder.
+func (udedo *UserDictEntryDeleteOne) Where(ps ...predicate.UserDictEntry) *UserDictEntryDeleteOne {
+	udedo.uded.mutation.Where(ps...)
+	return udedo
+}
+
+// Exec executes the deletion query.
+func (udedo *UserDictEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := udedo.uded.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{userdictentry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (udedo *UserDictEntryDeleteOne) ExecX(ctx context.Context) {
+	if err := udedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/predicate"
+	"github.com/kechako/yomiko/ent/userdictentry"
+)
+
+// UserDictEntryQuery is the builder for querying UserDictEntry entities.
+type UserDictEntryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []userdictentry.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UserDictEntry
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserDictEntryQuery builder.
+func (udeq *UserDictEntryQuery) Where(ps ...predicate.UserDictEntry) *UserDictEntryQuery {
+	udeq.predicates = append(udeq.predicates, ps...)
+	return udeq
+}
+
+// Limit the number of records to be returned by this query.
+func (udeq *UserDictEntryQuery) Limit(limit int) *UserDictEntryQuery {
+	udeq.ctx.Limit = &limit
+	return udeq
+}
+
+// Offset to start from.
+func (udeq *UserDictEntryQuery) Offset(offset int) *UserDictEntryQuery {
+	udeq.ctx.Offset = &offset
+	return udeq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (udeq *UserDictEntryQuery) Unique(unique bool) *UserDictEntryQuery {
+	udeq.ctx.Unique = &unique
+	return udeq
+}
+
+// Order specifies how the records should be ordered.
+func (udeq *UserDictEntryQuery) Order(o ...userdictentry.OrderOption) *UserDictEntryQuery {
+	udeq.order = append(udeq.order, o...)
+	return udeq
+}
+
+// First returns the first UserDictEntry entity from the query.
+// Returns a *NotFoundError when no UserDictEntry was found.
+func (udeq *UserDictEntryQuery) First(ctx context.Context) (*UserDictEntry, error) {
+	nodes, err := udeq.Limit(1).All(setContextOp(ctx, udeq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{userdictentry.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (udeq *UserDictEntryQuery) FirstX(ctx context.Context) *UserDictEntry {
+	node, err := udeq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserDictEntry ID from the query.
+// Returns a *NotFoundError when no UserDictEntry ID was found.
+func (udeq *UserDictEntryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = udeq.Limit(1).IDs(setContextOp(ctx, udeq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{userdictentry.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (udeq *UserDictEntryQuery) FirstIDX(ctx context.Context) int {
+	id, err := udeq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserDictEntry entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserDictEntry entity is found.
+// Returns a *NotFoundError when no UserDictEntry entities are found.
+func (udeq *UserDictEntryQuery) Only(ctx context.Context) (*UserDictEntry, error) {
+	nodes, err := udeq.Limit(2).All(setContextOp(ctx, udeq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{userdictentry.Label}
+	default:
+		return nil, &NotSingularError{userdictentry.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (udeq *UserDictEntryQuery) OnlyX(ctx context.Context) *UserDictEntry {
+	node, err := udeq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserDictEntry ID in the query.
+// Returns a *NotSingularError when more than one UserDictEntry ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (udeq *UserDictEntryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = udeq.Limit(2).IDs(setContextOp(ctx, udeq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{userdictentry.Label}
+	default:
+		err = &NotSingularError{userdictentry.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (udeq *UserDictEntryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := udeq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserDictEntries.
+func (udeq *UserDictEntryQuery) All(ctx context.Context) ([]*UserDictEntry, error) {
+	ctx = setContextOp(ctx, udeq.ctx, "All")
+	if err := udeq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UserDictEntry, *UserDictEntryQuery]()
+	return withInterceptors[[]*UserDictEntry](ctx, udeq, qr, udeq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (udeq *UserDictEntryQuery) AllX(ctx context.Context) []*UserDictEntry {
+	nodes, err := udeq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserDictEntry IDs.
+func (udeq *UserDictEntryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if udeq.ctx.Unique == nil && udeq.path != nil {
+		udeq.Unique(true)
+	}
+	ctx = setContextOp(ctx, udeq.ctx, "IDs")
+	if err = udeq.Select(userdictentry.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (udeq *UserDictEntryQuery) IDsX(ctx context.Context) []int {
+	ids, err := udeq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (udeq *UserDictEntryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, udeq.ctx, "Count")
+	if err := udeq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, udeq, querierCount[*UserDictEntryQuery](), udeq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (udeq *UserDictEntryQuery) CountX(ctx context.Context) int {
+	count, err := udeq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (udeq *UserDictEntryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, udeq.ctx, "Exist")
+	switch _, err := udeq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (udeq *UserDictEntryQuery) ExistX(ctx context.Context) bool {
+	exist, err := udeq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserDictEntryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (udeq *UserDictEntryQuery) Clone() *UserDictEntryQuery {
+	if udeq == nil {
+		return nil
+	}
+	return &UserDictEntryQuery{
+		config:     udeq.config,
+		ctx:        udeq.ctx.Clone(),
+		order:      append([]userdictentry.OrderOption{}, udeq.order...),
+		inters:     append([]Interceptor{}, udeq.inters...),
+		predicates: append([]predicate.UserDictEntry{}, udeq.predicates...),
+		// clone intermediate query.
+		sql:  udeq.sql.Clone(),
+		path: udeq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserDictEntry.Query().
+//		GroupBy(userdictentry.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (udeq *UserDictEntryQuery) GroupBy(field string, fields ...string) *UserDictEntryGroupBy {
+	udeq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserDictEntryGroupBy{build: udeq}
+	grbuild.flds = &udeq.ctx.Fields
+	grbuild.label = userdictentry.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID string `json:"user_id,omitempty"`
+//	}
+//
+//	client.UserDictEntry.Query().
+//		Select(userdictentry.FieldUserID).
+//		Scan(ctx, &v)
+func (udeq *UserDictEntryQuery) Select(fields ...string) *UserDictEntrySelect {
+	udeq.ctx.Fields = append(udeq.ctx.Fields, fields...)
+	sbuild := &UserDictEntrySelect{UserDictEntryQuery: udeq}
+	sbuild.label = userdictentry.Label
+	sbuild.flds, sbuild.scan = &udeq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserDictEntrySelect configured with the given aggregations.
+func (udeq *UserDictEntryQuery) Aggregate(fns ...AggregateFunc) *UserDictEntrySelect {
+	return udeq.Select().Aggregate(fns...)
+}
+
+func (udeq *UserDictEntryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range udeq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, udeq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range udeq.ctx.Fields {
+		if !userdictentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if udeq.path != nil {
+		prev, err := udeq.path(ctx)
+		if err != nil {
+			return err
+		}
+		udeq.sql = prev
+	}
+	return nil
+}
+
+func (udeq *UserDictEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserDictEntry, error) {
+	var (
+		nodes = []*UserDictEntry{}
+		_spec = udeq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UserDictEntry).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UserDictEntry{config: udeq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, udeq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (udeq *UserDictEntryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := udeq.querySpec()
+	_spec.Node.Columns = udeq.ctx.Fields
+	if len(udeq.ctx.Fields) > 0 {
+		_spec.Unique = udeq.ctx.Unique != nil && *udeq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, udeq.driver, _spec)
+}
+
+func (udeq *UserDictEntryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(userdictentry.Table, userdictentry.Columns, sqlgraph.NewFieldSpec(userdictentry.FieldID, field.TypeInt))
+	_spec.From = udeq.sql
+	if unique := udeq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if udeq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := udeq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userdictentry.FieldID)
+		for i := range fields {
+			if fields[i] != userdictentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := udeq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := udeq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := udeq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := udeq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (udeq *UserDictEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(udeq.driver.Dialect())
+	t1 := builder.Table(userdictentry.Table)
+	columns := udeq.ctx.Fields
+	if len(columns) == 0 {
+		columns = userdictentry.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if udeq.sql != nil {
+		selector = udeq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if udeq.ctx.Unique != nil && *udeq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range udeq.predicates {
+		p(selector)
+	}
+	for _, p := range udeq.order {
+		p(selector)
+	}
+	if offset := udeq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := udeq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// UserDictEntryGroupBy is the group-by builder for UserDictEntry entities.
+type UserDictEntryGroupBy struct {
+	selector
+	build *UserDictEntryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (udegb *UserDictEntryGroupBy) Aggregate(fns ...AggregateFunc) *UserDictEntryGroupBy {
+	udegb.fns = append(udegb.fns, fns...)
+	return udegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (udegb *UserDictEntryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, udegb.build.ctx, "GroupBy")
+	if err := udegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserDictEntryQuery, *UserDictEntryGroupBy](ctx, udegb.build, udegb, udegb.build.inters, v)
+}
+
+func (udegb *UserDictEntryGroupBy) sqlScan(ctx context.Context, root *UserDictEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(udegb.fns))
+	for _, fn := range udegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*udegb.flds)+len(udegb.fns))
+		for _, f := range *udegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*udegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := udegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UserDictEntrySelect is the builder for selecting fields of UserDictEntry entities.
+type UserDictEntrySelect struct {
+	*UserDictEntryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (udes *UserDictEntrySelect) Aggregate(fns ...AggregateFunc) *UserDictEntrySelect {
+	udes.fns = append(udes.fns, fns...)
+	return udes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (udes *UserDictEntrySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, udes.ctx, "Select")
+	if err := udes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserDictEntryQuery, *UserDictEntrySelect](ctx, udes.UserDictEntryQuery, udes, udes.inters, v)
+}
+
+func (udes *UserDictEntrySelect) sqlScan(ctx context.Context, root *UserDictEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(udes.fns))
+	for _, fn := range udes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*udes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := udes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/kechako/yomiko/ent/predicate"
+	"github.com/kechako/yomiko/ent/userdictentry"
+)
+
+// UserDictEntryUpdate is the builder for updating UserDictEntry entities.
+type UserDictEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserDictEntryMutation
+}
+
+// Where appends a list predicates to the UserDictEntryUpdate builder.
+func (udeu *UserDictEntryUpdate) Where(ps ...predicate.UserDictEntry) *UserDictEntryUpdate {
+	udeu.mutation.Where(ps...)
+	return udeu
+}
+
+// SetReading sets the "reading" field.
+func (udeu *UserDictEntryUpdate) SetReading(s string) *UserDictEntryUpdate {
+	udeu.mutation.SetReading(s)
+	return udeu
+}
+
+// SetNillableReading sets the "reading" field if the given value is not nil.
+func (udeu *UserDictEntryUpdate) SetNillableReading(s *string) *UserDictEntryUpdate {
+	if s != nil {
+		udeu.SetReading(*s)
+	}
+	return udeu
+}
+
+// Mutation returns the UserDictEntryMutation object of the builder.
+func (udeu *UserDictEntryUpdate) Mutation() *UserDictEntryMutation {
+	return udeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (udeu *UserDictEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, udeu.sqlSave, udeu.mutation, udeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (udeu *UserDictEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := udeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (udeu *UserDictEntryUpdate) Exec(ctx context.Context) error {
+	_, err := udeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (udeu *UserDictEntryUpdate) ExecX(ctx context.Context) {
+	if err := udeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (udeu *UserDictEntryUpdate) check() error {
+	if v, ok := udeu.mutation.Reading(); ok {
+		if err := userdictentry.ReadingValidator(v); err != nil {
+			return &ValidationError{Name: "reading", err: fmt.Errorf(`ent: validator failed for field "UserDictEntry.reading": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (udeu *UserDictEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := udeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userdictentry.Table, userdictentry.Columns, sqlgraph.NewFieldSpec(userdictentry.FieldID, field.TypeInt))
+	if ps := udeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := udeu.mutation.Reading(); ok {
+		_spec.SetField(userdictentry.FieldReading, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, udeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userdictentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	udeu.mutation.done = true
+	return n, nil
+}
+
+// UserDictEntryUpdateOne is the builder for updating a single UserDictEntry entity.
+type UserDictEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserDictEntryMutation
+}
+
+// SetReading sets the "reading" field.
+func (udeuo *UserDictEntryUpdateOne) SetReading(s string) *UserDictEntryUpdateOne {
+	udeuo.mutation.SetReading(s)
+	return udeuo
+}
+
+// SetNillableReading sets the "reading" field if the given value is not nil.
+func (udeuo *UserDictEntryUpdateOne) SetNillableReading(s *string) *UserDictEntryUpdateOne {
+	if s != nil {
+		udeuo.SetReading(*s)
+	}
+	return udeuo
+}
+
+// Mutation returns the UserDictEntryMutation object of the builder.
+func (udeuo *UserDictEntryUpdateOne) Mutation() *UserDictEntryMutation {
+	return udeuo.mutation
+}
+
+// Where appends a list predicates to the UserDictEntryUpdate builder.
+func (udeuo *UserDictEntryUpdateOne) Where(ps ...predicate.UserDictEntry) *UserDictEntryUpdateOne {
+	udeuo.mutation.Where(ps...)
+	return udeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (udeuo *UserDictEntryUpdateOne) Select(field string, fields ...string) *UserDictEntryUpdateOne {
+	udeuo.fields = append([]string{field}, fields...)
+	return udeuo
+}
+
+// Save executes the query and returns the updated UserDictEntry entity.
+func (udeuo *UserDictEntryUpdateOne) Save(ctx context.Context) (*UserDictEntry, error) {
+	return withHooks(ctx, udeuo.sqlSave, udeuo.mutation, udeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (udeuo *UserDictEntryUpdateOne) SaveX(ctx context.Context) *UserDictEntry {
+	node, err := udeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (udeuo *UserDictEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := udeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (udeuo *UserDictEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := udeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (udeuo *UserDictEntryUpdateOne) check() error {
+	if v, ok := udeuo.mutation.Reading(); ok {
+		if err := userdictentry.ReadingValidator(v); err != nil {
+			return &ValidationError{Name: "reading", err: fmt.Errorf(`ent: validator failed for field "UserDictEntry.reading": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (udeuo *UserDictEntryUpdateOne) sqlSave(ctx context.Context) (_node *UserDictEntry, err error) {
+	if err := udeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(userdictentry.Table, userdictentry.Columns, sqlgraph.NewFieldSpec(userdictentry.FieldID, field.TypeInt))
+	id, ok := udeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserDictEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := udeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userdictentry.FieldID)
+		for _, f := range fields {
+			if !userdictentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userdictentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := udeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := udeuo.mutation.Reading(); ok {
+		_spec.SetField(userdictentry.FieldReading, field.TypeString, value)
+	}
+	_node = &UserDictEntry{config: udeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, udeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userdictentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	udeuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,82 @@
+package ssml
+
+// Capabilities describes the subset of SSML supported by a speech engine.
+// Element and attribute names are the local names used in SSML documents,
+// e.g. "say-as" and "format".
+type Capabilities interface {
+	SupportsElement(name string) bool
+	SupportsAttribute(element, attr string) bool
+	SupportsInterpretation(t InterpretationType) bool
+}
+
+// Downgrade returns a copy of root in which the nodes not supported by caps
+// are stripped or rewritten:
+//
+//   - unsupported p and s elements are replaced by their contents followed by
+//     a line break,
+//   - unsupported sub elements are replaced by their aliases,
+//   - unsupported say-as elements are replaced by their texts, except for
+//     the expletive and bleep interpretations which are removed so that
+//     censored words will not be read,
+//   - unsupported attributes are removed.
+//
+// root is not modified.
+func Downgrade(root *SSML, caps Capabilities) *SSML {
+	return &SSML{
+		Nodes: downgradeNodes(root.Nodes, caps),
+	}
+}
+
+func downgradeNodes(nodes []Node, caps Capabilities) []Node {
+	downgraded := make([]Node, 0, len(nodes))
+	for _, node := range nodes {
+		downgraded = append(downgraded, downgradeNode(node, caps)...)
+	}
+	return downgraded
+}
+
+func downgradeNode(node Node, caps Capabilities) []Node {
+	switch n := node.(type) {
+	case *SSML:
+		return downgradeNodes(n.Nodes, caps)
+	case *Paragraph:
+		nodes := downgradeNodes(n.Nodes, caps)
+		if !caps.SupportsElement(paragraphName.Local) {
+			return append(nodes, Text("\n"))
+		}
+		return []Node{&Paragraph{Nodes: nodes}}
+	case *Sentence:
+		nodes := downgradeNodes(n.Nodes, caps)
+		if !caps.SupportsElement(sentenceName.Local) {
+			return append(nodes, Text("\n"))
+		}
+		return []Node{&Sentence{Nodes: nodes}}
+	case *SayAs:
+		if !caps.SupportsElement(sayAsName.Local) || !caps.SupportsInterpretation(n.InterpretAs) {
+			switch n.InterpretAs {
+			case Expletive, Bleep:
+				return nil
+			}
+			return []Node{n.Text}
+		}
+		sa := *n
+		if !caps.SupportsAttribute(sayAsName.Local, "format") {
+			sa.Format = ""
+		}
+		if !caps.SupportsAttribute(sayAsName.Local, "detail") {
+			sa.Detail = ""
+		}
+		if !caps.SupportsAttribute(sayAsName.Local, "language") {
+			sa.Language = ""
+		}
+		return []Node{&sa}
+	case *Sub:
+		if !caps.SupportsElement(subName.Local) {
+			return []Node{Text(n.Alias)}
+		}
+		sub := *n
+		return []Node{&sub}
+	}
+
+	return []Node{node}
+}
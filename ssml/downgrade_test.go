@@ -0,0 +1,99 @@
+package ssml
+
+import (
+	"fmt"
+	"testing"
+)
+
+type testCapabilities struct {
+	elements        map[string]bool
+	attributes      map[string]bool
+	interpretations map[InterpretationType]bool
+}
+
+func (caps *testCapabilities) SupportsElement(name string) bool {
+	return caps.elements[name]
+}
+
+func (caps *testCapabilities) SupportsAttribute(element, attr string) bool {
+	return caps.attributes[element+"@"+attr]
+}
+
+func (caps *testCapabilities) SupportsInterpretation(t InterpretationType) bool {
+	return caps.interpretations[t]
+}
+
+func newDowngradeTestSSML() *SSML {
+	root := New()
+	root.AddNode(&Paragraph{
+		Nodes: []Node{
+			&Sentence{
+				Nodes: []Node{
+					Text("aaaa"),
+					&SayAs{Text: "ABCDE", InterpretAs: Characters},
+					&Sub{Text: "禁書目録", Alias: "いんでっくす"},
+					&SayAs{Text: "2024/6/1", InterpretAs: Date, Format: "ymd"},
+					&SayAs{Text: "くそ", InterpretAs: Expletive},
+				},
+			},
+		},
+	})
+	return root
+}
+
+var downgradeTests = []struct {
+	caps *testCapabilities
+	want string
+}{
+	{
+		caps: &testCapabilities{
+			elements: map[string]bool{
+				"speak": true, "p": true, "s": true, "say-as": true, "sub": true,
+			},
+			attributes: map[string]bool{
+				"say-as@format": true, "say-as@detail": true, "say-as@language": true,
+			},
+			interpretations: map[InterpretationType]bool{
+				Characters: true, Date: true, Expletive: true,
+			},
+		},
+		want: `<speak><p><s>aaaa<say-as interpret-as="characters">ABCDE</say-as><sub alias="いんでっくす">禁書目録</sub><say-as interpret-as="date" format="ymd">2024/6/1</say-as><say-as interpret-as="expletive">くそ</say-as></s></p></speak>`,
+	},
+	{
+		caps: &testCapabilities{
+			elements: map[string]bool{
+				"speak": true, "p": true, "s": true, "say-as": true,
+			},
+			interpretations: map[InterpretationType]bool{
+				Characters: true, Date: true,
+			},
+		},
+		want: `<speak><p><s>aaaa<say-as interpret-as="characters">ABCDE</say-as>いんでっくす<say-as interpret-as="date">2024/6/1</say-as></s></p></speak>`,
+	},
+	{
+		caps: &testCapabilities{
+			elements: map[string]bool{
+				"speak": true,
+			},
+		},
+		want: "<speak>aaaaABCDEいんでっくす2024/6/1\n\n</speak>",
+	},
+}
+
+func TestDowngrade(t *testing.T) {
+	for i, tt := range downgradeTests {
+		t.Run(fmt.Sprintf("test_%02d", i+1), func(t *testing.T) {
+			root := newDowngradeTestSSML()
+			orig := root.ToSSML()
+
+			got := Downgrade(root, tt.caps).ToSSML()
+			if got != tt.want {
+				t.Errorf("Downgrade():\ngot : %q\nwant: %q", got, tt.want)
+			}
+
+			if root.ToSSML() != orig {
+				t.Error("Downgrade() must not modify the source document")
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package ssml
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteText writes the text that will be spoken for node.
+// Sub elements are replaced with their aliases, and say-as elements are
+// annotated with their interpretation like [characters:ABC].
+func WriteText(w io.Writer, node Node) error {
+	tw := &textWriter{w: w}
+	tw.write(node)
+	return tw.err
+}
+
+// ToText returns the text written by WriteText.
+func ToText(node Node) string {
+	var s strings.Builder
+	WriteText(&s, node)
+	return s.String()
+}
+
+type textWriter struct {
+	w   io.Writer
+	err error
+
+	// separator to be written before the next text
+	sep string
+	// true if any text has been written
+	written bool
+}
+
+func (tw *textWriter) writeString(s string) {
+	if tw.err != nil || s == "" {
+		return
+	}
+	if tw.written && tw.sep != "" {
+		_, tw.err = io.WriteString(tw.w, tw.sep)
+		if tw.err != nil {
+			return
+		}
+	}
+	tw.sep = ""
+	tw.written = true
+	_, tw.err = io.WriteString(tw.w, s)
+}
+
+// block requests a separator between the preceding text and the next text.
+// A paragraph break takes precedence over a sentence break.
+func (tw *textWriter) block(sep string) {
+	if len(sep) > len(tw.sep) {
+		tw.sep = sep
+	}
+}
+
+func (tw *textWriter) write(node Node) {
+	switch n := node.(type) {
+	case *SSML:
+		for _, node := range n.Nodes {
+			tw.write(node)
+		}
+	case *Paragraph:
+		tw.block("\n\n")
+		for _, node := range n.Nodes {
+			tw.write(node)
+		}
+		tw.block("\n\n")
+	case *Sentence:
+		tw.block("\n")
+		for _, node := range n.Nodes {
+			tw.write(node)
+		}
+		tw.block("\n")
+	case Text:
+		tw.writeString(string(n))
+	case *SayAs:
+		tw.writeString("[" + n.interpretation() + ":" + string(n.Text) + "]")
+	case *Sub:
+		tw.writeString(n.Alias)
+	}
+}
+
+func (sa *SayAs) interpretation() string {
+	var params []string
+	for _, attr := range sa.attrs()[1:] {
+		params = append(params, attr.Name.Local+"="+attr.Value)
+	}
+	if len(params) == 0 {
+		return string(sa.InterpretAs)
+	}
+	return string(sa.InterpretAs) + "(" + strings.Join(params, ",") + ")"
+}
+
+// WriteTree writes an indented dump of the node tree for debugging.
+func WriteTree(w io.Writer, node Node) error {
+	return writeTree(w, node, 0)
+}
+
+// ToTree returns the dump written by WriteTree.
+func ToTree(node Node) string {
+	var s strings.Builder
+	WriteTree(&s, node)
+	return s.String()
+}
+
+func writeTree(w io.Writer, node Node, depth int) error {
+	indent := strings.Repeat("  ", depth)
+
+	var (
+		line     string
+		children []Node
+	)
+	switch n := node.(type) {
+	case *SSML:
+		line = speakName.Local
+		children = n.Nodes
+	case *Paragraph:
+		line = paragraphName.Local
+		children = n.Nodes
+	case *Sentence:
+		line = sentenceName.Local
+		children = n.Nodes
+	case Text:
+		line = "text " + strconv.Quote(string(n))
+	case *SayAs:
+		line = sayAsName.Local
+		for _, attr := range n.attrs() {
+			line += fmt.Sprintf(" %s=%q", attr.Name.Local, attr.Value)
+		}
+		line += " " + strconv.Quote(string(n.Text))
+	case *Sub:
+		line = fmt.Sprintf("%s alias=%q %s", subName.Local, n.Alias, strconv.Quote(string(n.Text)))
+	default:
+		line = fmt.Sprintf("%T", node)
+	}
+
+	if _, err := io.WriteString(w, indent+line+"\n"); err != nil {
+		return err
+	}
+
+	for _, child := range children {
+		if err := writeTree(w, child, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
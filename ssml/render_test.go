@@ -0,0 +1,74 @@
+package ssml
+
+import "testing"
+
+func newRenderTestSSML() *SSML {
+	root := New()
+
+	root.AddNode(&Paragraph{
+		Nodes: []Node{
+			&Sentence{
+				Nodes: []Node{Text("読子")},
+			},
+		},
+	})
+
+	p := &Paragraph{}
+	root.AddNode(p)
+
+	p.AddNodes(
+		&Sentence{
+			Nodes: []Node{
+				Text("aaaa"),
+				&SayAs{
+					Text:        Text("ABCDE"),
+					InterpretAs: Characters,
+				},
+				&Sub{
+					Text:  Text("禁書目録"),
+					Alias: "いんでっくす",
+				},
+			},
+		},
+		&Sentence{
+			Nodes: []Node{
+				&SayAs{
+					Text:        Text("2024/6/1"),
+					InterpretAs: Date,
+					Format:      "ymd",
+				},
+			},
+		},
+	)
+
+	return root
+}
+
+func TestToText(t *testing.T) {
+	const want = "読子\n\naaaa[characters:ABCDE]いんでっくす\n[date(format=ymd):2024/6/1]"
+
+	got := ToText(newRenderTestSSML())
+	if got != want {
+		t.Errorf("ToText():\ngot : %q\nwant: %q", got, want)
+	}
+}
+
+func TestToTree(t *testing.T) {
+	const want = `speak
+  p
+    s
+      text "読子"
+  p
+    s
+      text "aaaa"
+      say-as interpret-as="characters" "ABCDE"
+      sub alias="いんでっくす" "禁書目録"
+    s
+      say-as interpret-as="date" format="ymd" "2024/6/1"
+`
+
+	got := ToTree(newRenderTestSSML())
+	if got != want {
+		t.Errorf("ToTree():\ngot :\n%s\nwant:\n%s", got, want)
+	}
+}
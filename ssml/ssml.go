@@ -201,6 +201,7 @@ const (
 	Verbatim   InterpretationType = "verbatim"
 	SpellOut   InterpretationType = "spell-out"
 	Date       InterpretationType = "date"
+	Time       InterpretationType = "time"
 	Characters InterpretationType = "characters"
 	Cardinal   InterpretationType = "cardinal"
 	Ordinal    InterpretationType = "ordinal"
@@ -260,7 +261,7 @@ func (sa *SayAs) attrs() []xml.Attr {
 				Value: sa.Language,
 			})
 		}
-	case Date:
+	case Date, Time:
 		if sa.Format != "" {
 			attrs = append(attrs, xml.Attr{
 				Name:  xml.Name{Local: "format"},
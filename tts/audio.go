@@ -0,0 +1,109 @@
+package tts
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"cloud.google.com/go/texttospeech/apiv1/texttospeechpb"
+	"github.com/kechako/yomiko/audio/pcm"
+)
+
+// Audio is synthesized speech. Data holds interleaved little endian samples
+// described by Format.
+type Audio struct {
+	Format pcm.Format
+	Data   []byte
+}
+
+// AudioStream is synthesized speech being received. It reads interleaved
+// little endian samples described by Format as they arrive.
+type AudioStream struct {
+	Format pcm.Format
+
+	r     io.Reader
+	close func()
+}
+
+func (s *AudioStream) Read(p []byte) (int, error) {
+	return s.r.Read(p)
+}
+
+// Close stops receiving the audio.
+func (s *AudioStream) Close() error {
+	if s.close != nil {
+		s.close()
+	}
+	return nil
+}
+
+// ReadAudio reads the whole audio from s and closes it.
+func ReadAudio(s *AudioStream) (*Audio, error) {
+	defer s.Close()
+
+	data, err := io.ReadAll(s)
+	if err != nil {
+		return nil, fmt.Errorf("tts.ReadAudio: %w", err)
+	}
+
+	return &Audio{
+		Format: s.Format,
+		Data:   data,
+	}, nil
+}
+
+// newWAVStream returns a stream of the samples following the WAV header
+// read from r.
+func newWAVStream(r io.Reader) (*AudioStream, error) {
+	wr, err := pcm.NewWAVReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("tts.newWAVStream: %w", err)
+	}
+
+	format, err := wr.Info().Format()
+	if err != nil {
+		return nil, fmt.Errorf("tts.newWAVStream: %w", err)
+	}
+
+	return &AudioStream{
+		Format: format,
+		r:      wr,
+	}, nil
+}
+
+// streamingFormat is the format of the audio returned from
+// StreamingSynthesize, which is headerless LINEAR16.
+var streamingFormat = pcm.Format{
+	SampleRate: 24000,
+	Channels:   1,
+	SampleType: pcm.SampleTypeInt16,
+}
+
+type streamingSynthesizeClient interface {
+	Recv() (*texttospeechpb.StreamingSynthesizeResponse, error)
+}
+
+// streamReader reads the audio content of the responses of
+// StreamingSynthesize.
+type streamReader struct {
+	stream streamingSynthesizeClient
+	buf    []byte
+}
+
+func (r *streamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		res, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, fmt.Errorf("tts.streamReader.Read: %w", err)
+		}
+		r.buf = res.GetAudioContent()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
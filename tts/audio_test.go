@@ -0,0 +1,79 @@
+package tts
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"cloud.google.com/go/texttospeech/apiv1/texttospeechpb"
+	"github.com/kechako/yomiko/audio/pcm"
+)
+
+type fakeStreamingSynthesizeClient struct {
+	responses [][]byte
+	err       error
+}
+
+func (c *fakeStreamingSynthesizeClient) Recv() (*texttospeechpb.StreamingSynthesizeResponse, error) {
+	if len(c.responses) == 0 {
+		return nil, c.err
+	}
+	res := &texttospeechpb.StreamingSynthesizeResponse{AudioContent: c.responses[0]}
+	c.responses = c.responses[1:]
+	return res, nil
+}
+
+func TestStreamReader(t *testing.T) {
+	r := &streamReader{
+		stream: &fakeStreamingSynthesizeClient{
+			responses: [][]byte{{1, 2, 3}, nil, {4}, {5, 6}},
+			err:       io.EOF,
+		},
+	}
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{1, 2, 3, 4, 5, 6}; !bytes.Equal(got, want) {
+		t.Errorf("streamReader.Read(): got %v, want %v", got, want)
+	}
+
+	errStream := errors.New("stream error")
+	r = &streamReader{
+		stream: &fakeStreamingSynthesizeClient{
+			responses: [][]byte{{1, 2}},
+			err:       errStream,
+		},
+	}
+	if _, err := io.ReadAll(r); !errors.Is(err, errStream) {
+		t.Errorf("streamReader.Read(): got %v, want %v", err, errStream)
+	}
+}
+
+func TestReadAudio(t *testing.T) {
+	format := pcm.Format{SampleRate: 24000, Channels: 1, SampleType: pcm.SampleTypeInt16}
+	data := []byte{1, 0, 2, 0, 3, 0}
+
+	var buf bytes.Buffer
+	if err := pcm.WriteWAV(&buf, format.WAVInfo(), data); err != nil {
+		t.Fatal(err)
+	}
+
+	stream, err := newWAVStream(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	audio, err := ReadAudio(stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if audio.Format != format {
+		t.Errorf("Audio.Format: got %v, want %v", audio.Format, format)
+	}
+	if !bytes.Equal(audio.Data, data) {
+		t.Errorf("Audio.Data: got %v, want %v", audio.Data, data)
+	}
+}
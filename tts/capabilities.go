@@ -0,0 +1,133 @@
+package tts
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/kechako/yomiko/ssml"
+)
+
+type VoiceFamily string
+
+const (
+	FamilyStandard VoiceFamily = "Standard"
+	FamilyWavenet  VoiceFamily = "Wavenet"
+	FamilyNeural2  VoiceFamily = "Neural2"
+	FamilyStudio   VoiceFamily = "Studio"
+	FamilyPolyglot VoiceFamily = "Polyglot"
+	FamilyNews     VoiceFamily = "News"
+	FamilyJourney  VoiceFamily = "Journey"
+	FamilyChirpHD  VoiceFamily = "Chirp-HD"
+	FamilyChirp3HD VoiceFamily = "Chirp3-HD"
+	FamilyUnknown  VoiceFamily = ""
+)
+
+// VoiceFamilies are the known voice families.
+var VoiceFamilies = []VoiceFamily{
+	FamilyStandard,
+	FamilyWavenet,
+	FamilyNeural2,
+	FamilyStudio,
+	FamilyPolyglot,
+	FamilyNews,
+	FamilyJourney,
+	FamilyChirpHD,
+	FamilyChirp3HD,
+}
+
+// Capabilities describes the SSML subset supported by a voice.
+type Capabilities struct {
+	Family VoiceFamily
+	// SSML is false if the voice accepts only plain text.
+	SSML bool
+	// Streaming is true if the voice can be synthesized by
+	// StreamingSynthesize.
+	Streaming bool
+
+	UnsupportedElements        []string
+	UnsupportedAttributes      map[string][]string
+	UnsupportedInterpretations []ssml.InterpretationType
+}
+
+var _ ssml.Capabilities = (*Capabilities)(nil)
+
+func (caps *Capabilities) SupportsElement(name string) bool {
+	if !caps.SSML {
+		return false
+	}
+	return !slices.Contains(caps.UnsupportedElements, name)
+}
+
+func (caps *Capabilities) SupportsAttribute(element, attr string) bool {
+	if !caps.SupportsElement(element) {
+		return false
+	}
+	return !slices.Contains(caps.UnsupportedAttributes[element], attr)
+}
+
+func (caps *Capabilities) SupportsInterpretation(t ssml.InterpretationType) bool {
+	if !caps.SSML {
+		return false
+	}
+	return !slices.Contains(caps.UnsupportedInterpretations, t)
+}
+
+var (
+	fullCapabilities = Capabilities{
+		SSML: true,
+	}
+	textOnlyCapabilities = Capabilities{
+		SSML: false,
+	}
+	streamingCapabilities = Capabilities{
+		SSML:      false,
+		Streaming: true,
+	}
+)
+
+var familyCapabilities = map[VoiceFamily]*Capabilities{
+	FamilyStandard: &fullCapabilities,
+	FamilyWavenet:  &fullCapabilities,
+	FamilyNeural2:  &fullCapabilities,
+	FamilyPolyglot: &fullCapabilities,
+	FamilyNews:     &fullCapabilities,
+	// the elements not supported by Studio, such as mark, are never emitted
+	FamilyStudio:   &fullCapabilities,
+	FamilyJourney:  &streamingCapabilities,
+	FamilyChirpHD:  &textOnlyCapabilities,
+	FamilyChirp3HD: &streamingCapabilities,
+	FamilyUnknown:  &fullCapabilities,
+}
+
+// ParseVoiceFamily returns the family of the voice from its name
+// like "ja-JP-Neural2-B".
+func ParseVoiceFamily(voiceName string) VoiceFamily {
+	// skip language code
+	parts := strings.SplitN(voiceName, "-", 3)
+	if len(parts) < 3 {
+		return FamilyUnknown
+	}
+	name := parts[2]
+
+	for family := range familyCapabilities {
+		if family == FamilyUnknown {
+			continue
+		}
+		if name == string(family) || strings.HasPrefix(name, string(family)+"-") {
+			return family
+		}
+	}
+
+	return FamilyUnknown
+}
+
+// VoiceCapabilities returns the capabilities of the voice. An empty name
+// means the default voice, which is assumed to support full SSML.
+func VoiceCapabilities(voiceName string) *Capabilities {
+	family := ParseVoiceFamily(voiceName)
+
+	caps := *familyCapabilities[family]
+	caps.Family = family
+
+	return &caps
+}
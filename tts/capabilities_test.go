@@ -0,0 +1,66 @@
+package tts
+
+import (
+	"testing"
+
+	"github.com/kechako/yomiko/ssml"
+)
+
+var parseVoiceFamilyTests = []struct {
+	name   string
+	family VoiceFamily
+}{
+	{"", FamilyUnknown},
+	{"ja-JP-Standard-A", FamilyStandard},
+	{"ja-JP-Wavenet-D", FamilyWavenet},
+	{"ja-JP-Neural2-B", FamilyNeural2},
+	{"en-US-Studio-O", FamilyStudio},
+	{"en-US-Polyglot-1", FamilyPolyglot},
+	{"en-US-News-K", FamilyNews},
+	{"en-US-Journey-D", FamilyJourney},
+	{"en-US-Chirp-HD-F", FamilyChirpHD},
+	{"ja-JP-Chirp3-HD-Aoede", FamilyChirp3HD},
+	{"ja-JP-Unknown-A", FamilyUnknown},
+}
+
+func TestParseVoiceFamily(t *testing.T) {
+	for _, tt := range parseVoiceFamilyTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseVoiceFamily(tt.name)
+			if got != tt.family {
+				t.Errorf("ParseVoiceFamily(%q): got %q, want %q", tt.name, got, tt.family)
+			}
+		})
+	}
+}
+
+func TestMakeSynthesisInput(t *testing.T) {
+	root := ssml.New()
+	root.AddNodes(
+		&ssml.Paragraph{
+			Nodes: []ssml.Node{
+				&ssml.Sentence{Nodes: []ssml.Node{ssml.Text("読子")}},
+			},
+		},
+		&ssml.Paragraph{
+			Nodes: []ssml.Node{
+				&ssml.Sentence{
+					Nodes: []ssml.Node{
+						&ssml.Sub{Text: "禁書目録", Alias: "いんでっくす"},
+						&ssml.SayAs{Text: "ABC", InterpretAs: ssml.Characters},
+					},
+				},
+			},
+		},
+	)
+
+	in := makeSynthesisInput(root, VoiceCapabilities("ja-JP-Neural2-B"))
+	if got, want := in.GetSsml(), root.ToSSML(); got != want {
+		t.Errorf("makeSynthesisInput(Neural2):\ngot : %q\nwant: %q", got, want)
+	}
+
+	in = makeSynthesisInput(root, VoiceCapabilities("ja-JP-Chirp3-HD-Aoede"))
+	if got, want := in.GetText(), "読子\n\nいんでっくすABC"; got != want {
+		t.Errorf("makeSynthesisInput(Chirp3-HD):\ngot : %q\nwant: %q", got, want)
+	}
+}
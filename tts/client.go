@@ -1,11 +1,14 @@
 package tts
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"strings"
 
 	texttospeech "cloud.google.com/go/texttospeech/apiv1"
 	"cloud.google.com/go/texttospeech/apiv1/texttospeechpb"
+	"github.com/kechako/yomiko/ssml"
 	"google.golang.org/api/option"
 )
 
@@ -71,7 +74,29 @@ func (c *Client) ListVoices(ctx context.Context) ([]*Voice, error) {
 	return res.GetVoices(), nil
 }
 
-func (c *Client) SynthesizeSpeech(ctx context.Context, ssml string, opts ...SynthesizeSpeechOption) ([]byte, error) {
+// SynthesizeSpeech synthesizes doc and returns the whole audio. See
+// SynthesizeSpeechStream for the details.
+func (c *Client) SynthesizeSpeech(ctx context.Context, doc *ssml.SSML, opts ...SynthesizeSpeechOption) (*Audio, error) {
+	stream, err := c.SynthesizeSpeechStream(ctx, doc, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("tts.Client.SynthesizeSpeech: %w", err)
+	}
+
+	audio, err := ReadAudio(stream)
+	if err != nil {
+		return nil, fmt.Errorf("tts.Client.SynthesizeSpeech: %w", err)
+	}
+
+	return audio, nil
+}
+
+// SynthesizeSpeechStream synthesizes doc and returns the audio as a stream.
+// Nodes that are not supported by the voice are downgraded before
+// synthesis. Voices supporting StreamingSynthesize return the audio as it is
+// synthesized, unless the speaking rate or the pitch is changed, which
+// StreamingSynthesize does not support. Other voices return the audio
+// after the whole synthesis.
+func (c *Client) SynthesizeSpeechStream(ctx context.Context, doc *ssml.SSML, opts ...SynthesizeSpeechOption) (*AudioStream, error) {
 	o := synthesizeSpeechOptions{
 		speakingRate: 1.0,
 		pitch:        0.0,
@@ -80,16 +105,24 @@ func (c *Client) SynthesizeSpeech(ctx context.Context, ssml string, opts ...Synt
 		opt.apply(&o)
 	}
 
+	caps := VoiceCapabilities(o.voiceName)
+	input := makeSynthesisInput(doc, caps)
+	voice := &texttospeechpb.VoiceSelectionParams{
+		LanguageCode: c.opts.languageCode,
+		Name:         o.voiceName,
+	}
+
+	if caps.Streaming && o.speakingRate == 1.0 && o.pitch == 0.0 {
+		stream, err := c.streamingSynthesize(ctx, voice, input.GetText())
+		if err != nil {
+			return nil, fmt.Errorf("tts.Client.SynthesizeSpeechStream: %w", err)
+		}
+		return stream, nil
+	}
+
 	res, err := c.client.SynthesizeSpeech(ctx, &texttospeechpb.SynthesizeSpeechRequest{
-		Input: &texttospeechpb.SynthesisInput{
-			InputSource: &texttospeechpb.SynthesisInput_Ssml{
-				Ssml: ssml,
-			},
-		},
-		Voice: &texttospeechpb.VoiceSelectionParams{
-			LanguageCode: c.opts.languageCode,
-			Name:         o.voiceName,
-		},
+		Input: input,
+		Voice: voice,
 		AudioConfig: &texttospeechpb.AudioConfig{
 			AudioEncoding:   texttospeechpb.AudioEncoding_LINEAR16,
 			SampleRateHertz: int32(c.opts.sampleRate),
@@ -98,10 +131,79 @@ func (c *Client) SynthesizeSpeech(ctx context.Context, ssml string, opts ...Synt
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("tts.Client.SynthesizeSpeech: %w", err)
+		return nil, fmt.Errorf("tts.Client.SynthesizeSpeechStream: %w", err)
 	}
 
-	return res.GetAudioContent(), nil
+	// LINEAR16 audio content includes a WAV header
+	stream, err := newWAVStream(bytes.NewReader(res.GetAudioContent()))
+	if err != nil {
+		return nil, fmt.Errorf("tts.Client.SynthesizeSpeechStream: %w", err)
+	}
+
+	return stream, nil
+}
+
+func (c *Client) streamingSynthesize(ctx context.Context, voice *texttospeechpb.VoiceSelectionParams, text string) (*AudioStream, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := c.client.StreamingSynthesize(ctx)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("tts.Client.streamingSynthesize: %w", err)
+	}
+
+	reqs := []*texttospeechpb.StreamingSynthesizeRequest{
+		{
+			StreamingRequest: &texttospeechpb.StreamingSynthesizeRequest_StreamingConfig{
+				StreamingConfig: &texttospeechpb.StreamingSynthesizeConfig{
+					Voice: voice,
+				},
+			},
+		},
+		{
+			StreamingRequest: &texttospeechpb.StreamingSynthesizeRequest_Input{
+				Input: &texttospeechpb.StreamingSynthesisInput{
+					InputSource: &texttospeechpb.StreamingSynthesisInput_Text{
+						Text: text,
+					},
+				},
+			},
+		},
+	}
+	for _, req := range reqs {
+		if err := stream.Send(req); err != nil {
+			cancel()
+			return nil, fmt.Errorf("tts.Client.streamingSynthesize: %w", err)
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("tts.Client.streamingSynthesize: %w", err)
+	}
+
+	return &AudioStream{
+		Format: streamingFormat,
+		r:      &streamReader{stream: stream},
+		close:  cancel,
+	}, nil
+}
+
+func makeSynthesisInput(doc *ssml.SSML, caps *Capabilities) *texttospeechpb.SynthesisInput {
+	doc = ssml.Downgrade(doc, caps)
+
+	if !caps.SSML {
+		return &texttospeechpb.SynthesisInput{
+			InputSource: &texttospeechpb.SynthesisInput_Text{
+				Text: strings.TrimSpace(ssml.ToText(doc)),
+			},
+		}
+	}
+
+	return &texttospeechpb.SynthesisInput{
+		InputSource: &texttospeechpb.SynthesisInput_Ssml{
+			Ssml: doc.ToSSML(),
+		},
+	}
 }
 
 func (c *Client) Close() error {
@@ -0,0 +1,19 @@
+package tts
+
+import (
+	"context"
+
+	"github.com/kechako/yomiko/ssml"
+)
+
+// Engine is a backend synthesizing speech.
+type Engine interface {
+	ListVoices(ctx context.Context) ([]*Voice, error)
+	SynthesizeSpeechStream(ctx context.Context, doc *ssml.SSML, opts ...SynthesizeSpeechOption) (*AudioStream, error)
+	Close() error
+}
+
+var (
+	_ Engine = (*Client)(nil)
+	_ Engine = (*FakeEngine)(nil)
+)
@@ -0,0 +1,158 @@
+package tts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"unicode"
+
+	"cloud.google.com/go/texttospeech/apiv1/texttospeechpb"
+	"github.com/kechako/yomiko/audio/pcm"
+	"github.com/kechako/yomiko/ssml"
+)
+
+const (
+	// duration of the tone of a character at the speaking rate 1
+	fakeCharMs = 80
+	// fade of the tone not to click
+	fakeFadeMs = 5
+	// amplitude of the tone
+	fakeAmplitude = 0.3
+)
+
+// semitones of the major pentatonic scale, one of which is chosen for a
+// character
+var fakeScale = [...]int{0, 2, 4, 7, 9}
+
+type fakeVoice struct {
+	name   string
+	gender texttospeechpb.SsmlVoiceGender
+	// frequency of the lowest tone
+	freq float64
+}
+
+var fakeVoices = []fakeVoice{
+	{name: "Fake-A", gender: GenderFemale, freq: 440},
+	{name: "Fake-B", gender: GenderMale, freq: 220},
+	{name: "Fake-C", gender: GenderNeutral, freq: 330},
+}
+
+// FakeEngine is a deterministic Engine running locally, for tests and
+// development without network. It does not speak, but plays a tone per
+// character, and the same input always results in the same audio.
+type FakeEngine struct {
+	opts *clientOptions
+}
+
+func NewFake(opts ...ClientOption) *FakeEngine {
+	options := clientOptions{
+		languageCode: DefaultLanguageCode,
+		sampleRate:   DefaultSampleRate,
+	}
+	for _, opt := range opts {
+		opt.apply(&options)
+	}
+
+	return &FakeEngine{
+		opts: &options,
+	}
+}
+
+func (e *FakeEngine) ListVoices(ctx context.Context) ([]*Voice, error) {
+	voices := make([]*Voice, len(fakeVoices))
+	for i, v := range fakeVoices {
+		voices[i] = &Voice{
+			LanguageCodes:          []string{e.opts.languageCode},
+			Name:                   e.voiceName(v),
+			SsmlGender:             v.gender,
+			NaturalSampleRateHertz: int32(e.opts.sampleRate),
+		}
+	}
+
+	return voices, nil
+}
+
+func (e *FakeEngine) voiceName(v fakeVoice) string {
+	return e.opts.languageCode + "-" + v.name
+}
+
+// voice returns the voice of the name. Unknown names, such as the voices of
+// other engines saved in the settings, fall back to the first voice.
+func (e *FakeEngine) voice(name string) fakeVoice {
+	for _, v := range fakeVoices {
+		if e.voiceName(v) == name {
+			return v
+		}
+	}
+	return fakeVoices[0]
+}
+
+// SynthesizeSpeechStream plays a tone for each character of the text of doc.
+// The pitch of a tone depends on the voice, the pitch option and the
+// character, and the duration on the speaking rate. Spaces and punctuations
+// are silent.
+func (e *FakeEngine) SynthesizeSpeechStream(ctx context.Context, doc *ssml.SSML, opts ...SynthesizeSpeechOption) (*AudioStream, error) {
+	o := synthesizeSpeechOptions{
+		speakingRate: 1.0,
+		pitch:        0.0,
+	}
+	for _, opt := range opts {
+		opt.apply(&o)
+	}
+	if o.speakingRate <= 0 {
+		return nil, fmt.Errorf("tts.FakeEngine.SynthesizeSpeechStream: invalid speaking rate %g", o.speakingRate)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("tts.FakeEngine.SynthesizeSpeechStream: %w", err)
+	}
+
+	format := pcm.Format{
+		SampleRate: e.opts.sampleRate,
+		Channels:   1,
+		SampleType: pcm.SampleTypeInt16,
+	}
+
+	v := e.voice(o.voiceName)
+	base := v.freq * math.Pow(2, o.pitch/12)
+	n := int(float64(format.SampleRate*fakeCharMs/1000) / o.speakingRate)
+	fade := min(n/2, format.SampleRate*fakeFadeMs/1000)
+
+	// read as plain text like the voices without SSML
+	text := makeSynthesisInput(doc, &Capabilities{}).GetText()
+
+	var samples []float32
+	for _, r := range text {
+		if unicode.IsSpace(r) || unicode.IsPunct(r) {
+			samples = append(samples, make([]float32, n)...)
+			continue
+		}
+
+		freq := base * math.Pow(2, float64(fakeScale[int(r)%len(fakeScale)])/12)
+		for i := 0; i < n; i++ {
+			g := fakeAmplitude
+			if i < fade {
+				g *= float64(i) / float64(fade)
+			} else if n-1-i < fade {
+				g *= float64(n-1-i) / float64(fade)
+			}
+			t := float64(i) / float64(format.SampleRate)
+			samples = append(samples, float32(g*math.Sin(2*math.Pi*freq*t)))
+		}
+	}
+
+	data := make([]byte, pcm.SamplesToBytes[int16](len(samples)))
+	if _, err := pcm.Encode(data, pcm.ConvertSamples[float32, int16](nil, samples), pcm.LittleEndian); err != nil {
+		return nil, fmt.Errorf("tts.FakeEngine.SynthesizeSpeechStream: %w", err)
+	}
+
+	return &AudioStream{
+		Format: format,
+		r:      bytes.NewReader(data),
+	}, nil
+}
+
+func (e *FakeEngine) Close() error {
+	return nil
+}
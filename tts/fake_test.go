@@ -0,0 +1,93 @@
+package tts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kechako/yomiko/audio/pcm"
+	"github.com/kechako/yomiko/ssml"
+)
+
+func newFakeDoc(text string) *ssml.SSML {
+	doc := ssml.New()
+	doc.AddNode(&ssml.Paragraph{Nodes: []ssml.Node{ssml.Text(text)}})
+	return doc
+}
+
+func synthesizeFake(t *testing.T, e *FakeEngine, text string, opts ...SynthesizeSpeechOption) *Audio {
+	t.Helper()
+
+	stream, err := e.SynthesizeSpeechStream(context.Background(), newFakeDoc(text), opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	audio, err := ReadAudio(stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return audio
+}
+
+func TestFakeEngine(t *testing.T) {
+	e := NewFake(WithSampleRate(48000))
+	charFrames := 48000 * fakeCharMs / 1000
+
+	tests := []struct {
+		text   string
+		opts   []SynthesizeSpeechOption
+		frames int
+	}{
+		{text: "", frames: 0},
+		{text: "あ", frames: charFrames},
+		{text: "こんにちは", frames: 5 * charFrames},
+		{text: "はい、はい", frames: 5 * charFrames},
+		{text: "こんにちは", opts: []SynthesizeSpeechOption{WithSpeakingRate(2)}, frames: 5 * charFrames / 2},
+	}
+
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("test_%02d", i), func(t *testing.T) {
+			audio := synthesizeFake(t, e, tt.text, tt.opts...)
+
+			want := pcm.Format{SampleRate: 48000, Channels: 1, SampleType: pcm.SampleTypeInt16}
+			if diff := cmp.Diff(want, audio.Format); diff != "" {
+				t.Errorf("format mismatch (-want +got):\n%s", diff)
+			}
+			if got := audio.Format.BytesToFrames(len(audio.Data)); got != tt.frames {
+				t.Errorf("frames: got %d, want %d", got, tt.frames)
+			}
+		})
+	}
+}
+
+func TestFakeEngineDeterministic(t *testing.T) {
+	e := NewFake()
+
+	a := synthesizeFake(t, e, "読子さん")
+	b := synthesizeFake(t, e, "読子さん")
+	if !bytes.Equal(a.Data, b.Data) {
+		t.Error("the same input results in different audio")
+	}
+
+	voices, err := e.ListVoices(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(voices) < 2 {
+		t.Fatalf("got %d voices, want at least 2", len(voices))
+	}
+
+	c := synthesizeFake(t, e, "読子さん", WithVoiceName(voices[1].GetName()))
+	if bytes.Equal(a.Data, c.Data) {
+		t.Error("different voices result in the same audio")
+	}
+
+	// unknown voices fall back to the first voice
+	d := synthesizeFake(t, e, "読子さん", WithVoiceName("ja-JP-Neural2-B"))
+	if !bytes.Equal(a.Data, d.Data) {
+		t.Error("unknown voice does not fall back to the first voice")
+	}
+}